@@ -1,13 +0,0 @@
-package apierrors
-
-type APIAction string
-
-const (
-	APIActionCreate   APIAction = "create"
-	APIActionRead     APIAction = "read"
-	APIActionUpdate   APIAction = "update"
-	APIActionDelete   APIAction = "delete"
-	APIActionDeploy   APIAction = "deploy"
-	APIActionStop     APIAction = "stop"
-	APIActionRedeploy APIAction = "redeploy"
-)
@@ -0,0 +1,4 @@
+data "qovery_digitalocean_credentials" "my_digitalocean_creds" {
+  id              = "<credentials_id>"
+  organization_id = "<organization_id>"
+}
\ No newline at end of file
@@ -0,0 +1,4 @@
+data "qovery_gcp_credentials" "my_gcp_creds" {
+  id              = "<credentials_id>"
+  organization_id = "<organization_id>"
+}
\ No newline at end of file
@@ -24,6 +24,18 @@ resource "qovery_application" "my_application" {
       value = "ENV_VAR_VALUE"
     }
   ]
+  environment_variable_aliases = [
+    {
+      key   = "ENV_VAR_KEY_ALIAS"
+      value = "ENV_VAR_KEY"
+    }
+  ]
+  environment_variable_overrides = [
+    {
+      key   = "SOME_PROJECT_VARIABLE"
+      value = "OVERRIDDEN_VALUE"
+    }
+  ]
   secrets = [
     {
       key   = "SECRET_KEY"
@@ -1,10 +1,26 @@
 resource "qovery_aws_credentials" "my_aws_creds" {
   # Required
-  organization_id   = qovery_organization.my_organization.id
-  name              = "my_aws_creds"
+  organization_id = qovery_organization.my_organization.id
+  name            = "my_aws_creds"
+
+  # Static keys
   access_key_id     = "<your-aws-access-key-id>"
   secret_access_key = "<your-aws-secret-access-key>"
 
+  depends_on = [
+    qovery_organization.my_organization
+  ]
+}
+
+resource "qovery_aws_credentials" "my_aws_role_creds" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  name            = "my_aws_role_creds"
+
+  # Role to assume
+  role_arn    = "arn:aws:iam::<your-aws-account-id>:role/<your-qovery-role>"
+  external_id = "<your-external-id>"
+
   depends_on = [
     qovery_organization.my_organization
   ]
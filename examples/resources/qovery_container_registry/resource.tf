@@ -10,7 +10,8 @@ resource "qovery_container_registry" "my_container_registry" {
   }
 
   # Optional
-  description = "My Docker Hub Registry"
+  description          = "My Docker Hub Registry"
+  validate_credentials = true
 
   depends_on = [
     qovery_organization.my_organization
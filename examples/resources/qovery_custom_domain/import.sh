@@ -0,0 +1 @@
+terraform import qovery_custom_domain.my_custom_domain "<service_id>,<domain>"
@@ -0,0 +1,13 @@
+resource "qovery_custom_domain" "my_custom_domain" {
+  # Required
+  service_id   = qovery_container.my_container.id
+  service_type = "CONTAINER"
+  domain       = "my.domain.com"
+
+  # Optional
+  wait_for_certificate = true
+
+  depends_on = [
+    qovery_container.my_container
+  ]
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import qovery_digitalocean_credentials.my_digitalocean_creds "<organization_id>,<digitalocean_credentials_id>"
\ No newline at end of file
@@ -0,0 +1,12 @@
+resource "qovery_digitalocean_credentials" "my_digitalocean_creds" {
+  # Required
+  organization_id                = qovery_organization.my_organization.id
+  name                           = "my_digitalocean_creds"
+  digitalocean_token             = "<your-digitalocean-token>"
+  digitalocean_spaces_access_id  = "<your-digitalocean-spaces-access-id>"
+  digitalocean_spaces_secret_key = "<your-digitalocean-spaces-secret-key>"
+
+  depends_on = [
+    qovery_organization.my_organization
+  ]
+}
\ No newline at end of file
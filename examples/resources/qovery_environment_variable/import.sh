@@ -0,0 +1 @@
+terraform import qovery_environment_variable.my_environment_variable "<scope_id>/<key>"
\ No newline at end of file
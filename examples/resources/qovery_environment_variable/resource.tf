@@ -0,0 +1,10 @@
+resource "qovery_environment_variable" "my_environment_variable" {
+  # Required
+  scope_id = qovery_environment.my_environment.id
+  key      = "MY_KEY"
+  value    = "my-value"
+
+  depends_on = [
+    qovery_environment.my_environment
+  ]
+}
\ No newline at end of file
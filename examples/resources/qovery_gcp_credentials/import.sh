@@ -0,0 +1 @@
+terraform import qovery_gcp_credentials.my_gcp_creds "<organization_id>,<gcp_credentials_id>"
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "qovery_gcp_credentials" "my_gcp_creds" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  name            = "my_gcp_creds"
+  gcp_credentials = file("<path-to-your-gcp-service-account-key.json>")
+
+  depends_on = [
+    qovery_organization.my_organization
+  ]
+}
\ No newline at end of file
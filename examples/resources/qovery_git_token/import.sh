@@ -0,0 +1 @@
+terraform import qovery_git_token.my_git_token "<organization_id>,<git_token_id>"
\ No newline at end of file
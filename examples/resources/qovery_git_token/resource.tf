@@ -0,0 +1,14 @@
+resource "qovery_git_token" "my_git_token" {
+  # Required
+  organization_id = qovery_organization.my_organization.id
+  name            = "my_github_token"
+  type            = "GITHUB"
+  token           = "<my_github_token>"
+
+  # Optional
+  description = "Token used to access the private repositories of my GitHub organization"
+
+  depends_on = [
+    qovery_organization.my_organization
+  ]
+}
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import qovery_secret.my_secret "<scope_id>/<key>"
\ No newline at end of file
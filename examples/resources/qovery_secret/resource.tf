@@ -0,0 +1,10 @@
+resource "qovery_secret" "my_secret" {
+  # Required
+  scope_id = qovery_application.my_application.id
+  key      = "MY_SECRET"
+  value    = "my-secret-value"
+
+  depends_on = [
+    qovery_application.my_application
+  ]
+}
\ No newline at end of file
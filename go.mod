@@ -89,3 +89,6 @@ require (
 	google.golang.org/protobuf v1.30.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// TODO: drop this replace once the upstream client is regenerated with the healthchecks, git token, gcp and aws role based credentials models.
+replace github.com/qovery/qovery-client-go => ./third_party/qovery-client-go
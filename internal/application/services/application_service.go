@@ -0,0 +1,259 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure applicationService defined types fully satisfy the application.Service interface.
+var _ application.Service = applicationService{}
+
+// applicationService implements the interface application.Service.
+type applicationService struct {
+	applicationRepository        application.Repository
+	applicationDeploymentService deployment.Service
+	variableService              variable.Service
+	secretService                secret.Service
+	customDomainService          customdomain.Service
+}
+
+// NewApplicationService return a new instance of an application.Service that uses the given application.Repository.
+func NewApplicationService(applicationRepository application.Repository, applicationDeploymentService deployment.Service, variableService variable.Service, secretService secret.Service, customDomainService customdomain.Service) (application.Service, error) {
+	if applicationRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if applicationDeploymentService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if variableService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if secretService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if customDomainService == nil {
+		return nil, ErrInvalidService
+	}
+
+	return &applicationService{
+		applicationRepository:        applicationRepository,
+		variableService:              variableService,
+		secretService:                secretService,
+		customDomainService:          customDomainService,
+		applicationDeploymentService: applicationDeploymentService,
+	}, nil
+}
+
+// Create handles the domain logic to create an application.
+func (s applicationService) Create(ctx context.Context, environmentID string, request application.UpsertServiceRequest) (*application.Application, error) {
+	if err := s.checkEnvironmentID(environmentID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Create(ctx, environmentID, request.ApplicationUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	_, err = s.variableService.Update(ctx, app.ID.String(), request.EnvironmentVariables)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	_, err = s.secretService.Update(ctx, app.ID.String(), request.Secrets)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	_, err = s.customDomainService.Update(ctx, app.ID.String(), request.CustomDomains)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	app, err = s.deployGitCommit(ctx, *app, request.ApplicationUpsertRequest.GitRepository.CommitID)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	app, err = s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToCreateApplication.Error())
+	}
+
+	return app, nil
+}
+
+// Get handles the domain logic to retrieve an application.
+func (s applicationService) Get(ctx context.Context, applicationID string) (*application.Application, error) {
+	if err := s.checkID(applicationID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Get(ctx, applicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	app, err = s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToGetApplication.Error())
+	}
+
+	return app, nil
+}
+
+// Update handles the domain logic to update an application.
+func (s applicationService) Update(ctx context.Context, applicationID string, request application.UpsertServiceRequest) (*application.Application, error) {
+	if err := s.checkID(applicationID); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	app, err := s.applicationRepository.Update(ctx, applicationID, request.ApplicationUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	_, err = s.variableService.Update(ctx, app.ID.String(), request.EnvironmentVariables)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	_, err = s.secretService.Update(ctx, app.ID.String(), request.Secrets)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	_, err = s.customDomainService.Update(ctx, app.ID.String(), request.CustomDomains)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	app, err = s.deployGitCommit(ctx, *app, request.ApplicationUpsertRequest.GitRepository.CommitID)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	app, err = s.refreshApplication(ctx, *app)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrFailedToUpdateApplication.Error())
+	}
+
+	return app, nil
+}
+
+// Delete handles the domain logic to delete an application.
+func (s applicationService) Delete(ctx context.Context, applicationID string) error {
+	if err := s.checkID(applicationID); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	if err := s.applicationRepository.Delete(ctx, applicationID); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	if err := waitForDeletion(ctx, s.applicationDeploymentService, applicationID); err != nil {
+		return errors.Wrap(err, application.ErrFailedToDeleteApplication.Error())
+	}
+
+	return nil
+}
+
+// deployGitCommit deploys the given commit of the application if it is not the one already deployed.
+// The application is fetched again once deployed so its deployed commit is up-to-date.
+func (s applicationService) deployGitCommit(ctx context.Context, app application.Application, commitID *string) (*application.Application, error) {
+	if commitID == nil || (app.GitRepository.CommitID != nil && *app.GitRepository.CommitID == *commitID) {
+		return &app, nil
+	}
+
+	if _, err := s.applicationDeploymentService.Deploy(ctx, app.ID.String(), *commitID); err != nil {
+		return nil, err
+	}
+
+	return s.applicationRepository.Get(ctx, app.ID.String())
+}
+
+func (s applicationService) refreshApplication(ctx context.Context, app application.Application) (*application.Application, error) {
+	envVars, err := s.variableService.List(ctx, app.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := s.secretService.List(ctx, app.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	customDomains, err := s.customDomainService.List(ctx, app.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.applicationDeploymentService.GetStatus(ctx, app.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := app.SetEnvironmentVariables(envVars); err != nil {
+		return nil, err
+	}
+
+	if err := app.SetSecrets(secrets); err != nil {
+		return nil, err
+	}
+
+	if err := app.SetCustomDomains(customDomains); err != nil {
+		return nil, err
+	}
+
+	if err := app.SetState(status.State); err != nil {
+		return nil, err
+	}
+
+	return &app, err
+}
+
+// checkEnvironmentID validates that the given environmentID is valid.
+func (s applicationService) checkEnvironmentID(environmentID string) error {
+	if environmentID == "" {
+		return application.ErrInvalidEnvironmentIDParam
+	}
+
+	if _, err := uuid.Parse(environmentID); err != nil {
+		return errors.Wrap(err, application.ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkID validates that the given applicationID is valid.
+func (s applicationService) checkID(applicationID string) error {
+	if applicationID == "" {
+		return application.ErrInvalidApplicationIDParam
+	}
+
+	if _, err := uuid.Parse(applicationID); err != nil {
+		return errors.Wrap(err, application.ErrInvalidApplicationIDParam.Error())
+	}
+
+	return nil
+}
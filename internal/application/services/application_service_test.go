@@ -0,0 +1,505 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+// applicationServiceRepositories holds the mocked repositories used by the application service and the services it relies on.
+type applicationServiceRepositories struct {
+	Application  *mocks_test.ApplicationRepository
+	Deployment   *mocks_test.DeploymentRepository
+	Variable     *mocks_test.VariableRepository
+	Secret       *mocks_test.SecretRepository
+	CustomDomain *mocks_test.CustomDomainRepository
+}
+
+func newApplicationServiceRepositories(t *testing.T) applicationServiceRepositories {
+	return applicationServiceRepositories{
+		Application:  mocks_test.NewApplicationRepository(t),
+		Deployment:   mocks_test.NewDeploymentRepository(t),
+		Variable:     mocks_test.NewVariableRepository(t),
+		Secret:       mocks_test.NewSecretRepository(t),
+		CustomDomain: mocks_test.NewCustomDomainRepository(t),
+	}
+}
+
+func newApplicationService(t *testing.T, repositories applicationServiceRepositories) application.Service {
+	deploymentService, err := services.NewDeploymentService(repositories.Deployment, envlock.New())
+	require.NoError(t, err)
+
+	variableService, err := services.NewVariableService(repositories.Variable)
+	require.NoError(t, err)
+
+	secretService, err := services.NewSecretService(repositories.Secret)
+	require.NoError(t, err)
+
+	customDomainService, err := services.NewCustomDomainService(repositories.CustomDomain)
+	require.NoError(t, err)
+
+	applicationService, err := services.NewApplicationService(repositories.Application, deploymentService, variableService, secretService, customDomainService)
+	require.NoError(t, err)
+
+	return applicationService
+}
+
+func newApplicationUpsertServiceRequest() application.UpsertServiceRequest {
+	return application.UpsertServiceRequest{
+		ApplicationUpsertRequest: application.UpsertRepositoryRequest{
+			Name: gofakeit.Name(),
+			GitRepository: application.GitRepositoryUpsertRequest{
+				URL:    "https://github.com/Qovery/test_http_server.git",
+				Branch: pointer.ToString("master"),
+			},
+			Ports: []port.UpsertRequest{{InternalPort: 8080, PubliclyAccessible: true}},
+		},
+		EnvironmentVariables: variable.DiffRequest{
+			Create: []variable.DiffCreateRequest{{UpsertRequest: variable.UpsertRequest{Key: "LOG_LEVEL", Value: "debug"}}},
+		},
+		Secrets: secret.DiffRequest{
+			Create: []secret.DiffCreateRequest{{UpsertRequest: secret.UpsertRequest{Key: "API_KEY", Value: gofakeit.Password(true, true, true, false, false, 16)}}},
+		},
+		CustomDomains: customdomain.DiffRequest{
+			Create: []customdomain.DiffCreateRequest{{UpsertRequest: customdomain.UpsertRequest{Domain: gofakeit.DomainName()}}},
+		},
+	}
+}
+
+// newApplicationFromRequest returns the application as it would be returned by the api once the given request is applied.
+func newApplicationFromRequest(environmentID uuid.UUID, applicationID uuid.UUID, request application.UpsertServiceRequest) *application.Application {
+	return &application.Application{
+		ID:            applicationID,
+		EnvironmentID: environmentID,
+		Name:          request.ApplicationUpsertRequest.Name,
+		GitRepository: git_repository.GitRepository{
+			Url:    request.ApplicationUpsertRequest.GitRepository.URL,
+			Branch: request.ApplicationUpsertRequest.GitRepository.Branch,
+		},
+		BuildMode:           application.BuildModeDocker,
+		CPU:                 application.DefaultCPU,
+		Memory:              application.DefaultMemory,
+		MinRunningInstances: application.DefaultMinRunningInstances,
+		MaxRunningInstances: application.DefaultMaxRunningInstances,
+	}
+}
+
+// expectApplicationRefresh makes the mocked repositories return the variables, secrets, custom domains and status of the application.
+func expectApplicationRefresh(repositories applicationServiceRepositories, applicationID uuid.UUID, vars variable.Variables, secrets secret.Secrets, customDomains customdomain.CustomDomains, state status.State) {
+	repositories.Variable.EXPECT().
+		List(mock.Anything, applicationID.String()).
+		Return(vars, nil).
+		Once()
+	repositories.Secret.EXPECT().
+		List(mock.Anything, applicationID.String()).
+		Return(secrets, nil).
+		Once()
+	repositories.CustomDomain.EXPECT().
+		List(mock.Anything, applicationID.String()).
+		Return(customDomains, nil).
+		Once()
+	repositories.Deployment.EXPECT().
+		GetStatus(mock.Anything, applicationID.String()).
+		Return(&status.Status{ID: applicationID, State: state}, nil).
+		Once()
+}
+
+func TestNewApplicationService(t *testing.T) {
+	t.Parallel()
+
+	repositories := newApplicationServiceRepositories(t)
+	deploymentService, err := services.NewDeploymentService(repositories.Deployment, envlock.New())
+	require.NoError(t, err)
+	variableService, err := services.NewVariableService(repositories.Variable)
+	require.NoError(t, err)
+	secretService, err := services.NewSecretService(repositories.Secret)
+	require.NoError(t, err)
+	customDomainService, err := services.NewCustomDomainService(repositories.CustomDomain)
+	require.NoError(t, err)
+
+	_, err = services.NewApplicationService(nil, deploymentService, variableService, secretService, customDomainService)
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+
+	_, err = services.NewApplicationService(repositories.Application, nil, variableService, secretService, customDomainService)
+	assert.ErrorIs(t, err, services.ErrInvalidService)
+
+	_, err = services.NewApplicationService(repositories.Application, deploymentService, nil, secretService, customDomainService)
+	assert.ErrorIs(t, err, services.ErrInvalidService)
+
+	_, err = services.NewApplicationService(repositories.Application, deploymentService, variableService, nil, customDomainService)
+	assert.ErrorIs(t, err, services.ErrInvalidService)
+
+	_, err = services.NewApplicationService(repositories.Application, deploymentService, variableService, secretService, nil)
+	assert.ErrorIs(t, err, services.ErrInvalidService)
+
+	applicationService, err := services.NewApplicationService(repositories.Application, deploymentService, variableService, secretService, customDomainService)
+	assert.NoError(t, err)
+	assert.NotNil(t, applicationService)
+}
+
+func TestApplicationService_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	environmentID := uuid.New()
+	applicationID := uuid.New()
+	request := newApplicationUpsertServiceRequest()
+	createdApplication := newApplicationFromRequest(environmentID, applicationID, request)
+
+	createdVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeValue, Key: "LOG_LEVEL", Value: "debug"}
+	createdSecret := secret.Secret{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeValue, Key: "API_KEY"}
+	createdCustomDomain := customdomain.CustomDomain{ID: uuid.New(), Domain: request.CustomDomains.Create[0].Domain}
+
+	repositories := newApplicationServiceRepositories(t)
+	repositories.Application.EXPECT().
+		Create(mock.Anything, environmentID.String(), request.ApplicationUpsertRequest).
+		Return(createdApplication, nil).
+		Once()
+	repositories.Variable.EXPECT().
+		Create(mock.Anything, applicationID.String(), request.EnvironmentVariables.Create[0].UpsertRequest).
+		Return(&createdVariable, nil).
+		Once()
+	repositories.Secret.EXPECT().
+		Create(mock.Anything, applicationID.String(), request.Secrets.Create[0].UpsertRequest).
+		Return(&createdSecret, nil).
+		Once()
+	repositories.CustomDomain.EXPECT().
+		Create(mock.Anything, applicationID.String(), request.CustomDomains.Create[0].UpsertRequest).
+		Return(&createdCustomDomain, nil).
+		Once()
+	expectApplicationRefresh(repositories, applicationID, variable.Variables{createdVariable}, secret.Secrets{createdSecret}, customdomain.CustomDomains{createdCustomDomain}, status.StateReady)
+
+	app, err := newApplicationService(t, repositories).Create(ctx, environmentID.String(), request)
+	require.NoError(t, err)
+	assert.Equal(t, applicationID, app.ID)
+	assert.Equal(t, variable.Variables{createdVariable}, app.EnvironmentVariables)
+	assert.Equal(t, secret.Secrets{createdSecret}, app.Secrets)
+	assert.Equal(t, customdomain.CustomDomains{createdCustomDomain}, app.CustomDomains)
+	assert.Equal(t, status.StateStopped, app.State)
+	repositories.Deployment.AssertNotCalled(t, "Deploy", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplicationService_Create_WithCommitID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	environmentID := uuid.New()
+	applicationID := uuid.New()
+	commitID := "4f5c9c9cc3b09d95a2e1b5d1b0b43a4c8b6e2f5a"
+
+	request := newApplicationUpsertServiceRequest()
+	request.EnvironmentVariables = variable.DiffRequest{}
+	request.Secrets = secret.DiffRequest{}
+	request.CustomDomains = customdomain.DiffRequest{}
+	request.ApplicationUpsertRequest.GitRepository.CommitID = pointer.ToString(commitID)
+
+	createdApplication := newApplicationFromRequest(environmentID, applicationID, request)
+	deployedApplication := newApplicationFromRequest(environmentID, applicationID, request)
+	deployedApplication.GitRepository.CommitID = pointer.ToString(commitID)
+
+	repositories := newApplicationServiceRepositories(t)
+	repositories.Application.EXPECT().
+		Create(mock.Anything, environmentID.String(), request.ApplicationUpsertRequest).
+		Return(createdApplication, nil).
+		Once()
+	repositories.Deployment.EXPECT().
+		GetEnvironmentID(mock.Anything, applicationID.String()).
+		Return(environmentID.String(), nil).
+		Once()
+	repositories.Deployment.EXPECT().
+		GetStatus(mock.Anything, applicationID.String()).
+		Return(&status.Status{ID: applicationID, State: status.StateDeployed}, nil).
+		Times(3)
+	repositories.Deployment.EXPECT().
+		Deploy(mock.Anything, applicationID.String(), commitID).
+		Return(&status.Status{ID: applicationID, State: status.StateDeploying}, nil).
+		Once()
+	repositories.Application.EXPECT().
+		Get(mock.Anything, applicationID.String()).
+		Return(deployedApplication, nil).
+		Once()
+	expectApplicationRefresh(repositories, applicationID, variable.Variables{}, secret.Secrets{}, customdomain.CustomDomains{}, status.StateDeployed)
+
+	app, err := newApplicationService(t, repositories).Create(ctx, environmentID.String(), request)
+	require.NoError(t, err)
+	assert.Equal(t, pointer.ToString(commitID), app.GitRepository.CommitID)
+	assert.Equal(t, status.StateDeployed, app.State)
+}
+
+func TestApplicationService_Create_FailWithInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		EnvironmentID string
+		Request       func() application.UpsertServiceRequest
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_invalid_environment_id",
+			EnvironmentID: "not-a-uuid",
+			Request:       newApplicationUpsertServiceRequest,
+			ExpectedError: application.ErrInvalidEnvironmentIDParam,
+		},
+		{
+			TestName:      "fail_with_invalid_request",
+			EnvironmentID: gofakeit.UUID(),
+			Request: func() application.UpsertServiceRequest {
+				request := newApplicationUpsertServiceRequest()
+				request.ApplicationUpsertRequest.Name = ""
+				return request
+			},
+			ExpectedError: application.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName:      "fail_with_custom_domains_without_http_port",
+			EnvironmentID: gofakeit.UUID(),
+			Request: func() application.UpsertServiceRequest {
+				request := newApplicationUpsertServiceRequest()
+				request.ApplicationUpsertRequest.Ports = nil
+				return request
+			},
+			ExpectedError: application.ErrCustomDomainsRequireHTTPPort,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			repositories := newApplicationServiceRepositories(t)
+
+			app, err := newApplicationService(t, repositories).Create(context.Background(), tc.EnvironmentID, tc.Request())
+			assert.ErrorContains(t, err, application.ErrFailedToCreateApplication.Error())
+			assert.ErrorContains(t, err, tc.ExpectedError.Error())
+			assert.Nil(t, app)
+			repositories.Application.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestApplicationService_Create_FailWithRepositoryError(t *testing.T) {
+	t.Parallel()
+
+	environmentID := uuid.New()
+	applicationID := uuid.New()
+	request := newApplicationUpsertServiceRequest()
+	variableError := apierrors.NewCreateApiError(apierrors.ApiResourceApplicationEnvironmentVariable, "", nil, errors.New("api failure"))
+
+	repositories := newApplicationServiceRepositories(t)
+	repositories.Application.EXPECT().
+		Create(mock.Anything, environmentID.String(), request.ApplicationUpsertRequest).
+		Return(newApplicationFromRequest(environmentID, applicationID, request), nil).
+		Once()
+	repositories.Variable.EXPECT().
+		Create(mock.Anything, applicationID.String(), request.EnvironmentVariables.Create[0].UpsertRequest).
+		Return(nil, variableError).
+		Once()
+
+	app, err := newApplicationService(t, repositories).Create(context.Background(), environmentID.String(), request)
+	assert.ErrorContains(t, err, application.ErrFailedToCreateApplication.Error())
+	assert.ErrorContains(t, err, variable.ErrFailedToUpdateVariables.Error())
+	assert.Nil(t, app)
+	repositories.Secret.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	repositories.CustomDomain.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplicationService_Get(t *testing.T) {
+	t.Parallel()
+
+	environmentID := uuid.New()
+	applicationID := uuid.New()
+	existingApplication := newApplicationFromRequest(environmentID, applicationID, newApplicationUpsertServiceRequest())
+
+	builtInVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Type: variable.TypeBuiltIn, Key: "QOVERY_APPLICATION_ID", Value: applicationID.String()}
+	applicationVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeValue, Key: "LOG_LEVEL", Value: "info"}
+
+	repositories := newApplicationServiceRepositories(t)
+	repositories.Application.EXPECT().
+		Get(mock.Anything, applicationID.String()).
+		Return(existingApplication, nil).
+		Once()
+	expectApplicationRefresh(repositories, applicationID, variable.Variables{builtInVariable, applicationVariable}, secret.Secrets{}, customdomain.CustomDomains{}, status.StateDeployed)
+
+	app, err := newApplicationService(t, repositories).Get(context.Background(), applicationID.String())
+	require.NoError(t, err)
+	assert.Equal(t, variable.Variables{applicationVariable}, app.EnvironmentVariables)
+	assert.Equal(t, variable.Variables{builtInVariable}, app.BuiltInEnvironmentVariables)
+	assert.Equal(t, status.StateDeployed, app.State)
+
+	_, err = newApplicationService(t, newApplicationServiceRepositories(t)).Get(context.Background(), "not-a-uuid")
+	assert.ErrorContains(t, err, application.ErrFailedToGetApplication.Error())
+	assert.ErrorContains(t, err, application.ErrInvalidApplicationIDParam.Error())
+}
+
+func TestApplicationService_Update(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	environmentID := uuid.New()
+	applicationID := uuid.New()
+
+	updatedVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeValue, Key: "LOG_LEVEL", Value: "warn"}
+	deletedVariableID := uuid.New()
+	deletedSecretID := uuid.New()
+	deletedCustomDomainID := uuid.New()
+
+	request := newApplicationUpsertServiceRequest()
+	request.EnvironmentVariables = variable.DiffRequest{
+		Update: []variable.DiffUpdateRequest{{VariableID: updatedVariable.ID.String(), UpsertRequest: variable.UpsertRequest{Key: updatedVariable.Key, Value: updatedVariable.Value}}},
+		Delete: []variable.DiffDeleteRequest{{VariableID: deletedVariableID.String()}},
+	}
+	request.Secrets = secret.DiffRequest{
+		Delete: []secret.DiffDeleteRequest{{SecretID: deletedSecretID.String()}},
+	}
+	request.CustomDomains = customdomain.DiffRequest{
+		Delete: []customdomain.DiffDeleteRequest{{CustomDomainID: deletedCustomDomainID.String()}},
+	}
+	updatedApplication := newApplicationFromRequest(environmentID, applicationID, request)
+
+	repositories := newApplicationServiceRepositories(t)
+	repositories.Application.EXPECT().
+		Update(mock.Anything, applicationID.String(), request.ApplicationUpsertRequest).
+		Return(updatedApplication, nil).
+		Once()
+	repositories.Variable.EXPECT().
+		Delete(mock.Anything, applicationID.String(), deletedVariableID.String()).
+		Return(nil).
+		Once()
+	repositories.Variable.EXPECT().
+		Update(mock.Anything, applicationID.String(), updatedVariable.ID.String(), request.EnvironmentVariables.Update[0].UpsertRequest).
+		Return(&updatedVariable, nil).
+		Once()
+	repositories.Secret.EXPECT().
+		Delete(mock.Anything, applicationID.String(), deletedSecretID.String()).
+		Return(nil).
+		Once()
+	repositories.CustomDomain.EXPECT().
+		Delete(mock.Anything, applicationID.String(), deletedCustomDomainID.String()).
+		Return(nil).
+		Once()
+	expectApplicationRefresh(repositories, applicationID, variable.Variables{updatedVariable}, secret.Secrets{}, customdomain.CustomDomains{}, status.StateDeployed)
+
+	app, err := newApplicationService(t, repositories).Update(ctx, applicationID.String(), request)
+	require.NoError(t, err)
+	assert.Equal(t, variable.Variables{updatedVariable}, app.EnvironmentVariables)
+	assert.Empty(t, app.Secrets)
+	assert.Empty(t, app.CustomDomains)
+	repositories.Variable.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestApplicationService_Update_FailWithInvalidParams(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		ApplicationID string
+		Request       func() application.UpsertServiceRequest
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_invalid_application_id",
+			ApplicationID: "not-a-uuid",
+			Request:       newApplicationUpsertServiceRequest,
+			ExpectedError: application.ErrInvalidApplicationIDParam,
+		},
+		{
+			TestName:      "fail_with_invalid_request",
+			ApplicationID: gofakeit.UUID(),
+			Request: func() application.UpsertServiceRequest {
+				request := newApplicationUpsertServiceRequest()
+				request.ApplicationUpsertRequest.GitRepository.URL = ""
+				return request
+			},
+			ExpectedError: application.ErrInvalidUpsertRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			repositories := newApplicationServiceRepositories(t)
+
+			app, err := newApplicationService(t, repositories).Update(context.Background(), tc.ApplicationID, tc.Request())
+			assert.ErrorContains(t, err, application.ErrFailedToUpdateApplication.Error())
+			assert.ErrorContains(t, err, tc.ExpectedError.Error())
+			assert.Nil(t, app)
+			repositories.Application.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestApplicationService_Delete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		DeleteError   error
+		ExpectedError error
+	}{
+		{
+			TestName: "success_when_application_is_not_found",
+		},
+		{
+			TestName:      "fail_when_delete_fails",
+			DeleteError:   apierrors.NewDeleteApiError(apierrors.ApiResourceApplication, "", nil, errors.New("api failure")),
+			ExpectedError: application.ErrFailedToDeleteApplication,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			applicationID := uuid.New()
+
+			repositories := newApplicationServiceRepositories(t)
+			repositories.Application.EXPECT().
+				Delete(mock.Anything, applicationID.String()).
+				Return(tc.DeleteError).
+				Once()
+			if tc.DeleteError == nil {
+				repositories.Deployment.EXPECT().
+					GetStatus(mock.Anything, applicationID.String()).
+					Return(nil, apierrors.NewNotFoundApiError(apierrors.ApiResourceApplication, applicationID.String())).
+					Once()
+			}
+
+			err := newApplicationService(t, repositories).Delete(context.Background(), applicationID.String())
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				repositories.Deployment.AssertNotCalled(t, "GetStatus", mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+
+	err := newApplicationService(t, newApplicationServiceRepositories(t)).Delete(context.Background(), "not-a-uuid")
+	assert.ErrorContains(t, err, application.ErrFailedToDeleteApplication.Error())
+	assert.ErrorContains(t, err, application.ErrInvalidApplicationIDParam.Error())
+}
@@ -0,0 +1,257 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
+)
+
+// Ensure clusterService defined types fully satisfy the cluster.Service interface.
+var _ cluster.Service = clusterService{}
+
+// clusterService implements the interface cluster.Service.
+type clusterService struct {
+	clusterRepository cluster.Repository
+}
+
+// NewClusterService return a new instance of a cluster.Service that uses the given cluster.Repository.
+func NewClusterService(clusterRepository cluster.Repository) (cluster.Service, error) {
+	if clusterRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &clusterService{
+		clusterRepository: clusterRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create a cluster, apply its configuration and bring it to the desired state.
+func (s clusterService) Create(ctx context.Context, organizationID string, request cluster.UpsertServiceRequest) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	newCluster, err := s.clusterRepository.Create(ctx, organizationID, request.ClusterUpsertRequest)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	if err := s.applyConfiguration(ctx, organizationID, newCluster.ID.String(), nil, request); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	createdCluster, err := s.clusterRepository.Get(ctx, organizationID, newCluster.ID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToCreateCluster.Error())
+	}
+
+	return createdCluster, nil
+}
+
+// Get handles the domain logic to retrieve a cluster.
+func (s clusterService) Get(ctx context.Context, organizationID string, clusterID string) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	if err := s.checkClusterID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	c, err := s.clusterRepository.Get(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToGetCluster.Error())
+	}
+
+	return c, nil
+}
+
+// Update handles the domain logic to update a cluster, apply its configuration and bring it to the desired state.
+func (s clusterService) Update(ctx context.Context, organizationID string, clusterID string, request cluster.UpsertServiceRequest) (*cluster.Cluster, error) {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := s.checkClusterID(clusterID); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	currentCluster, err := s.clusterRepository.Get(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if _, err := s.clusterRepository.Update(ctx, organizationID, clusterID, request.ClusterUpsertRequest); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	if err := s.applyConfiguration(ctx, organizationID, clusterID, currentCluster, request); err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	updatedCluster, err := s.clusterRepository.Get(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrFailedToUpdateCluster.Error())
+	}
+
+	return updatedCluster, nil
+}
+
+// Delete handles the domain logic to delete a cluster.
+func (s clusterService) Delete(ctx context.Context, organizationID string, clusterID string) error {
+	if err := s.checkOrganizationID(organizationID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := s.checkClusterID(clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	getter := s.statusGetter(organizationID)
+
+	// Wait until the cluster is in a final state, otherwise the deletion will fail.
+	if err := waitForTerminalState(ctx, getter, clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := s.clusterRepository.Delete(ctx, organizationID, clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	if err := waitForClusterDeletion(ctx, getter, clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrFailedToDeleteCluster.Error())
+	}
+
+	return nil
+}
+
+// applyConfiguration links the cluster to its credentials, applies its routing table & advanced settings then brings it to the desired state.
+// The currentCluster is nil when the cluster has just been created.
+func (s clusterService) applyConfiguration(ctx context.Context, organizationID string, clusterID string, currentCluster *cluster.Cluster, request cluster.UpsertServiceRequest) error {
+	if request.HasCredentialsChanges(currentCluster) {
+		if err := s.clusterRepository.EditCloudProviderInfo(ctx, organizationID, clusterID, request.CloudProviderInfoRequest()); err != nil {
+			return err
+		}
+	}
+
+	if len(request.RoutingTable) > 0 && request.HasRoutingTableChanges(currentCluster) {
+		if _, err := s.clusterRepository.EditRoutingTable(ctx, organizationID, clusterID, request.RoutingTable); err != nil {
+			return err
+		}
+	}
+
+	if request.HasAdvancedSettingsChanges(currentCluster) {
+		if _, err := s.clusterRepository.EditAdvancedSettings(ctx, organizationID, clusterID, request.AdvancedSettings); err != nil {
+			return err
+		}
+	}
+
+	return s.updateState(ctx, organizationID, clusterID, request.DesiredState, request.RequiresRedeploy(currentCluster))
+}
+
+// updateState brings the cluster to the desired state.
+// A deployed cluster is deployed again if forceRedeploy is true, so that its new configuration is applied.
+func (s clusterService) updateState(ctx context.Context, organizationID string, clusterID string, desiredState status.State, forceRedeploy bool) error {
+	getter := s.statusGetter(organizationID)
+
+	// Wait until the cluster is in a final state, otherwise the deploy or stop will fail.
+	if err := waitForTerminalState(ctx, getter, clusterID); err != nil {
+		return err
+	}
+
+	currentStatus, err := s.clusterRepository.GetStatus(ctx, organizationID, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if currentStatus.State == desiredState && !(desiredState == status.StateDeployed && forceRedeploy) {
+		return nil
+	}
+
+	switch desiredState {
+	case status.StateDeployed:
+		if _, err := s.clusterRepository.Deploy(ctx, organizationID, clusterID); err != nil {
+			return err
+		}
+	case status.StateStopped:
+		if _, err := s.clusterRepository.Stop(ctx, organizationID, clusterID); err != nil {
+			return err
+		}
+	default:
+		return cluster.ErrInvalidStateParam
+	}
+
+	return waitForState(ctx, getter, clusterID, desiredState)
+}
+
+// statusGetter returns a statusGetter retrieving the status of the clusters of the given organization.
+func (s clusterService) statusGetter(organizationID string) statusGetter {
+	return clusterStatusGetter{
+		clusterRepository: s.clusterRepository,
+		organizationID:    organizationID,
+	}
+}
+
+// checkOrganizationID validates that the given organizationID is valid.
+func (s clusterService) checkOrganizationID(organizationID string) error {
+	if organizationID == "" {
+		return cluster.ErrInvalidOrganizationIDParam
+	}
+
+	if _, err := uuid.Parse(organizationID); err != nil {
+		return errors.Wrap(err, cluster.ErrInvalidOrganizationIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkClusterID validates that the given clusterID is valid.
+func (s clusterService) checkClusterID(clusterID string) error {
+	if clusterID == "" {
+		return cluster.ErrInvalidClusterIDParam
+	}
+
+	if _, err := uuid.Parse(clusterID); err != nil {
+		return errors.Wrap(err, cluster.ErrInvalidClusterIDParam.Error())
+	}
+
+	return nil
+}
+
+// clusterStatusGetter adapts a cluster.Repository to the statusGetter used by the waiters, since clusters are scoped by organization.
+type clusterStatusGetter struct {
+	clusterRepository cluster.Repository
+	organizationID    string
+}
+
+// GetStatus returns the status of the given cluster.
+func (g clusterStatusGetter) GetStatus(ctx context.Context, clusterID string) (*status.Status, error) {
+	return g.clusterRepository.GetStatus(ctx, g.organizationID, clusterID)
+}
+
+// waitForClusterDeletion waits until the given cluster is deleted.
+// NOTE: the api may answer with a 400 Bad Request instead of a 404 Not Found once the cluster is deleted.
+func waitForClusterDeletion(ctx context.Context, getter statusGetter, clusterID string) error {
+	getState := newStateFunc(getter, clusterID)
+	return poller.UntilState(ctx, func(ctx context.Context) (status.State, error) {
+		state, err := getState(ctx)
+		if err != nil && (apierrors.IsErrNotFound(errors.Cause(err)) || apierrors.IsErrBadRequest(errors.Cause(err))) {
+			return status.StateDeleted, nil
+		}
+		return state, err
+	}, []status.State{status.StateDeleted})
+}
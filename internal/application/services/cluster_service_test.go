@@ -0,0 +1,352 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func newClusterUpsertServiceRequest() cluster.UpsertServiceRequest {
+	return cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: cluster.UpsertRepositoryRequest{
+			Name:            gofakeit.Name(),
+			CloudProvider:   cluster.CloudProviderAWS.String(),
+			Region:          "eu-west-3",
+			KubernetesMode:  cluster.KubernetesModeManaged.String(),
+			MinRunningNodes: pointer.ToInt32(3),
+			MaxRunningNodes: pointer.ToInt32(10),
+			Features:        cluster.Features{cluster.NewStaticIPFeature(true)},
+		},
+		CredentialsID:    gofakeit.UUID(),
+		RoutingTable:     cluster.RoutingTable{{Description: "peering", Destination: "10.0.0.0/20", Target: "pcx-1"}},
+		AdvancedSettings: cluster.AdvancedSettings{"loki.log_retention_in_week": 12},
+		DesiredState:     status.StateDeployed,
+	}
+}
+
+// newClusterFromRequest returns the cluster as it would be returned by the api once the given request is applied.
+func newClusterFromRequest(organizationID uuid.UUID, clusterID uuid.UUID, request cluster.UpsertServiceRequest) *cluster.Cluster {
+	return &cluster.Cluster{
+		ID:               clusterID,
+		OrganizationID:   organizationID,
+		CredentialsID:    uuid.MustParse(request.CredentialsID),
+		Name:             request.ClusterUpsertRequest.Name,
+		CloudProvider:    cluster.CloudProvider(request.ClusterUpsertRequest.CloudProvider),
+		Region:           request.ClusterUpsertRequest.Region,
+		KubernetesMode:   cluster.KubernetesMode(request.ClusterUpsertRequest.KubernetesMode),
+		Features:         request.ClusterUpsertRequest.Features,
+		RoutingTable:     request.RoutingTable,
+		AdvancedSettings: cluster.AdvancedSettings{"loki.log_retention_in_week": float64(12), "aws.vpc.enable_s3_flow_logs": false},
+		State:            status.StateDeployed,
+	}
+}
+
+// expectClusterStatus makes the mocked repository return the current state of the cluster.
+// The state is updated when the cluster is deployed or stopped.
+func expectClusterStatus(repository *mocks_test.ClusterRepository, organizationID string, clusterID string, initialState status.State) {
+	state := initialState
+	getStatus := func(context.Context, string, string) (*status.Status, error) {
+		return &status.Status{ID: uuid.MustParse(clusterID), State: state}, nil
+	}
+
+	repository.EXPECT().
+		GetStatus(mock.Anything, organizationID, clusterID).
+		RunAndReturn(getStatus).
+		Maybe()
+	repository.EXPECT().
+		Deploy(mock.Anything, organizationID, clusterID).
+		RunAndReturn(func(ctx context.Context, organizationID string, clusterID string) (*status.Status, error) {
+			state = status.StateDeployed
+			return getStatus(ctx, organizationID, clusterID)
+		}).
+		Maybe()
+	repository.EXPECT().
+		Stop(mock.Anything, organizationID, clusterID).
+		RunAndReturn(func(ctx context.Context, organizationID string, clusterID string) (*status.Status, error) {
+			state = status.StateStopped
+			return getStatus(ctx, organizationID, clusterID)
+		}).
+		Maybe()
+}
+
+func TestNewClusterService(t *testing.T) {
+	t.Parallel()
+
+	_, err := services.NewClusterService(nil)
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+
+	clusterService, err := services.NewClusterService(mocks_test.NewClusterRepository(t))
+	assert.NoError(t, err)
+	assert.NotNil(t, clusterService)
+}
+
+func TestClusterService_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	organizationID := uuid.New()
+	clusterID := uuid.New()
+	request := newClusterUpsertServiceRequest()
+	createdCluster := newClusterFromRequest(organizationID, clusterID, request)
+
+	clusterRepository := mocks_test.NewClusterRepository(t)
+	clusterRepository.EXPECT().
+		Create(mock.Anything, organizationID.String(), request.ClusterUpsertRequest).
+		Return(&cluster.Cluster{ID: clusterID}, nil).
+		Once()
+	clusterRepository.EXPECT().
+		EditCloudProviderInfo(mock.Anything, organizationID.String(), clusterID.String(), request.CloudProviderInfoRequest()).
+		Return(nil).
+		Once()
+	clusterRepository.EXPECT().
+		EditRoutingTable(mock.Anything, organizationID.String(), clusterID.String(), request.RoutingTable).
+		Return(request.RoutingTable, nil).
+		Once()
+	clusterRepository.EXPECT().
+		EditAdvancedSettings(mock.Anything, organizationID.String(), clusterID.String(), request.AdvancedSettings).
+		Return(createdCluster.AdvancedSettings, nil).
+		Once()
+	clusterRepository.EXPECT().
+		Get(mock.Anything, organizationID.String(), clusterID.String()).
+		Return(createdCluster, nil).
+		Once()
+	expectClusterStatus(clusterRepository, organizationID.String(), clusterID.String(), status.StateReady)
+
+	clusterService, err := services.NewClusterService(clusterRepository)
+	require.NoError(t, err)
+
+	c, err := clusterService.Create(ctx, organizationID.String(), request)
+	require.NoError(t, err)
+	assert.Equal(t, createdCluster, c)
+	clusterRepository.AssertCalled(t, "Deploy", mock.Anything, organizationID.String(), clusterID.String())
+}
+
+func TestClusterService_Create_FailWithInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	request := newClusterUpsertServiceRequest()
+	request.DesiredState = status.StateDeleted
+
+	clusterService, err := services.NewClusterService(mocks_test.NewClusterRepository(t))
+	require.NoError(t, err)
+
+	c, err := clusterService.Create(context.Background(), gofakeit.UUID(), request)
+	assert.ErrorContains(t, err, cluster.ErrFailedToCreateCluster.Error())
+	assert.ErrorContains(t, err, cluster.ErrInvalidStateParam.Error())
+	assert.Nil(t, c)
+}
+
+func TestClusterService_Update(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName                  string
+		Request                   func() cluster.UpsertServiceRequest
+		Current                   func(current *cluster.Cluster)
+		CurrentState              status.State
+		ExpectCloudProviderInfo   bool
+		ExpectRoutingTableEdit    bool
+		ExpectAdvancedSettingEdit bool
+		ExpectDeploy              bool
+		ExpectStop                bool
+	}{
+		{
+			TestName:     "nothing_to_apply_on_deployed_cluster",
+			Request:      newClusterUpsertServiceRequest,
+			CurrentState: status.StateDeployed,
+		},
+		{
+			TestName: "credentials_changes_do_not_redeploy",
+			Request:  newClusterUpsertServiceRequest,
+			Current: func(current *cluster.Cluster) {
+				current.CredentialsID = uuid.New()
+			},
+			CurrentState:            status.StateDeployed,
+			ExpectCloudProviderInfo: true,
+		},
+		{
+			TestName: "features_changes_redeploy",
+			Request:  newClusterUpsertServiceRequest,
+			Current: func(current *cluster.Cluster) {
+				current.Features = cluster.Features{cluster.NewStaticIPFeature(false)}
+			},
+			CurrentState: status.StateDeployed,
+			ExpectDeploy: true,
+		},
+		{
+			TestName: "routing_table_changes_are_applied_and_redeploy",
+			Request:  newClusterUpsertServiceRequest,
+			Current: func(current *cluster.Cluster) {
+				current.RoutingTable = cluster.RoutingTable{{Description: "old", Destination: "10.1.0.0/20", Target: "pcx-2"}}
+			},
+			CurrentState:           status.StateDeployed,
+			ExpectRoutingTableEdit: true,
+			ExpectDeploy:           true,
+		},
+		{
+			TestName: "advanced_settings_changes_are_applied_and_redeploy",
+			Request:  newClusterUpsertServiceRequest,
+			Current: func(current *cluster.Cluster) {
+				current.AdvancedSettings = cluster.AdvancedSettings{"loki.log_retention_in_week": float64(8)}
+			},
+			CurrentState:              status.StateDeployed,
+			ExpectAdvancedSettingEdit: true,
+			ExpectDeploy:              true,
+		},
+		{
+			TestName: "changes_on_stopped_cluster_do_not_deploy",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newClusterUpsertServiceRequest()
+				r.DesiredState = status.StateStopped
+				return r
+			},
+			Current: func(current *cluster.Cluster) {
+				current.AdvancedSettings = cluster.AdvancedSettings{"loki.log_retention_in_week": float64(8)}
+			},
+			CurrentState:              status.StateStopped,
+			ExpectAdvancedSettingEdit: true,
+		},
+		{
+			TestName:     "deploy_stopped_cluster",
+			Request:      newClusterUpsertServiceRequest,
+			CurrentState: status.StateStopped,
+			ExpectDeploy: true,
+		},
+		{
+			TestName: "stop_deployed_cluster",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newClusterUpsertServiceRequest()
+				r.DesiredState = status.StateStopped
+				return r
+			},
+			CurrentState: status.StateDeployed,
+			ExpectStop:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			ctx := context.Background()
+			organizationID := uuid.New()
+			clusterID := uuid.New()
+			request := tc.Request()
+
+			currentCluster := newClusterFromRequest(organizationID, clusterID, request)
+			if tc.Current != nil {
+				tc.Current(currentCluster)
+			}
+			updatedCluster := newClusterFromRequest(organizationID, clusterID, request)
+
+			clusterRepository := mocks_test.NewClusterRepository(t)
+			clusterRepository.EXPECT().
+				Get(mock.Anything, organizationID.String(), clusterID.String()).
+				Return(currentCluster, nil).
+				Once()
+			clusterRepository.EXPECT().
+				Update(mock.Anything, organizationID.String(), clusterID.String(), request.ClusterUpsertRequest).
+				Return(updatedCluster, nil).
+				Once()
+			if tc.ExpectCloudProviderInfo {
+				clusterRepository.EXPECT().
+					EditCloudProviderInfo(mock.Anything, organizationID.String(), clusterID.String(), request.CloudProviderInfoRequest()).
+					Return(nil).
+					Once()
+			}
+			if tc.ExpectRoutingTableEdit {
+				clusterRepository.EXPECT().
+					EditRoutingTable(mock.Anything, organizationID.String(), clusterID.String(), request.RoutingTable).
+					Return(request.RoutingTable, nil).
+					Once()
+			}
+			if tc.ExpectAdvancedSettingEdit {
+				clusterRepository.EXPECT().
+					EditAdvancedSettings(mock.Anything, organizationID.String(), clusterID.String(), request.AdvancedSettings).
+					Return(updatedCluster.AdvancedSettings, nil).
+					Once()
+			}
+			expectClusterStatus(clusterRepository, organizationID.String(), clusterID.String(), tc.CurrentState)
+			clusterRepository.EXPECT().
+				Get(mock.Anything, organizationID.String(), clusterID.String()).
+				Return(updatedCluster, nil).
+				Once()
+
+			clusterService, err := services.NewClusterService(clusterRepository)
+			require.NoError(t, err)
+
+			c, err := clusterService.Update(ctx, organizationID.String(), clusterID.String(), request)
+			require.NoError(t, err)
+			assert.Equal(t, updatedCluster, c)
+
+			if tc.ExpectDeploy {
+				clusterRepository.AssertCalled(t, "Deploy", mock.Anything, organizationID.String(), clusterID.String())
+			} else {
+				clusterRepository.AssertNotCalled(t, "Deploy", mock.Anything, organizationID.String(), clusterID.String())
+			}
+			if tc.ExpectStop {
+				clusterRepository.AssertCalled(t, "Stop", mock.Anything, organizationID.String(), clusterID.String())
+			} else {
+				clusterRepository.AssertNotCalled(t, "Stop", mock.Anything, organizationID.String(), clusterID.String())
+			}
+		})
+	}
+}
+
+func TestClusterService_Delete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName     string
+		DeletedError error
+	}{
+		{
+			TestName:     "success_when_cluster_is_not_found",
+			DeletedError: apierrors.NewNotFoundApiError(apierrors.ApiResourceCluster, gofakeit.UUID()),
+		},
+		{
+			TestName:     "success_when_cluster_status_is_a_bad_request",
+			DeletedError: apierrors.NewReadApiError(apierrors.ApiResourceClusterStatus, gofakeit.UUID(), &http.Response{StatusCode: http.StatusBadRequest}, nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			ctx := context.Background()
+			organizationID := uuid.New()
+			clusterID := uuid.New()
+
+			clusterRepository := mocks_test.NewClusterRepository(t)
+			clusterRepository.EXPECT().
+				GetStatus(mock.Anything, organizationID.String(), clusterID.String()).
+				Return(&status.Status{ID: clusterID, State: status.StateDeployed}, nil).
+				Once()
+			clusterRepository.EXPECT().
+				Delete(mock.Anything, organizationID.String(), clusterID.String()).
+				Return(nil).
+				Once()
+			clusterRepository.EXPECT().
+				GetStatus(mock.Anything, organizationID.String(), clusterID.String()).
+				Return(nil, tc.DeletedError).
+				Once()
+
+			clusterService, err := services.NewClusterService(clusterRepository)
+			require.NoError(t, err)
+
+			assert.NoError(t, clusterService.Delete(ctx, organizationID.String(), clusterID.String()))
+		})
+	}
+}
@@ -43,6 +43,16 @@ func (c containerRegistryService) Create(ctx context.Context, organizationID str
 		return nil, errors.Wrap(err, registry.ErrFailedToCreateRegistry.Error())
 	}
 
+	if request.ValidateCredentials {
+		if err := c.checkCredentials(ctx, organizationID, reg.ID.String()); err != nil {
+			// NOTE: the registry is deleted so it isn't left behind with credentials that can't be used.
+			if deleteErr := c.registryRepository.Delete(ctx, organizationID, reg.ID.String()); deleteErr != nil {
+				err = errors.Wrap(err, deleteErr.Error())
+			}
+			return nil, errors.Wrap(err, registry.ErrFailedToCreateRegistry.Error())
+		}
+	}
+
 	return reg, nil
 }
 
@@ -83,6 +93,12 @@ func (c containerRegistryService) Update(ctx context.Context, organizationID str
 		return nil, errors.Wrap(err, registry.ErrFailedToUpdateRegistry.Error())
 	}
 
+	if request.ValidateCredentials {
+		if err := c.checkCredentials(ctx, organizationID, registryID); err != nil {
+			return nil, errors.Wrap(err, registry.ErrFailedToUpdateRegistry.Error())
+		}
+	}
+
 	return reg, nil
 }
 
@@ -103,6 +119,15 @@ func (c containerRegistryService) Delete(ctx context.Context, organizationID str
 	return nil
 }
 
+// checkCredentials makes sure the registry accepts the credentials of its config.
+func (c containerRegistryService) checkCredentials(ctx context.Context, organizationID string, registryID string) error {
+	if err := c.registryRepository.CheckCredentials(ctx, organizationID, registryID); err != nil {
+		return errors.Wrap(err, registry.ErrInvalidRegistryCredentials.Error())
+	}
+
+	return nil
+}
+
 // checkOrganizationID validates that the given organizationID is valid.
 func (c containerRegistryService) checkOrganizationID(organizationID string) error {
 	if organizationID == "" {
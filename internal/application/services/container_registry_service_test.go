@@ -0,0 +1,153 @@
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func newContainerRegistryUpsertRequest(validateCredentials bool) registry.UpsertRequest {
+	return registry.UpsertRequest{
+		Name: gofakeit.Name(),
+		Kind: registry.KindGitHubCR.String(),
+		URL:  gofakeit.URL(),
+		Config: registry.UpsertRequestConfig{
+			Username: pointer.ToString(gofakeit.Username()),
+			Password: pointer.ToString(gofakeit.Password(true, true, true, false, false, 16)),
+		},
+		ValidateCredentials: validateCredentials,
+	}
+}
+
+func TestContainerRegistryService_Create(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName            string
+		Request             registry.UpsertRequest
+		CheckCredentialsErr error
+		ExpectedError       error
+	}{
+		{
+			TestName: "success_without_credentials_validation",
+			Request:  newContainerRegistryUpsertRequest(false),
+		},
+		{
+			TestName: "success_with_valid_credentials",
+			Request:  newContainerRegistryUpsertRequest(true),
+		},
+		{
+			TestName:            "fail_with_invalid_credentials",
+			Request:             newContainerRegistryUpsertRequest(true),
+			CheckCredentialsErr: errors.New("unauthorized"),
+			ExpectedError:       registry.ErrInvalidRegistryCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			organizationID := gofakeit.UUID()
+			registryID := uuid.New()
+			registryRepository := mocks_test.NewRegistryRepository(t)
+			registryRepository.EXPECT().
+				Create(mock.Anything, organizationID, tc.Request).
+				Return(&registry.Registry{ID: registryID, Name: tc.Request.Name}, nil).
+				Once()
+			if tc.Request.ValidateCredentials {
+				registryRepository.EXPECT().
+					CheckCredentials(mock.Anything, organizationID, registryID.String()).
+					Return(tc.CheckCredentialsErr).
+					Once()
+			}
+			if tc.CheckCredentialsErr != nil {
+				registryRepository.EXPECT().
+					Delete(mock.Anything, organizationID, registryID.String()).
+					Return(nil).
+					Once()
+			}
+
+			service, err := services.NewContainerRegistryService(registryRepository)
+			require.NoError(t, err)
+
+			reg, err := service.Create(context.Background(), organizationID, tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, registry.ErrFailedToCreateRegistry.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, reg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, registryID, reg.ID)
+		})
+	}
+}
+
+func TestContainerRegistryService_Update(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName            string
+		Request             registry.UpsertRequest
+		CheckCredentialsErr error
+		ExpectedError       error
+	}{
+		{
+			TestName: "success_with_valid_credentials",
+			Request:  newContainerRegistryUpsertRequest(true),
+		},
+		{
+			TestName:            "fail_with_invalid_credentials",
+			Request:             newContainerRegistryUpsertRequest(true),
+			CheckCredentialsErr: errors.New("unauthorized"),
+			ExpectedError:       registry.ErrInvalidRegistryCredentials,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			organizationID := gofakeit.UUID()
+			registryID := uuid.New()
+			registryRepository := mocks_test.NewRegistryRepository(t)
+			registryRepository.EXPECT().
+				Update(mock.Anything, organizationID, registryID.String(), tc.Request).
+				Return(&registry.Registry{ID: registryID, Name: tc.Request.Name}, nil).
+				Once()
+			registryRepository.EXPECT().
+				CheckCredentials(mock.Anything, organizationID, registryID.String()).
+				Return(tc.CheckCredentialsErr).
+				Once()
+
+			service, err := services.NewContainerRegistryService(registryRepository)
+			require.NoError(t, err)
+
+			reg, err := service.Update(context.Background(), organizationID, registryID.String(), tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, registry.ErrFailedToUpdateRegistry.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, reg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, registryID, reg.ID)
+		})
+	}
+}
@@ -144,7 +144,7 @@ func (s containerService) Delete(ctx context.Context, containerID string) error
 		return errors.Wrap(err, container.ErrFailedToDeleteContainer.Error())
 	}
 
-	if err := wait(ctx, waitNotFoundFunc(s.containerDeploymentService, containerID), nil); err != nil {
+	if err := waitForDeletion(ctx, s.containerDeploymentService, containerID); err != nil {
 		return errors.Wrap(err, container.ErrFailedToDeleteContainer.Error())
 	}
 
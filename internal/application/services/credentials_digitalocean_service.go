@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// credentialsDigitalOceanService implements the interface credentials.DigitalOceanService.
+type credentialsDigitalOceanService struct {
+	credentialsDigitalOceanRepository credentials.DigitalOceanRepository
+}
+
+// NOTE: This forces the implementation of the interface credentials.DigitalOceanService by credentialsDigitalOceanService at compile time.
+var _ credentials.DigitalOceanService = credentialsDigitalOceanService{}
+
+// NewCredentialsDigitalOceanService return a new instance of a credentials.DigitalOceanService that uses the given credentials.DigitalOceanRepository.
+func NewCredentialsDigitalOceanService(credentialsDigitalOceanRepository credentials.DigitalOceanRepository) (credentials.DigitalOceanService, error) {
+	if credentialsDigitalOceanRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &credentialsDigitalOceanService{
+		credentialsDigitalOceanRepository: credentialsDigitalOceanRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create a digitalocean cluster credentials.
+func (c credentialsDigitalOceanService) Create(ctx context.Context, organizationID string, request credentials.UpsertDigitalOceanRequest) (*credentials.Credentials, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToCreateDigitalOceanCredentials.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToCreateDigitalOceanCredentials.Error())
+	}
+
+	creds, err := c.credentialsDigitalOceanRepository.Create(ctx, organizationID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToCreateDigitalOceanCredentials.Error())
+	}
+
+	return creds, nil
+}
+
+// Get handles the domain logic to retrieve a digitalocean cluster credentials.
+func (c credentialsDigitalOceanService) Get(ctx context.Context, organizationID string, credentialsID string) (*credentials.Credentials, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToGetDigitalOceanCredentials.Error())
+	}
+
+	if err := c.checkCredentialsID(credentialsID); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToGetDigitalOceanCredentials.Error())
+	}
+
+	creds, err := c.credentialsDigitalOceanRepository.Get(ctx, organizationID, credentialsID)
+	if err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToGetDigitalOceanCredentials.Error())
+	}
+
+	return creds, nil
+}
+
+// Update handles the domain logic to update a digitalocean cluster credentials.
+func (c credentialsDigitalOceanService) Update(ctx context.Context, organizationID string, credentialsID string, request credentials.UpsertDigitalOceanRequest) (*credentials.Credentials, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToUpdateDigitalOceanCredentials.Error())
+	}
+
+	if err := c.checkCredentialsID(credentialsID); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToUpdateDigitalOceanCredentials.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToUpdateDigitalOceanCredentials.Error())
+	}
+
+	creds, err := c.credentialsDigitalOceanRepository.Update(ctx, organizationID, credentialsID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, credentials.ErrFailedToUpdateDigitalOceanCredentials.Error())
+	}
+
+	return creds, nil
+}
+
+// Delete handles the domain logic to delete a digitalocean cluster credentials.
+func (c credentialsDigitalOceanService) Delete(ctx context.Context, organizationID string, credentialsID string) error {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return errors.Wrap(err, credentials.ErrFailedToDeleteDigitalOceanCredentials.Error())
+	}
+
+	if err := c.checkCredentialsID(credentialsID); err != nil {
+		return errors.Wrap(err, credentials.ErrFailedToDeleteDigitalOceanCredentials.Error())
+	}
+
+	if err := c.credentialsDigitalOceanRepository.Delete(ctx, organizationID, credentialsID); err != nil {
+		return errors.Wrap(err, credentials.ErrFailedToDeleteDigitalOceanCredentials.Error())
+	}
+	return nil
+
+}
+
+// checkOrganizationID validates that the given organizationID is valid.
+func (c credentialsDigitalOceanService) checkOrganizationID(organizationID string) error {
+	if organizationID == "" {
+		return credentials.ErrInvalidOrganizationIDParam
+	}
+
+	if _, err := uuid.Parse(organizationID); err != nil {
+		return errors.Wrap(err, credentials.ErrInvalidOrganizationIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkCredentialsID validates that the given credentialsID is valid.
+func (c credentialsDigitalOceanService) checkCredentialsID(credentialsID string) error {
+	if credentialsID == "" {
+		return credentials.ErrInvalidCredentialsIDParam
+	}
+
+	if _, err := uuid.Parse(credentialsID); err != nil {
+		return errors.Wrap(err, credentials.ErrInvalidCredentialsIDParam.Error())
+	}
+
+	return nil
+}
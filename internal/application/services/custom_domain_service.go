@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
+)
+
+// Ensure customDomainService defined type fully satisfy the customdomain.Service interface.
+var _ customdomain.Service = customDomainService{}
+
+// customDomainService implements the interface customdomain.Service.
+type customDomainService struct {
+	customDomainRepository customdomain.Repository
+}
+
+// NewCustomDomainService return a new instance of a customdomain.Service that uses the given customdomain.Repository.
+func NewCustomDomainService(customDomainRepository customdomain.Repository) (customdomain.Service, error) {
+	if customDomainRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &customDomainService{
+		customDomainRepository: customDomainRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create a custom domain.
+func (c customDomainService) Create(ctx context.Context, resourceID string, request customdomain.UpsertRequest) (*customdomain.CustomDomain, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToCreateCustomDomain.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToCreateCustomDomain.Error())
+	}
+
+	d, err := c.customDomainRepository.Create(ctx, resourceID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToCreateCustomDomain.Error())
+	}
+
+	return d, nil
+}
+
+// Get handles the domain logic to retrieve a custom domain.
+func (c customDomainService) Get(ctx context.Context, resourceID string, customDomainID string) (*customdomain.CustomDomain, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToGetCustomDomain.Error())
+	}
+
+	if err := c.checkCustomDomainID(customDomainID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToGetCustomDomain.Error())
+	}
+
+	d, err := c.customDomainRepository.Get(ctx, resourceID, customDomainID)
+	if err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToGetCustomDomain.Error())
+	}
+
+	return d, nil
+}
+
+// Delete handles the domain logic to delete a custom domain.
+func (c customDomainService) Delete(ctx context.Context, resourceID string, customDomainID string) error {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return errors.Wrap(err, customdomain.ErrFailedToDeleteCustomDomain.Error())
+	}
+
+	if err := c.checkCustomDomainID(customDomainID); err != nil {
+		return errors.Wrap(err, customdomain.ErrFailedToDeleteCustomDomain.Error())
+	}
+
+	if err := c.customDomainRepository.Delete(ctx, resourceID, customDomainID); err != nil {
+		return errors.Wrap(err, customdomain.ErrFailedToDeleteCustomDomain.Error())
+	}
+
+	return nil
+}
+
+// WaitForCertificate handles the domain logic to wait until the certificate of a custom domain is issued.
+// The waiting stops when the given context is done.
+func (c customDomainService) WaitForCertificate(ctx context.Context, resourceID string, customDomainID string) (*customdomain.CustomDomain, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToWaitForCustomDomainCertificate.Error())
+	}
+
+	if err := c.checkCustomDomainID(customDomainID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToWaitForCustomDomainCertificate.Error())
+	}
+
+	var d *customdomain.CustomDomain
+	err := poller.Until(ctx, func(ctx context.Context) (bool, error) {
+		var err error
+		d, err = c.customDomainRepository.Get(ctx, resourceID, customDomainID)
+		if err != nil {
+			return false, err
+		}
+
+		return d.IsCertificateIssued(), nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToWaitForCustomDomainCertificate.Error())
+	}
+
+	return d, nil
+}
+
+// List handles the domain logic to retrieve a list of custom domains.
+func (c customDomainService) List(ctx context.Context, resourceID string) (customdomain.CustomDomains, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToListCustomDomains.Error())
+	}
+
+	customDomains, err := c.customDomainRepository.List(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToListCustomDomains.Error())
+	}
+
+	return customDomains, nil
+}
+
+// Update handles the domain logic to update the custom domains of a resource.
+func (c customDomainService) Update(ctx context.Context, resourceID string, request customdomain.DiffRequest) (customdomain.CustomDomains, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToUpdateCustomDomains.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, customdomain.ErrFailedToUpdateCustomDomains.Error())
+	}
+
+	customDomains := make(customdomain.CustomDomains, 0, len(request.Create))
+	for _, toDelete := range request.Delete {
+		err := c.customDomainRepository.Delete(ctx, resourceID, toDelete.CustomDomainID)
+		if err != nil {
+			return nil, errors.Wrap(err, customdomain.ErrFailedToUpdateCustomDomains.Error())
+		}
+	}
+
+	for _, toCreate := range request.Create {
+		d, err := c.customDomainRepository.Create(ctx, resourceID, toCreate.UpsertRequest)
+		if err != nil {
+			return nil, errors.Wrap(err, customdomain.ErrFailedToUpdateCustomDomains.Error())
+		}
+
+		customDomains = append(customDomains, *d)
+	}
+
+	return customDomains, nil
+}
+
+// checkResourceID validates that the given resourceID is valid.
+func (c customDomainService) checkResourceID(resourceID string) error {
+	if resourceID == "" {
+		return customdomain.ErrInvalidResourceIDParam
+	}
+
+	if _, err := uuid.Parse(resourceID); err != nil {
+		return errors.Wrap(err, customdomain.ErrInvalidResourceIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkCustomDomainID validates that the given customDomainID is valid.
+func (c customDomainService) checkCustomDomainID(customDomainID string) error {
+	if customDomainID == "" {
+		return customdomain.ErrInvalidCustomDomainIDParam
+	}
+
+	if _, err := uuid.Parse(customDomainID); err != nil {
+		return errors.Wrap(err, customdomain.ErrInvalidCustomDomainIDParam.Error())
+	}
+
+	return nil
+}
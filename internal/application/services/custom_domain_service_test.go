@@ -0,0 +1,188 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
+)
+
+func TestCustomDomainService_Create(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		ResourceID    string
+		Request       customdomain.UpsertRequest
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_invalid_resource_id",
+			ResourceID:    "not-a-uuid",
+			Request:       customdomain.UpsertRequest{Domain: gofakeit.DomainName()},
+			ExpectedError: customdomain.ErrInvalidResourceIDParam,
+		},
+		{
+			TestName:      "fail_with_invalid_request",
+			ResourceID:    gofakeit.UUID(),
+			ExpectedError: customdomain.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName:   "success",
+			ResourceID: gofakeit.UUID(),
+			Request:    customdomain.UpsertRequest{Domain: gofakeit.DomainName()},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			customDomainRepository := mocks_test.NewCustomDomainRepository(t)
+			if tc.ExpectedError == nil {
+				customDomainRepository.EXPECT().
+					Create(mock.Anything, tc.ResourceID, tc.Request).
+					Return(&customdomain.CustomDomain{ID: uuid.New(), Domain: tc.Request.Domain}, nil).
+					Once()
+			}
+
+			service, err := services.NewCustomDomainService(customDomainRepository)
+			require.NoError(t, err)
+
+			d, err := service.Create(context.Background(), tc.ResourceID, tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, customdomain.ErrFailedToCreateCustomDomain.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, d)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Request.Domain, d.Domain)
+		})
+	}
+}
+
+func TestCustomDomainService_Get(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName       string
+		ResourceID     string
+		CustomDomainID string
+		ExpectedError  error
+	}{
+		{
+			TestName:       "fail_with_invalid_resource_id",
+			CustomDomainID: gofakeit.UUID(),
+			ExpectedError:  customdomain.ErrInvalidResourceIDParam,
+		},
+		{
+			TestName:       "fail_with_invalid_custom_domain_id",
+			ResourceID:     gofakeit.UUID(),
+			CustomDomainID: "not-a-uuid",
+			ExpectedError:  customdomain.ErrInvalidCustomDomainIDParam,
+		},
+		{
+			TestName:       "success",
+			ResourceID:     gofakeit.UUID(),
+			CustomDomainID: gofakeit.UUID(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			customDomainRepository := mocks_test.NewCustomDomainRepository(t)
+			if tc.ExpectedError == nil {
+				customDomainRepository.EXPECT().
+					Get(mock.Anything, tc.ResourceID, tc.CustomDomainID).
+					Return(&customdomain.CustomDomain{ID: uuid.MustParse(tc.CustomDomainID), Domain: gofakeit.DomainName()}, nil).
+					Once()
+			}
+
+			service, err := services.NewCustomDomainService(customDomainRepository)
+			require.NoError(t, err)
+
+			d, err := service.Get(context.Background(), tc.ResourceID, tc.CustomDomainID)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, customdomain.ErrFailedToGetCustomDomain.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, d)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.CustomDomainID, d.ID.String())
+		})
+	}
+}
+
+func TestCustomDomainService_WaitForCertificate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Status        *string
+		ExpectedError error
+	}{
+		{
+			TestName: "success_with_issued_certificate",
+			Status:   pointer.ToString("VALIDATION_SUCCEEDED"),
+		},
+		{
+			TestName:      "fail_with_pending_certificate",
+			Status:        pointer.ToString(customdomain.StatusValidationPending),
+			ExpectedError: poller.ErrWaitTimeout,
+		},
+		{
+			TestName:      "fail_without_status",
+			ExpectedError: poller.ErrWaitTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			customDomainID := gofakeit.UUID()
+			customDomainRepository := mocks_test.NewCustomDomainRepository(t)
+			customDomainRepository.EXPECT().
+				Get(mock.Anything, resourceID, customDomainID).
+				Return(&customdomain.CustomDomain{ID: uuid.MustParse(customDomainID), Domain: gofakeit.DomainName(), Status: tc.Status}, nil)
+
+			service, err := services.NewCustomDomainService(customDomainRepository)
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			d, err := service.WaitForCertificate(ctx, resourceID, customDomainID)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, customdomain.ErrFailedToWaitForCustomDomainCertificate.Error())
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, d)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, d.IsCertificateIssued())
+		})
+	}
+}
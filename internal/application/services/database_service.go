@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure databaseService defined types fully satisfy the database.Service interface.
+var _ database.Service = databaseService{}
+
+// databaseService implements the interface database.Service.
+type databaseService struct {
+	databaseRepository         database.Repository
+	databaseDeploymentService  deployment.Service
+	environmentRepository      environment.Repository
+	environmentVariableService variable.Service
+}
+
+// NewDatabaseService return a new instance of a database.Service that uses the given database.Repository.
+// The environment.Repository & variable.Service are used to retrieve the cloud provider & the internal host of the database from its environment.
+func NewDatabaseService(databaseRepository database.Repository, databaseDeploymentService deployment.Service, environmentRepository environment.Repository, environmentVariableService variable.Service) (database.Service, error) {
+	if databaseRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if databaseDeploymentService == nil {
+		return nil, ErrInvalidService
+	}
+
+	if environmentRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	if environmentVariableService == nil {
+		return nil, ErrInvalidService
+	}
+
+	return &databaseService{
+		databaseRepository:         databaseRepository,
+		databaseDeploymentService:  databaseDeploymentService,
+		environmentRepository:      environmentRepository,
+		environmentVariableService: environmentVariableService,
+	}, nil
+}
+
+// Create handles the domain logic to create a database.
+func (s databaseService) Create(ctx context.Context, environmentID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	if err := s.checkEnvironmentID(environmentID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	env, err := s.environmentRepository.Get(ctx, environmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	if err := request.ValidateForCloudProvider(env.CloudProvider); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Create(ctx, environmentID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	db, err = s.refreshDatabase(ctx, *db)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToCreateDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Get handles the domain logic to retrieve a database.
+func (s databaseService) Get(ctx context.Context, databaseID string) (*database.Database, error) {
+	if err := s.checkID(databaseID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToGetDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Get(ctx, databaseID)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToGetDatabase.Error())
+	}
+
+	db, err = s.refreshDatabase(ctx, *db)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToGetDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Update handles the domain logic to update a database.
+func (s databaseService) Update(ctx context.Context, databaseID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	if err := s.checkID(databaseID); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	db, err := s.databaseRepository.Update(ctx, databaseID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	db, err = s.refreshDatabase(ctx, *db)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrFailedToUpdateDatabase.Error())
+	}
+
+	return db, nil
+}
+
+// Delete handles the domain logic to delete a database.
+func (s databaseService) Delete(ctx context.Context, databaseID string) error {
+	if err := s.checkID(databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	// Wait until the database is in a final state, otherwise the deletion will fail.
+	if err := waitForTerminalState(ctx, s.databaseDeploymentService, databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	if err := s.databaseRepository.Delete(ctx, databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	if err := waitForDeletion(ctx, s.databaseDeploymentService, databaseID); err != nil {
+		return errors.Wrap(err, database.ErrFailedToDeleteDatabase.Error())
+	}
+
+	return nil
+}
+
+func (s databaseService) refreshDatabase(ctx context.Context, db database.Database) (*database.Database, error) {
+	envVars, err := s.environmentVariableService.List(ctx, db.EnvironmentID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.databaseDeploymentService.GetStatus(ctx, db.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	db.SetInternalHost(envVars)
+
+	if err := db.SetState(status.State); err != nil {
+		return nil, err
+	}
+
+	return &db, nil
+}
+
+// checkEnvironmentID validates that the given environmentID is valid.
+func (s databaseService) checkEnvironmentID(environmentID string) error {
+	if environmentID == "" {
+		return database.ErrInvalidEnvironmentIDParam
+	}
+
+	if _, err := uuid.Parse(environmentID); err != nil {
+		return errors.Wrap(err, database.ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkID validates that the given databaseID is valid.
+func (s databaseService) checkID(databaseID string) error {
+	if databaseID == "" {
+		return database.ErrInvalidDatabaseIDParam
+	}
+
+	if _, err := uuid.Parse(databaseID); err != nil {
+		return errors.Wrap(err, database.ErrInvalidDatabaseIDParam.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestDatabaseService_Create(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		CloudProvider string
+		Type          database.Type
+		Mode          database.Mode
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_managed_redis_on_scaleway",
+			CloudProvider: "SCW",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeManaged,
+			ExpectedError: database.ErrUnsupportedModeParam,
+		},
+		{
+			TestName:      "success_with_managed_redis_on_aws",
+			CloudProvider: "AWS",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeManaged,
+		},
+		{
+			TestName:      "success_with_container_redis_on_scaleway",
+			CloudProvider: "SCW",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeContainer,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			ctx := context.Background()
+			environmentID := uuid.New()
+			databaseID := uuid.New()
+			request := database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    tc.Type.String(),
+				Version: "6",
+				Mode:    tc.Mode.String(),
+			}
+
+			environmentRepository := mocks_test.NewEnvironmentRepository(t)
+			environmentRepository.EXPECT().
+				Get(mock.Anything, environmentID.String()).
+				Return(&environment.Environment{ID: environmentID, CloudProvider: tc.CloudProvider}, nil)
+
+			databaseRepository := mocks_test.NewDatabaseRepository(t)
+			deploymentRepository := mocks_test.NewDeploymentRepository(t)
+			variableRepository := mocks_test.NewVariableRepository(t)
+			if tc.ExpectedError == nil {
+				databaseRepository.EXPECT().
+					Create(mock.Anything, environmentID.String(), request).
+					Return(&database.Database{
+						ID:            databaseID,
+						EnvironmentID: environmentID,
+						Name:          request.Name,
+						Type:          tc.Type,
+						Version:       request.Version,
+						Mode:          tc.Mode,
+						Accessibility: database.DefaultAccessibility,
+						CPU:           database.DefaultCPU,
+						Memory:        database.DefaultMemory,
+						Storage:       database.DefaultStorage,
+					}, nil)
+				deploymentRepository.EXPECT().
+					GetStatus(mock.Anything, databaseID.String()).
+					Return(&status.Status{ID: databaseID, State: status.StateReady}, nil)
+				variableRepository.EXPECT().
+					List(mock.Anything, environmentID.String()).
+					Return(variable.Variables{}, nil)
+			}
+
+			databaseService := newDatabaseService(t, databaseRepository, deploymentRepository, environmentRepository, variableRepository)
+
+			db, err := databaseService.Create(ctx, environmentID.String(), request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, db)
+				databaseRepository.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, db)
+			assert.Equal(t, databaseID, db.ID)
+			assert.Equal(t, status.StateStopped, db.State)
+		})
+	}
+}
+
+func TestDatabaseService_Update(t *testing.T) {
+	t.Parallel()
+
+	databaseID := uuid.New()
+
+	databaseRepository := mocks_test.NewDatabaseRepository(t)
+	databaseService := newDatabaseService(t, databaseRepository, mocks_test.NewDeploymentRepository(t), mocks_test.NewEnvironmentRepository(t), mocks_test.NewVariableRepository(t))
+
+	db, err := databaseService.Update(context.Background(), databaseID.String(), database.UpsertRepositoryRequest{
+		Name:    gofakeit.Name(),
+		Type:    database.TypePostgreSQL.String(),
+		Version: "13",
+		Mode:    database.ModeContainer.String(),
+		CPU:     pointer.ToInt32(database.MinCPU - 1),
+	})
+	assert.ErrorContains(t, err, database.ErrInvalidCPUParam.Error())
+	assert.Nil(t, db)
+	databaseRepository.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func newDatabaseService(t *testing.T, databaseRepository database.Repository, deploymentRepository *mocks_test.DeploymentRepository, environmentRepository environment.Repository, variableRepository variable.Repository) database.Service {
+	deploymentService, err := services.NewDeploymentService(deploymentRepository, envlock.New())
+	require.NoError(t, err)
+
+	variableService, err := services.NewVariableService(variableRepository)
+	require.NoError(t, err)
+
+	databaseService, err := services.NewDatabaseService(databaseRepository, deploymentService, environmentRepository, variableService)
+	require.NoError(t, err)
+
+	return databaseService
+}
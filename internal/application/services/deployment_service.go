@@ -4,38 +4,39 @@ import (
 	"context"
 	"time"
 
-	"github.com/AlekSi/pointer"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
 )
 
-const (
-	defaultWaitTimeout    = 1 * time.Hour
-	defaultWaitMaxRetries = 5
-)
-
-type waitFunc func(ctx context.Context) (bool, error)
-
 // Ensure deploymentService defined types fully satisfy the deployment.Service interface.
 var _ deployment.Service = deploymentService{}
 
 // deploymentService implements the interface deployment.Service.
 type deploymentService struct {
 	deploymentRepository deployment.Repository
+	environmentLocker    *envlock.Locker
 }
 
 // NewDeploymentService return a new instance of a deployment.Service that uses the given deployment.Repository.
-func NewDeploymentService(deploymentRepository deployment.Repository) (deployment.Service, error) {
+// The deploy, redeploy & stop actions are serialized per environment using the given envlock.Locker.
+func NewDeploymentService(deploymentRepository deployment.Repository, environmentLocker *envlock.Locker) (deployment.Service, error) {
 	if deploymentRepository == nil {
 		return nil, ErrInvalidRepository
 	}
 
+	if environmentLocker == nil {
+		return nil, ErrInvalidEnvironmentLocker
+	}
+
 	return &deploymentService{
 		deploymentRepository: deploymentRepository,
+		environmentLocker:    environmentLocker,
 	}, nil
 }
 
@@ -71,24 +72,39 @@ func (c deploymentService) Deploy(ctx context.Context, resourceID string, versio
 		return nil, errors.Wrap(err, deployment.ErrFailedToDeploy.Error())
 	}
 
+	unlock, err := c.lockEnvironment(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, deployment.ErrFailedToDeploy.Error())
+	}
+	defer unlock()
+
+	return c.deploy(ctx, resourceID, version)
+}
+
+// deploy deploys a resource, the lock of its environment must be held by the caller.
+func (c deploymentService) deploy(ctx context.Context, resourceID string, version string) (*status.Status, error) {
 	currentStatus, err := c.GetStatus(ctx, resourceID)
 	if err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToDeploy.Error())
 	}
 
-	switch currentStatus.State {
-	case status.StateDeployed:
+	// NOTE: when a version is given, the resource is deployed again to move it to that version.
+	switch {
+	case currentStatus.State == status.StateDeployed && version == "":
 		return currentStatus, nil
-	case status.StateDeploymentError:
-		return c.Redeploy(ctx, resourceID)
+	case currentStatus.State == status.StateDeploymentError && version == "":
+		return c.redeploy(ctx, resourceID)
 	default:
-		_, err := c.deploymentRepository.Deploy(ctx, resourceID, version)
+		err := retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := c.deploymentRepository.Deploy(ctx, resourceID, version)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, deployment.ErrFailedToDeploy.Error())
 		}
 	}
 
-	if err := c.wait(ctx, c.waitDesiredStateFunc(resourceID, status.StateDeployed), nil); err != nil {
+	if err := waitForState(ctx, c.deploymentRepository, resourceID, status.StateDeployed); err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToDeploy.Error())
 	}
 
@@ -101,7 +117,18 @@ func (c deploymentService) Redeploy(ctx context.Context, resourceID string) (*st
 		return nil, errors.Wrap(err, deployment.ErrFailedToRedeploy.Error())
 	}
 
-	if err := c.wait(ctx, c.waitFinalStateFunc(resourceID), nil); err != nil {
+	unlock, err := c.lockEnvironment(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, deployment.ErrFailedToRedeploy.Error())
+	}
+	defer unlock()
+
+	return c.redeploy(ctx, resourceID)
+}
+
+// redeploy redeploys a resource, the lock of its environment must be held by the caller.
+func (c deploymentService) redeploy(ctx context.Context, resourceID string) (*status.Status, error) {
+	if err := waitForTerminalState(ctx, c.deploymentRepository, resourceID); err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToRedeploy.Error())
 	}
 
@@ -114,13 +141,16 @@ func (c deploymentService) Redeploy(ctx context.Context, resourceID string) (*st
 	case status.StateReady:
 		return currentStatus, nil
 	default:
-		_, err := c.deploymentRepository.Redeploy(ctx, resourceID)
+		err := retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := c.deploymentRepository.Redeploy(ctx, resourceID)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, deployment.ErrFailedToRedeploy.Error())
 		}
 	}
 
-	if err := c.wait(ctx, c.waitDesiredStateFunc(resourceID, status.StateDeployed), nil); err != nil {
+	if err := waitForState(ctx, c.deploymentRepository, resourceID, status.StateDeployed); err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToRedeploy.Error())
 	}
 
@@ -133,6 +163,12 @@ func (c deploymentService) Stop(ctx context.Context, resourceID string) (*status
 		return nil, errors.Wrap(err, deployment.ErrFailedToStop.Error())
 	}
 
+	unlock, err := c.lockEnvironment(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, deployment.ErrFailedToStop.Error())
+	}
+	defer unlock()
+
 	currentStatus, err := c.GetStatus(ctx, resourceID)
 	if err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToStop.Error())
@@ -142,19 +178,33 @@ func (c deploymentService) Stop(ctx context.Context, resourceID string) (*status
 	case status.StateReady, status.StateStopped:
 		return currentStatus, nil
 	default:
-		_, err := c.deploymentRepository.Stop(ctx, resourceID)
+		err := retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := c.deploymentRepository.Stop(ctx, resourceID)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, deployment.ErrFailedToStop.Error())
 		}
 	}
 
-	if err := c.wait(ctx, c.waitDesiredStateFunc(resourceID, status.StateStopped), nil); err != nil {
+	if err := waitForState(ctx, c.deploymentRepository, resourceID, status.StateStopped); err != nil {
 		return nil, errors.Wrap(err, deployment.ErrFailedToStop.Error())
 	}
 
 	return c.GetStatus(ctx, resourceID)
 }
 
+// lockEnvironment takes the lock of the environment of the given resource.
+// It returns a function that must be called to release the lock.
+func (c deploymentService) lockEnvironment(ctx context.Context, resourceID string) (func(), error) {
+	environmentID, err := c.deploymentRepository.GetEnvironmentID(ctx, resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.environmentLocker.Lock(ctx, environmentID)
+}
+
 // checkResourceID validates that the given resourceID is valid.
 func (c deploymentService) checkResourceID(resourceID string) error {
 	if resourceID == "" {
@@ -168,117 +218,57 @@ func (c deploymentService) checkResourceID(resourceID string) error {
 	return nil
 }
 
-func (c deploymentService) wait(ctx context.Context, f waitFunc, timeout *time.Duration) error {
-	if timeout == nil {
-		timeout = pointer.ToDuration(defaultWaitTimeout)
-	}
-
-	// Run the function once before waiting
-	ok, err := f(ctx)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
-	}
-
-	ticker := time.NewTicker(10 * time.Second)
-	timeoutTicker := time.NewTicker(*timeout)
-
-	for {
-		select {
-		case <-timeoutTicker.C:
-			return nil
-		case <-ticker.C:
-			ok, err := f(ctx)
-			if err != nil {
-				return err
-			}
-			if ok {
-				return nil
-			}
-		}
-	}
+// statusGetter is implemented by both deployment.Repository and deployment.Service.
+type statusGetter interface {
+	GetStatus(ctx context.Context, resourceID string) (*status.Status, error)
 }
 
-func (c deploymentService) waitDesiredStateFunc(resourceID string, desiredState status.State) waitFunc {
-	return func(ctx context.Context) (bool, error) {
-		for tryCount := 0; tryCount < defaultWaitMaxRetries; tryCount++ {
-			currentStatus, err := c.deploymentRepository.GetStatus(ctx, resourceID)
-			if err != nil {
-				return false, err
-			}
-
-			isExpectedState := currentStatus.State == desiredState
-			if !isExpectedState && currentStatus.IsFinalState() {
-				time.Sleep(5 * time.Second)
-				continue
-			}
-
-			return isExpectedState, nil
-		}
-		return false, deployment.ErrUnexpectedState
-	}
+// waitForState waits until the given resource reaches one of the expected states.
+func waitForState(ctx context.Context, getter statusGetter, resourceID string, expected ...status.State) error {
+	return poller.UntilState(ctx, newStateFunc(getter, resourceID), expected)
 }
 
-func (c deploymentService) waitFinalStateFunc(resourceID string) waitFunc {
-	return waitFinalStateFunc(c.deploymentRepository, resourceID)
+// waitForTerminalState waits until the given resource reaches a terminal state, whether successful or not.
+func waitForTerminalState(ctx context.Context, getter statusGetter, resourceID string) error {
+	return poller.UntilTerminalState(ctx, newStateFunc(getter, resourceID))
 }
 
-func waitFinalStateFunc(deploymentRepository deployment.Repository, resourceID string) waitFunc {
-	return func(ctx context.Context) (bool, error) {
-		currentStatus, err := deploymentRepository.GetStatus(ctx, resourceID)
-		if err != nil {
-			return false, err
+// waitForDeletion waits until the given resource is deleted.
+// A resource that cannot be found anymore is considered as deleted.
+func waitForDeletion(ctx context.Context, getter statusGetter, resourceID string) error {
+	getState := newStateFunc(getter, resourceID)
+	return poller.UntilState(ctx, func(ctx context.Context) (status.State, error) {
+		state, err := getState(ctx)
+		if err != nil && apierrors.IsErrNotFound(errors.Cause(err)) {
+			return status.StateDeleted, nil
 		}
-
-		return currentStatus.IsFinalState(), nil
-	}
+		return state, err
+	}, []status.State{status.StateDeleted})
 }
 
-func waitNotFoundFunc(deploymentRepository deployment.Repository, resourceID string) waitFunc {
-	return func(ctx context.Context) (bool, error) {
-		_, err := deploymentRepository.GetStatus(ctx, resourceID)
+func newStateFunc(getter statusGetter, resourceID string) poller.StateFunc {
+	return func(ctx context.Context) (status.State, error) {
+		currentStatus, err := getter.GetStatus(ctx, resourceID)
 		if err != nil {
-			if apierrors.IsErrNotFound(errors.Cause(err)) {
-				return true, nil
-			}
-			return false, err
+			return "", err
 		}
 
-		return false, nil
+		return currentStatus.State, nil
 	}
 }
 
-func wait(ctx context.Context, f waitFunc, timeout *time.Duration) error {
-	if timeout == nil {
-		timeout = pointer.ToDuration(defaultWaitTimeout)
-	}
-
-	// Run the function once before waiting
-	ok, err := f(ctx)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
-	}
+// deploymentInProgressRetryInterval is the initial time to wait before trying again an action rejected because of a deployment in progress.
+const deploymentInProgressRetryInterval = time.Second
 
-	ticker := time.NewTicker(10 * time.Second)
-	timeoutTicker := time.NewTicker(*timeout)
-
-	for {
-		select {
-		case <-timeoutTicker.C:
-			return nil
-		case <-ticker.C:
-			ok, err := f(ctx)
-			if err != nil {
-				return err
-			}
-			if ok {
-				return nil
-			}
+// retryWhileDeploymentInProgress calls the given action again while it is rejected because a deployment is already in progress on the environment.
+// The environment lock only covers the actions of this provider, not the deployments started from the console or by another Terraform run.
+func retryWhileDeploymentInProgress(ctx context.Context, action func(ctx context.Context) error) error {
+	return poller.Until(ctx, func(ctx context.Context) (bool, error) {
+		err := action(ctx)
+		if err != nil && apierrors.IsErrDeploymentInProgress(errors.Cause(err)) {
+			return false, nil
 		}
-	}
+
+		return err == nil, err
+	}, poller.WithInterval(deploymentInProgressRetryInterval, poller.DefaultMaxInterval))
 }
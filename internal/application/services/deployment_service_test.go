@@ -0,0 +1,296 @@
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+// newDeploymentInProgressError returns the error sent by the api when a deployment is already in progress on the environment.
+func newDeploymentInProgressError(resourceID string) error {
+	return apierrors.NewDeployApiError(apierrors.ApiResourceApplication, resourceID, &http.Response{
+		StatusCode: http.StatusBadRequest,
+		Body:       io.NopCloser(bytes.NewBufferString(`{"message": "a deployment is already in progress on the environment"}`)),
+	}, nil)
+}
+
+// expectDeploymentStatus mocks the status of the given resource, updated by the deploy & stop actions.
+func expectDeploymentStatus(repository *mocks_test.DeploymentRepository, resourceID string, environmentID string, initialState status.State) {
+	var mu sync.Mutex
+	state := initialState
+	getStatus := func(context.Context, string) (*status.Status, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		return &status.Status{ID: uuid.MustParse(resourceID), State: state}, nil
+	}
+	setState := func(newState status.State) func(context.Context, string) (*status.Status, error) {
+		return func(ctx context.Context, resourceID string) (*status.Status, error) {
+			mu.Lock()
+			state = newState
+			mu.Unlock()
+			return getStatus(ctx, resourceID)
+		}
+	}
+
+	repository.EXPECT().
+		GetEnvironmentID(mock.Anything, resourceID).
+		Return(environmentID, nil).
+		Maybe()
+	repository.EXPECT().
+		GetStatus(mock.Anything, resourceID).
+		RunAndReturn(getStatus).
+		Maybe()
+	repository.EXPECT().
+		Deploy(mock.Anything, resourceID, mock.Anything).
+		RunAndReturn(func(ctx context.Context, resourceID string, _ string) (*status.Status, error) {
+			return setState(status.StateDeployed)(ctx, resourceID)
+		}).
+		Maybe()
+	repository.EXPECT().
+		Redeploy(mock.Anything, resourceID).
+		RunAndReturn(setState(status.StateDeployed)).
+		Maybe()
+	repository.EXPECT().
+		Stop(mock.Anything, resourceID).
+		RunAndReturn(setState(status.StateStopped)).
+		Maybe()
+}
+
+func TestNewDeploymentService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName             string
+		DeploymentRepository deployment.Repository
+		EnvironmentLocker    *envlock.Locker
+		ExpectedError        error
+	}{
+		{
+			TestName:          "fail_with_nil_repository",
+			EnvironmentLocker: envlock.New(),
+			ExpectedError:     services.ErrInvalidRepository,
+		},
+		{
+			TestName:             "fail_with_nil_environment_locker",
+			DeploymentRepository: mocks_test.NewDeploymentRepository(t),
+			ExpectedError:        services.ErrInvalidEnvironmentLocker,
+		},
+		{
+			TestName:             "success",
+			DeploymentRepository: mocks_test.NewDeploymentRepository(t),
+			EnvironmentLocker:    envlock.New(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			service, err := services.NewDeploymentService(tc.DeploymentRepository, tc.EnvironmentLocker)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, service)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, service)
+		})
+	}
+}
+
+func TestDeploymentService_UpdateState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		InitialState  status.State
+		DesiredState  status.State
+		Version       string
+		ExpectedCalls []string
+	}{
+		{
+			TestName:      "deploy_stopped_resource",
+			InitialState:  status.StateStopped,
+			DesiredState:  status.StateDeployed,
+			ExpectedCalls: []string{"Deploy"},
+		},
+		{
+			TestName:      "redeploy_resource_in_deployment_error",
+			InitialState:  status.StateDeploymentError,
+			DesiredState:  status.StateDeployed,
+			ExpectedCalls: []string{"Redeploy"},
+		},
+		{
+			TestName:     "keep_deployed_resource",
+			InitialState: status.StateDeployed,
+			DesiredState: status.StateDeployed,
+		},
+		{
+			TestName:      "deploy_deployed_resource_to_another_version",
+			InitialState:  status.StateDeployed,
+			DesiredState:  status.StateDeployed,
+			Version:       "42e2e5af9d49de268cd1fda3587788da4ace418a",
+			ExpectedCalls: []string{"Deploy"},
+		},
+		{
+			TestName:      "deploy_resource_in_deployment_error_to_another_version",
+			InitialState:  status.StateDeploymentError,
+			DesiredState:  status.StateDeployed,
+			Version:       "42e2e5af9d49de268cd1fda3587788da4ace418a",
+			ExpectedCalls: []string{"Deploy"},
+		},
+		{
+			TestName:      "stop_deployed_resource",
+			InitialState:  status.StateDeployed,
+			DesiredState:  status.StateStopped,
+			ExpectedCalls: []string{"Stop"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			deploymentRepository := mocks_test.NewDeploymentRepository(t)
+			expectDeploymentStatus(deploymentRepository, resourceID, gofakeit.UUID(), tc.InitialState)
+
+			service, err := services.NewDeploymentService(deploymentRepository, envlock.New())
+			require.NoError(t, err)
+
+			newStatus, err := service.UpdateState(context.Background(), resourceID, tc.DesiredState, tc.Version)
+			require.NoError(t, err)
+			assert.Equal(t, tc.DesiredState, newStatus.State)
+
+			for _, method := range []string{"Deploy", "Redeploy", "Stop"} {
+				var expectedCalls int
+				for _, expected := range tc.ExpectedCalls {
+					if expected == method {
+						expectedCalls++
+					}
+				}
+				deploymentRepository.AssertNumberOfCalls(t, method, expectedCalls)
+			}
+		})
+	}
+}
+
+func TestDeploymentService_SerializedPerEnvironment(t *testing.T) {
+	t.Parallel()
+
+	environmentID := gofakeit.UUID()
+	environmentLocker := envlock.New()
+
+	var running int32
+	var maxRunning int32
+	track := func() {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			previous := atomic.LoadInt32(&maxRunning)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		resourceID := gofakeit.UUID()
+		deploymentRepository := mocks_test.NewDeploymentRepository(t)
+		deploymentRepository.EXPECT().
+			GetEnvironmentID(mock.Anything, resourceID).
+			Return(environmentID, nil).
+			Once()
+		state := status.StateDeployed
+		deploymentRepository.EXPECT().
+			GetStatus(mock.Anything, resourceID).
+			RunAndReturn(func(context.Context, string) (*status.Status, error) {
+				return &status.Status{ID: uuid.MustParse(resourceID), State: state}, nil
+			})
+		deploymentRepository.EXPECT().
+			Stop(mock.Anything, resourceID).
+			RunAndReturn(func(context.Context, string) (*status.Status, error) {
+				track()
+				state = status.StateStopped
+				return &status.Status{ID: uuid.MustParse(resourceID), State: state}, nil
+			}).
+			Once()
+
+		service, err := services.NewDeploymentService(deploymentRepository, environmentLocker)
+		require.NoError(t, err)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			_, err := service.Stop(context.Background(), resourceID)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestDeploymentService_RetryWhileDeploymentInProgress(t *testing.T) {
+	t.Parallel()
+
+	resourceID := gofakeit.UUID()
+	deploymentRepository := mocks_test.NewDeploymentRepository(t)
+	deploymentRepository.EXPECT().
+		Deploy(mock.Anything, resourceID, mock.Anything).
+		Return(nil, newDeploymentInProgressError(resourceID)).
+		Once()
+	expectDeploymentStatus(deploymentRepository, resourceID, gofakeit.UUID(), status.StateStopped)
+
+	service, err := services.NewDeploymentService(deploymentRepository, envlock.New())
+	require.NoError(t, err)
+
+	newStatus, err := service.Deploy(context.Background(), resourceID, "")
+	require.NoError(t, err)
+	assert.Equal(t, status.StateDeployed, newStatus.State)
+	deploymentRepository.AssertNumberOfCalls(t, "Deploy", 2)
+}
+
+func TestDeploymentService_DoNotRetryOtherErrors(t *testing.T) {
+	t.Parallel()
+
+	resourceID := gofakeit.UUID()
+	deploymentRepository := mocks_test.NewDeploymentRepository(t)
+	deploymentRepository.EXPECT().
+		Stop(mock.Anything, resourceID).
+		Return(nil, apierrors.NewStopApiError(apierrors.ApiResourceApplication, resourceID, &http.Response{StatusCode: http.StatusForbidden, Body: http.NoBody}, nil)).
+		Once()
+	expectDeploymentStatus(deploymentRepository, resourceID, gofakeit.UUID(), status.StateDeployed)
+
+	service, err := services.NewDeploymentService(deploymentRepository, envlock.New())
+	require.NoError(t, err)
+
+	_, err = service.Stop(context.Background(), resourceID)
+	assert.ErrorContains(t, err, deployment.ErrFailedToStop.Error())
+	assert.True(t, apierrors.IsErrUnauthorized(err))
+	deploymentRepository.AssertNumberOfCalls(t, "Stop", 1)
+}
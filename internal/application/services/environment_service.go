@@ -153,7 +153,7 @@ func (s environmentService) Delete(ctx context.Context, environmentID string) er
 		return nil
 	}
 
-	if err := wait(ctx, waitFinalStateFunc(s.environmentDeploymentService, environmentID), nil); err != nil {
+	if err := waitForTerminalState(ctx, s.environmentDeploymentService, environmentID); err != nil {
 		return errors.Wrap(err, environment.ErrFailedToDeleteEnvironment.Error())
 	}
 
@@ -161,7 +161,7 @@ func (s environmentService) Delete(ctx context.Context, environmentID string) er
 		return errors.Wrap(err, environment.ErrFailedToDeleteEnvironment.Error())
 	}
 
-	if err := wait(ctx, waitNotFoundFunc(s.environmentDeploymentService, environmentID), nil); err != nil {
+	if err := waitForDeletion(ctx, s.environmentDeploymentService, environmentID); err != nil {
 		return errors.Wrap(err, environment.ErrFailedToDeleteEnvironment.Error())
 	}
 
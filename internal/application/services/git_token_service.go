@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+// Ensure gitTokenService defined types fully satisfy the gittoken.Service interface.
+var _ gittoken.Service = gitTokenService{}
+
+// gitTokenService implements the interface gittoken.Service.
+type gitTokenService struct {
+	gitTokenRepository gittoken.Repository
+}
+
+// NewGitTokenService return a new instance of a gittoken.Service that uses the given gittoken.Repository.
+func NewGitTokenService(gitTokenRepository gittoken.Repository) (gittoken.Service, error) {
+	if gitTokenRepository == nil {
+		return nil, ErrInvalidRepository
+	}
+
+	return &gitTokenService{
+		gitTokenRepository: gitTokenRepository,
+	}, nil
+}
+
+// Create handles the domain logic to create a git token.
+func (c gitTokenService) Create(ctx context.Context, organizationID string, request gittoken.UpsertRequest) (*gittoken.GitToken, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToCreateGitToken.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToCreateGitToken.Error())
+	}
+
+	token, err := c.gitTokenRepository.Create(ctx, organizationID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToCreateGitToken.Error())
+	}
+
+	return token, nil
+}
+
+// Get handles the domain logic to retrieve a git token.
+func (c gitTokenService) Get(ctx context.Context, organizationID string, gitTokenID string) (*gittoken.GitToken, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToGetGitToken.Error())
+	}
+
+	if err := c.checkGitTokenID(gitTokenID); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToGetGitToken.Error())
+	}
+
+	token, err := c.gitTokenRepository.Get(ctx, organizationID, gitTokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToGetGitToken.Error())
+	}
+
+	return token, nil
+}
+
+// Update handles the domain logic to update a git token.
+func (c gitTokenService) Update(ctx context.Context, organizationID string, gitTokenID string, request gittoken.UpsertRequest) (*gittoken.GitToken, error) {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToUpdateGitToken.Error())
+	}
+
+	if err := c.checkGitTokenID(gitTokenID); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToUpdateGitToken.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToUpdateGitToken.Error())
+	}
+
+	token, err := c.gitTokenRepository.Update(ctx, organizationID, gitTokenID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrFailedToUpdateGitToken.Error())
+	}
+
+	return token, nil
+}
+
+// Delete handles the domain logic to delete a git token.
+func (c gitTokenService) Delete(ctx context.Context, organizationID string, gitTokenID string) error {
+	if err := c.checkOrganizationID(organizationID); err != nil {
+		return errors.Wrap(err, gittoken.ErrFailedToDeleteGitToken.Error())
+	}
+
+	if err := c.checkGitTokenID(gitTokenID); err != nil {
+		return errors.Wrap(err, gittoken.ErrFailedToDeleteGitToken.Error())
+	}
+
+	if err := c.gitTokenRepository.Delete(ctx, organizationID, gitTokenID); err != nil {
+		return errors.Wrap(err, gittoken.ErrFailedToDeleteGitToken.Error())
+	}
+
+	return nil
+}
+
+// checkOrganizationID validates that the given organizationID is valid.
+func (c gitTokenService) checkOrganizationID(organizationID string) error {
+	if organizationID == "" {
+		return gittoken.ErrInvalidOrganizationIDParam
+	}
+
+	if _, err := uuid.Parse(organizationID); err != nil {
+		return errors.Wrap(err, gittoken.ErrInvalidOrganizationIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkGitTokenID validates that the given gitTokenID is valid.
+func (c gitTokenService) checkGitTokenID(gitTokenID string) error {
+	if gitTokenID == "" {
+		return gittoken.ErrInvalidGitTokenIDParam
+	}
+
+	if _, err := uuid.Parse(gitTokenID); err != nil {
+		return errors.Wrap(err, gittoken.ErrInvalidGitTokenIDParam.Error())
+	}
+
+	return nil
+}
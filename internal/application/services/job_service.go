@@ -74,6 +74,11 @@ func (s jobService) Create(ctx context.Context, environmentID string, request jo
 		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
 	}
 
+	newJob, err = s.deployGitCommit(ctx, *newJob, request.JobUpsertRequest.Source.GitCommitID())
+	if err != nil {
+		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
+	}
+
 	newJob, err = s.refreshJob(ctx, *newJob)
 	if err != nil {
 		return nil, errors.Wrap(err, job.ErrFailedToCreateJob.Error())
@@ -126,6 +131,11 @@ func (s jobService) Update(ctx context.Context, jobID string, request job.Upsert
 		return nil, errors.Wrap(err, job.ErrFailedToUpdateJob.Error())
 	}
 
+	updateJob, err = s.deployGitCommit(ctx, *updateJob, request.JobUpsertRequest.Source.GitCommitID())
+	if err != nil {
+		return nil, errors.Wrap(err, job.ErrFailedToUpdateJob.Error())
+	}
+
 	updateJob, err = s.refreshJob(ctx, *updateJob)
 	if err != nil {
 		return nil, errors.Wrap(err, job.ErrFailedToUpdateJob.Error())
@@ -144,13 +154,28 @@ func (s jobService) Delete(ctx context.Context, jobID string) error {
 		return errors.Wrap(err, job.ErrFailedToDeleteJob.Error())
 	}
 
-	if err := wait(ctx, waitNotFoundFunc(s.jobDeploymentService, jobID), nil); err != nil {
+	if err := waitForDeletion(ctx, s.jobDeploymentService, jobID); err != nil {
 		return errors.Wrap(err, job.ErrFailedToDeleteJob.Error())
 	}
 
 	return nil
 }
 
+// deployGitCommit deploys the given commit of the job git repository if it is not the one already deployed.
+// The job is fetched again once deployed so its deployed commit is up-to-date.
+func (s jobService) deployGitCommit(ctx context.Context, j job.Job, commitID *string) (*job.Job, error) {
+	deployedCommitID := j.Source.GitCommitID()
+	if commitID == nil || (deployedCommitID != nil && *deployedCommitID == *commitID) {
+		return &j, nil
+	}
+
+	if _, err := s.jobDeploymentService.Deploy(ctx, j.ID.String(), *commitID); err != nil {
+		return nil, err
+	}
+
+	return s.jobRepository.Get(ctx, j.ID.String())
+}
+
 func (s jobService) refreshJob(ctx context.Context, job job.Job) (*job.Job, error) {
 	envVars, err := s.variableService.List(ctx, job.ID.String())
 	if err != nil {
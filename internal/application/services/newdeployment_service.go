@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
 )
 
 var _ newdeployment.Service = newDeploymentService{}
@@ -13,9 +14,10 @@ var _ newdeployment.Service = newDeploymentService{}
 type newDeploymentService struct {
 	newDeploymentEnvironmentRepository newdeployment.EnvironmentRepository
 	deploymentStatusRepository         newdeployment.DeploymentStatusRepository
+	environmentLocker                  *envlock.Locker
 }
 
-func NewNewDeploymentService(newDeploymentEnvironmentRepository newdeployment.EnvironmentRepository, deploymentStatusRepository newdeployment.DeploymentStatusRepository) (newdeployment.Service, error) {
+func NewNewDeploymentService(newDeploymentEnvironmentRepository newdeployment.EnvironmentRepository, deploymentStatusRepository newdeployment.DeploymentStatusRepository, environmentLocker *envlock.Locker) (newdeployment.Service, error) {
 	if newDeploymentEnvironmentRepository == nil {
 		return nil, ErrInvalidRepository
 	}
@@ -24,9 +26,14 @@ func NewNewDeploymentService(newDeploymentEnvironmentRepository newdeployment.En
 		return nil, ErrInvalidRepository
 	}
 
+	if environmentLocker == nil {
+		return nil, ErrInvalidEnvironmentLocker
+	}
+
 	return &newDeploymentService{
 		newDeploymentEnvironmentRepository: newDeploymentEnvironmentRepository,
 		deploymentStatusRepository:         deploymentStatusRepository,
+		environmentLocker:                  environmentLocker,
 	}, nil
 }
 
@@ -40,9 +47,18 @@ func (s newDeploymentService) Create(ctx context.Context, params newdeployment.N
 		return nil, newdeployment.ErrDesiredStateForbiddenAtCreation
 	}
 
+	unlock, err := s.environmentLocker.Lock(ctx, deployment.EnvironmentID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
+	}
+	defer unlock()
+
 	switch deployment.DesiredState {
 	case newdeployment.RUNNING:
-		_, err = s.newDeploymentEnvironmentRepository.Deploy(ctx, *deployment)
+		err = retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := s.newDeploymentEnvironmentRepository.Deploy(ctx, *deployment)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
 		}
@@ -74,6 +90,12 @@ func (s newDeploymentService) Update(ctx context.Context, params newdeployment.N
 		return nil, err
 	}
 
+	unlock, err := s.environmentLocker.Lock(ctx, deployment.EnvironmentID.String())
+	if err != nil {
+		return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
+	}
+	defer unlock()
+
 	err = s.deploymentStatusRepository.WaitForTerminatedState(ctx, *deployment.EnvironmentID)
 	if err != nil {
 		return nil, errors.Wrap(err, newdeployment.ErrFailedToCheckDeploymentStatus.Error())
@@ -81,19 +103,28 @@ func (s newDeploymentService) Update(ctx context.Context, params newdeployment.N
 
 	switch deployment.DesiredState {
 	case newdeployment.RUNNING:
-		_, err = s.newDeploymentEnvironmentRepository.ReDeploy(ctx, *deployment)
+		err = retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := s.newDeploymentEnvironmentRepository.ReDeploy(ctx, *deployment)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
 		}
 		break
 	case newdeployment.STOPPED:
-		_, err = s.newDeploymentEnvironmentRepository.Stop(ctx, *deployment)
+		err = retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := s.newDeploymentEnvironmentRepository.Stop(ctx, *deployment)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
 		}
 		break
 	case newdeployment.RESTARTED:
-		_, err = s.newDeploymentEnvironmentRepository.Restart(ctx, *deployment)
+		err = retryWhileDeploymentInProgress(ctx, func(ctx context.Context) error {
+			_, err := s.newDeploymentEnvironmentRepository.Restart(ctx, *deployment)
+			return err
+		})
 		if err != nil {
 			return nil, errors.Wrap(err, newdeployment.ErrFailedToCreateDeployment.Error())
 		}
@@ -114,6 +145,12 @@ func (s newDeploymentService) Delete(ctx context.Context, params newdeployment.N
 		return err
 	}
 
+	unlock, err := s.environmentLocker.Lock(ctx, deployment.EnvironmentID.String())
+	if err != nil {
+		return errors.Wrap(err, newdeployment.ErrFailedToDeleteDeployment.Error())
+	}
+	defer unlock()
+
 	err = s.deploymentStatusRepository.WaitForTerminatedState(ctx, *deployment.EnvironmentID)
 	if err != nil {
 		return errors.Wrap(err, newdeployment.ErrFailedToCheckDeploymentStatus.Error())
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure secretKeyService defined type fully satisfy the secret.KeyService interface.
+var _ secret.KeyService = secretKeyService{}
+
+// secretKeyService implements the interface secret.KeyService.
+type secretKeyService struct {
+	secretRepositories map[variable.Scope]secret.Repository
+}
+
+// NewSecretKeyService return a new instance of a secret.KeyService that uses the given secret.Repository of each scope.
+func NewSecretKeyService(secretRepositories map[variable.Scope]secret.Repository) (secret.KeyService, error) {
+	for _, scope := range variable.KeyScopes {
+		if secretRepositories[scope] == nil {
+			return nil, ErrInvalidRepository
+		}
+	}
+
+	return &secretKeyService{
+		secretRepositories: secretRepositories,
+	}, nil
+}
+
+// ResolveScope handles the domain logic to find the scope of the given resource by listing its secrets with the repository of each scope.
+// It is meant to be called once, the resolved scope being given to the other calls afterwards.
+func (c secretKeyService) ResolveScope(ctx context.Context, resourceID string) (variable.Scope, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return "", errors.Wrap(err, secret.ErrFailedToResolveScope.Error())
+	}
+
+	for _, scope := range variable.KeyScopes {
+		if _, err := c.secretRepositories[scope].List(ctx, resourceID); err != nil {
+			if isScopeMismatch(err) {
+				continue
+			}
+			return "", errors.Wrap(err, secret.ErrFailedToResolveScope.Error())
+		}
+
+		return scope, nil
+	}
+
+	return "", errors.Wrap(secret.ErrScopeResourceNotFound, secret.ErrFailedToResolveScope.Error())
+}
+
+// Create handles the domain logic to create a secret in the scope of the given resource.
+func (c secretKeyService) Create(ctx context.Context, scope variable.Scope, resourceID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToCreateSecret.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToCreateSecret.Error())
+	}
+
+	s, err := repository.Create(ctx, resourceID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToCreateSecret.Error())
+	}
+
+	return s, nil
+}
+
+// Get handles the domain logic to retrieve the secret with the given key in the scope of the given resource.
+func (c secretKeyService) Get(ctx context.Context, scope variable.Scope, resourceID string, key string) (*secret.Secret, error) {
+	if key == "" {
+		return nil, errors.Wrap(secret.ErrInvalidKeyParam, secret.ErrFailedToGetSecret.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToGetSecret.Error())
+	}
+
+	secrets, err := repository.List(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToGetSecret.Error())
+	}
+
+	for _, s := range secrets {
+		if s.Scope == scope && s.Key == key {
+			return &s, nil
+		}
+	}
+
+	return nil, errors.Wrap(secret.ErrSecretNotFound, secret.ErrFailedToGetSecret.Error())
+}
+
+// Update handles the domain logic to update a secret in the scope of the given resource.
+func (c secretKeyService) Update(ctx context.Context, scope variable.Scope, resourceID string, secretID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	if err := c.checkSecretID(secretID); err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecret.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecret.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecret.Error())
+	}
+
+	s, err := repository.Update(ctx, resourceID, secretID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecret.Error())
+	}
+
+	return s, nil
+}
+
+// Delete handles the domain logic to delete a secret from the scope of the given resource.
+func (c secretKeyService) Delete(ctx context.Context, scope variable.Scope, resourceID string, secretID string) error {
+	if err := c.checkSecretID(secretID); err != nil {
+		return errors.Wrap(err, secret.ErrFailedToDeleteSecret.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return errors.Wrap(err, secret.ErrFailedToDeleteSecret.Error())
+	}
+
+	if err := repository.Delete(ctx, resourceID, secretID); err != nil {
+		return errors.Wrap(err, secret.ErrFailedToDeleteSecret.Error())
+	}
+
+	return nil
+}
+
+// getRepository validates the given scope and resourceID and returns the secret.Repository of the scope.
+func (c secretKeyService) getRepository(scope variable.Scope, resourceID string) (secret.Repository, error) {
+	if !slices.Contains(variable.KeyScopes, scope) {
+		return nil, secret.ErrInvalidScopeParam
+	}
+
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, err
+	}
+
+	return c.secretRepositories[scope], nil
+}
+
+// checkResourceID validates that the given resourceID is valid.
+func (c secretKeyService) checkResourceID(resourceID string) error {
+	if resourceID == "" {
+		return secret.ErrInvalidResourceIDParam
+	}
+
+	if _, err := uuid.Parse(resourceID); err != nil {
+		return errors.Wrap(err, secret.ErrInvalidResourceIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkSecretID validates that the given secretID is valid.
+func (c secretKeyService) checkSecretID(secretID string) error {
+	if secretID == "" {
+		return secret.ErrInvalidSecretIDParam
+	}
+
+	if _, err := uuid.Parse(secretID); err != nil {
+		return errors.Wrap(err, secret.ErrInvalidSecretIDParam.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestSecretKeyService_Get(t *testing.T) {
+	t.Parallel()
+
+	key := "MY_SECRET"
+	jobSecret := secret.Secret{ID: uuid.New(), Scope: variable.ScopeJob, Key: key}
+	inheritedSecret := secret.Secret{ID: uuid.New(), Scope: variable.ScopeEnvironment, Key: "INHERITED"}
+
+	testCases := []struct {
+		TestName       string
+		Key            string
+		ExpectedSecret *secret.Secret
+		ExpectedError  error
+	}{
+		{
+			TestName:       "success",
+			Key:            key,
+			ExpectedSecret: &jobSecret,
+		},
+		{
+			TestName:      "fail_with_inherited_secret",
+			Key:           inheritedSecret.Key,
+			ExpectedError: secret.ErrSecretNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			secretRepositories := make(map[variable.Scope]secret.Repository)
+			for _, scope := range variable.KeyScopes {
+				repository := mocks_test.NewSecretRepository(t)
+				if scope == variable.ScopeJob {
+					repository.EXPECT().
+						List(mock.Anything, resourceID).
+						Return(secret.Secrets{jobSecret, inheritedSecret}, nil).
+						Once()
+				}
+				secretRepositories[scope] = repository
+			}
+
+			service, err := services.NewSecretKeyService(secretRepositories)
+			require.NoError(t, err)
+
+			s, err := service.Get(context.Background(), variable.ScopeJob, resourceID, tc.Key)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, secret.ErrFailedToGetSecret.Error())
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, s)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedSecret, s)
+		})
+	}
+}
+
+func TestSecretKeyService_ResolveScope(t *testing.T) {
+	t.Parallel()
+
+	resourceID := gofakeit.UUID()
+	secretRepositories := make(map[variable.Scope]secret.Repository)
+	for _, scope := range variable.KeyScopes {
+		repository := mocks_test.NewSecretRepository(t)
+		switch scope {
+		case variable.ScopeApplication, variable.ScopeContainer:
+			repository.EXPECT().
+				List(mock.Anything, resourceID).
+				Return(nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationSecret, resourceID, &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil)).
+				Once()
+		case variable.ScopeJob:
+			repository.EXPECT().
+				List(mock.Anything, resourceID).
+				Return(secret.Secrets{}, nil).
+				Once()
+		}
+		secretRepositories[scope] = repository
+	}
+
+	service, err := services.NewSecretKeyService(secretRepositories)
+	require.NoError(t, err)
+
+	scope, err := service.ResolveScope(context.Background(), resourceID)
+	require.NoError(t, err)
+	assert.Equal(t, variable.ScopeJob, scope)
+}
@@ -52,7 +52,7 @@ func (c secretService) Update(ctx context.Context, resourceID string, request se
 		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
 	}
 
-	secrets := make(secret.Secrets, 0, len(request.Create)+len(request.Update))
+	secrets := make(secret.Secrets, 0, len(request.Create)+len(request.CreateAlias)+len(request.CreateOverride)+len(request.Update))
 	for _, toDelete := range request.Delete {
 		err := c.secretRepository.Delete(ctx, resourceID, toDelete.SecretID)
 		if err != nil {
@@ -78,6 +78,44 @@ func (c secretService) Update(ctx context.Context, resourceID string, request se
 		secrets = append(secrets, *v)
 	}
 
+	if len(request.CreateAlias) == 0 && len(request.CreateOverride) == 0 {
+		return secrets, nil
+	}
+
+	// Aliases and overrides reference other secrets by key, so they are created once every other secret is up-to-date.
+	existing, err := c.secretRepository.List(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
+	}
+
+	for _, toCreate := range request.CreateAlias {
+		aliased := existing.FindReferenceable(toCreate.AliasedSecretKey)
+		if aliased == nil {
+			return nil, errors.Wrap(errors.Wrap(secret.ErrReferencedSecretNotFound, toCreate.AliasedSecretKey), secret.ErrFailedToUpdateSecrets.Error())
+		}
+
+		v, err := c.secretRepository.CreateAlias(ctx, resourceID, aliased.ID.String(), toCreate.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
+		}
+
+		secrets = append(secrets, *v)
+	}
+
+	for _, toCreate := range request.CreateOverride {
+		overridden := existing.FindReferenceable(toCreate.OverriddenSecretKey)
+		if overridden == nil {
+			return nil, errors.Wrap(errors.Wrap(secret.ErrReferencedSecretNotFound, toCreate.OverriddenSecretKey), secret.ErrFailedToUpdateSecrets.Error())
+		}
+
+		v, err := c.secretRepository.CreateOverride(ctx, resourceID, overridden.ID.String(), toCreate.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, secret.ErrFailedToUpdateSecrets.Error())
+		}
+
+		secrets = append(secrets, *v)
+	}
+
 	return secrets, nil
 }
 
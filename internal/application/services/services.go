@@ -4,14 +4,22 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/organization"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
 	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories"
 )
 
@@ -20,6 +28,8 @@ var (
 	ErrInvalidRepository = errors.New("invalid repository")
 	// ErrInvalidService is the error return if the given service is nil or invalid.
 	ErrInvalidService = errors.New("invalid service")
+	// ErrInvalidEnvironmentLocker is the error return if the given environment locker is nil.
+	ErrInvalidEnvironmentLocker = errors.New("invalid environment locker")
 	// ErrMissingConfiguration is the error return if no configuration has been given.
 	ErrMissingConfiguration = errors.New("missing configuration")
 )
@@ -28,16 +38,26 @@ var (
 type Services struct {
 	repos *repositories.Repositories
 
-	CredentialsAws      credentials.AwsService
-	CredentialsScaleway credentials.ScalewayService
-	Organization        organization.Service
-	Project             project.Service
-	Container           container.Service
-	Job                 job.Service
-	ContainerRegistry   registry.Service
-	Environment         environment.Service
-	DeploymentStage     deploymentstage.Service
-	Deployment          newdeployment.Service
+	CredentialsAws          credentials.AwsService
+	CredentialsScaleway     credentials.ScalewayService
+	CredentialsGcp          credentials.GcpService
+	CredentialsDigitalOcean credentials.DigitalOceanService
+	Organization            organization.Service
+	Cluster                 cluster.Service
+	Project                 project.Service
+	Application             application.Service
+	ApplicationCustomDomain customdomain.Service
+	Container               container.Service
+	ContainerCustomDomain   customdomain.Service
+	Job                     job.Service
+	ContainerRegistry       registry.Service
+	GitToken                gittoken.Service
+	Database                database.Service
+	Environment             environment.Service
+	DeploymentStage         deploymentstage.Service
+	Deployment              newdeployment.Service
+	EnvironmentVariable     variable.KeyService
+	Secret                  secret.KeyService
 }
 
 // Configuration represents a function that handle the QoveryAPI configuration.
@@ -59,6 +79,9 @@ func New(configs ...Configuration) (*Services, error) {
 	}
 
 	// Initialize services implementations.
+	// The environment locker is shared by all the services so that a single deployment action runs at a time on an environment.
+	environmentLocker := envlock.New()
+
 	credentialsAwsService, err := NewCredentialsAwsService(services.repos.CredentialsAws)
 	if err != nil {
 		return nil, err
@@ -69,11 +92,26 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
+	credentialsGcpService, err := NewCredentialsGcpService(services.repos.CredentialsGcp)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsDigitalOceanService, err := NewCredentialsDigitalOceanService(services.repos.CredentialsDigitalOcean)
+	if err != nil {
+		return nil, err
+	}
+
 	organizationService, err := NewOrganizationService(services.repos.Organization)
 	if err != nil {
 		return nil, err
 	}
 
+	clusterService, err := NewClusterService(services.repos.Cluster)
+	if err != nil {
+		return nil, err
+	}
+
 	projectEnvironmentVariableService, err := NewVariableService(services.repos.ProjectEnvironmentVariable)
 	if err != nil {
 		return nil, err
@@ -89,7 +127,32 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
-	containerDeploymentService, err := NewDeploymentService(services.repos.ContainerDeployment)
+	applicationDeploymentService, err := NewDeploymentService(services.repos.ApplicationDeployment, environmentLocker)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationEnvironmentVariableService, err := NewVariableService(services.repos.ApplicationEnvironmentVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationSecretService, err := NewSecretService(services.repos.ApplicationSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationCustomDomainService, err := NewCustomDomainService(services.repos.ApplicationCustomDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationService, err := NewApplicationService(services.repos.Application, applicationDeploymentService, applicationEnvironmentVariableService, applicationSecretService, applicationCustomDomainService)
+	if err != nil {
+		return nil, err
+	}
+
+	containerDeploymentService, err := NewDeploymentService(services.repos.ContainerDeployment, environmentLocker)
 	if err != nil {
 		return nil, err
 	}
@@ -104,12 +167,17 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
+	containerCustomDomainService, err := NewCustomDomainService(services.repos.ContainerCustomDomain)
+	if err != nil {
+		return nil, err
+	}
+
 	containerService, err := NewContainerService(services.repos.Container, containerDeploymentService, containerEnvironmentVariableService, containerSecretService)
 	if err != nil {
 		return nil, err
 	}
 
-	jobDeploymentService, err := NewDeploymentService(services.repos.JobDeployment)
+	jobDeploymentService, err := NewDeploymentService(services.repos.JobDeployment, environmentLocker)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +202,12 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
-	environmentDeploymentService, err := NewDeploymentService(services.repos.EnvironmentDeployment)
+	gitTokenService, err := NewGitTokenService(services.repos.GitToken)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentDeploymentService, err := NewDeploymentService(services.repos.EnvironmentDeployment, environmentLocker)
 	if err != nil {
 		return nil, err
 	}
@@ -154,32 +227,77 @@ func New(configs ...Configuration) (*Services, error) {
 		return nil, err
 	}
 
+	databaseDeploymentService, err := NewDeploymentService(services.repos.DatabaseDeployment, environmentLocker)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseService, err := NewDatabaseService(services.repos.Database, databaseDeploymentService, services.repos.Environment, environmentEnvironmentVariableService)
+	if err != nil {
+		return nil, err
+	}
+
 	deploymentStageService, err := NewDeploymentStageService(services.repos.DeploymentStage)
 	if err != nil {
 		return nil, err
 	}
 
-	deploymentService, err := NewNewDeploymentService(services.repos.DeploymentEnvironment, services.repos.DeploymentStatus)
+	deploymentService, err := NewNewDeploymentService(services.repos.DeploymentEnvironment, services.repos.DeploymentStatus, environmentLocker)
+	if err != nil {
+		return nil, err
+	}
+	environmentVariableService, err := NewVariableKeyService(map[variable.Scope]variable.Repository{
+		variable.ScopeProject:     services.repos.ProjectEnvironmentVariable,
+		variable.ScopeEnvironment: services.repos.EnvironmentEnvironmentVariable,
+		variable.ScopeApplication: services.repos.ApplicationEnvironmentVariable,
+		variable.ScopeContainer:   services.repos.ContainerEnvironmentVariable,
+		variable.ScopeJob:         services.repos.JobEnvironmentVariable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secretService, err := NewSecretKeyService(map[variable.Scope]secret.Repository{
+		variable.ScopeProject:     services.repos.ProjectSecret,
+		variable.ScopeEnvironment: services.repos.EnvironmentSecret,
+		variable.ScopeApplication: services.repos.ApplicationSecret,
+		variable.ScopeContainer:   services.repos.ContainerSecret,
+		variable.ScopeJob:         services.repos.JobSecret,
+	})
 	if err != nil {
 		return nil, err
 	}
+
 	services.CredentialsAws = credentialsAwsService
 	services.CredentialsScaleway = credentialsScalewayService
+	services.CredentialsGcp = credentialsGcpService
+	services.CredentialsDigitalOcean = credentialsDigitalOceanService
 	services.Organization = organizationService
+	services.Cluster = clusterService
 	services.Project = projectService
+	services.Application = applicationService
+	services.ApplicationCustomDomain = applicationCustomDomainService
 	services.Container = containerService
+	services.ContainerCustomDomain = containerCustomDomainService
 	services.Job = jobService
 	services.ContainerRegistry = containerRegistryService
+	services.GitToken = gitTokenService
+	services.Database = databaseService
 	services.Environment = environmentService
 	services.DeploymentStage = deploymentStageService
 	services.Deployment = deploymentService
+	services.EnvironmentVariable = environmentVariableService
+	services.Secret = secretService
 
 	return services, nil
 }
 
-func WithQoveryRepository(apiToken string, providerVersion string) Configuration {
+// WithQoveryRepository configures the services to use repositories backed by Qovery's API.
+// If apiURL is empty, the default Qovery API server is used.
+// If maxAttempts is 0, the api calls are attempted httpretry.DefaultMaxAttempts times at most.
+func WithQoveryRepository(apiToken string, providerVersion string, apiURL string, maxAttempts int) Configuration {
 	return func(services *Services) error {
-		repos, err := repositories.New(repositories.WithQoveryAPI(apiToken, providerVersion))
+		repos, err := repositories.New(repositories.WithQoveryAPI(apiToken, providerVersion, apiURL, maxAttempts))
 		if err != nil {
 			return err
 		}
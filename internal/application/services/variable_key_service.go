@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure variableKeyService defined type fully satisfy the variable.KeyService interface.
+var _ variable.KeyService = variableKeyService{}
+
+// variableKeyService implements the interface variable.KeyService.
+type variableKeyService struct {
+	variableRepositories map[variable.Scope]variable.Repository
+}
+
+// NewVariableKeyService return a new instance of a variable.KeyService that uses the given variable.Repository of each scope.
+func NewVariableKeyService(variableRepositories map[variable.Scope]variable.Repository) (variable.KeyService, error) {
+	for _, scope := range variable.KeyScopes {
+		if variableRepositories[scope] == nil {
+			return nil, ErrInvalidRepository
+		}
+	}
+
+	return &variableKeyService{
+		variableRepositories: variableRepositories,
+	}, nil
+}
+
+// ResolveScope handles the domain logic to find the scope of the given resource by listing its variables with the repository of each scope.
+// It is meant to be called once, the resolved scope being given to the other calls afterwards.
+func (c variableKeyService) ResolveScope(ctx context.Context, resourceID string) (variable.Scope, error) {
+	if err := c.checkResourceID(resourceID); err != nil {
+		return "", errors.Wrap(err, variable.ErrFailedToResolveScope.Error())
+	}
+
+	for _, scope := range variable.KeyScopes {
+		if _, err := c.variableRepositories[scope].List(ctx, resourceID); err != nil {
+			if isScopeMismatch(err) {
+				continue
+			}
+			return "", errors.Wrap(err, variable.ErrFailedToResolveScope.Error())
+		}
+
+		return scope, nil
+	}
+
+	return "", errors.Wrap(variable.ErrScopeResourceNotFound, variable.ErrFailedToResolveScope.Error())
+}
+
+// Create handles the domain logic to create a variable in the scope of the given resource.
+func (c variableKeyService) Create(ctx context.Context, scope variable.Scope, resourceID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToCreateVariable.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToCreateVariable.Error())
+	}
+
+	v, err := repository.Create(ctx, resourceID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToCreateVariable.Error())
+	}
+
+	return v, nil
+}
+
+// Get handles the domain logic to retrieve the variable with the given key in the scope of the given resource.
+func (c variableKeyService) Get(ctx context.Context, scope variable.Scope, resourceID string, key string) (*variable.Variable, error) {
+	if key == "" {
+		return nil, errors.Wrap(variable.ErrInvalidKeyParam, variable.ErrFailedToGetVariable.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToGetVariable.Error())
+	}
+
+	vars, err := repository.List(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToGetVariable.Error())
+	}
+
+	for _, v := range vars {
+		if v.Scope == scope && v.Key == key {
+			return &v, nil
+		}
+	}
+
+	return nil, errors.Wrap(variable.ErrVariableNotFound, variable.ErrFailedToGetVariable.Error())
+}
+
+// Update handles the domain logic to update a variable in the scope of the given resource.
+func (c variableKeyService) Update(ctx context.Context, scope variable.Scope, resourceID string, variableID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	if err := c.checkVariableID(variableID); err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariable.Error())
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariable.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariable.Error())
+	}
+
+	v, err := repository.Update(ctx, resourceID, variableID, request)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariable.Error())
+	}
+
+	return v, nil
+}
+
+// Delete handles the domain logic to delete a variable from the scope of the given resource.
+func (c variableKeyService) Delete(ctx context.Context, scope variable.Scope, resourceID string, variableID string) error {
+	if err := c.checkVariableID(variableID); err != nil {
+		return errors.Wrap(err, variable.ErrFailedToDeleteVariable.Error())
+	}
+
+	repository, err := c.getRepository(scope, resourceID)
+	if err != nil {
+		return errors.Wrap(err, variable.ErrFailedToDeleteVariable.Error())
+	}
+
+	if err := repository.Delete(ctx, resourceID, variableID); err != nil {
+		return errors.Wrap(err, variable.ErrFailedToDeleteVariable.Error())
+	}
+
+	return nil
+}
+
+// getRepository validates the given scope and resourceID and returns the variable.Repository of the scope.
+func (c variableKeyService) getRepository(scope variable.Scope, resourceID string) (variable.Repository, error) {
+	if !slices.Contains(variable.KeyScopes, scope) {
+		return nil, variable.ErrInvalidScopeParam
+	}
+
+	if err := c.checkResourceID(resourceID); err != nil {
+		return nil, err
+	}
+
+	return c.variableRepositories[scope], nil
+}
+
+// checkResourceID validates that the given resourceID is valid.
+func (c variableKeyService) checkResourceID(resourceID string) error {
+	if resourceID == "" {
+		return variable.ErrInvalidResourceIDParam
+	}
+
+	if _, err := uuid.Parse(resourceID); err != nil {
+		return errors.Wrap(err, variable.ErrInvalidResourceIDParam.Error())
+	}
+
+	return nil
+}
+
+// checkVariableID validates that the given variableID is valid.
+func (c variableKeyService) checkVariableID(variableID string) error {
+	if variableID == "" {
+		return variable.ErrInvalidVariableIDParam
+	}
+
+	if _, err := uuid.Parse(variableID); err != nil {
+		return errors.Wrap(err, variable.ErrInvalidVariableIDParam.Error())
+	}
+
+	return nil
+}
+
+// isScopeMismatch returns true if the given error tells that the resource id doesn't belong to the scope of the api called.
+// NOTE: the api may answer with a 400 Bad Request instead of a 404 Not Found in that case.
+func isScopeMismatch(err error) bool {
+	return apierrors.IsErrNotFound(err) || apierrors.IsErrBadRequest(err)
+}
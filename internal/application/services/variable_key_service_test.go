@@ -0,0 +1,230 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+// newVariableRepositories returns a mocked variable.Repository for each scope a variable can be defined in.
+func newVariableRepositories(t *testing.T) map[variable.Scope]*mocks_test.VariableRepository {
+	repositories := make(map[variable.Scope]*mocks_test.VariableRepository)
+	for _, scope := range variable.KeyScopes {
+		repositories[scope] = mocks_test.NewVariableRepository(t)
+	}
+
+	return repositories
+}
+
+func newVariableKeyService(t *testing.T, repositories map[variable.Scope]*mocks_test.VariableRepository) variable.KeyService {
+	variableRepositories := make(map[variable.Scope]variable.Repository, len(repositories))
+	for scope, repository := range repositories {
+		variableRepositories[scope] = repository
+	}
+
+	service, err := services.NewVariableKeyService(variableRepositories)
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestNewVariableKeyService(t *testing.T) {
+	t.Parallel()
+
+	_, err := services.NewVariableKeyService(map[variable.Scope]variable.Repository{
+		variable.ScopeProject: mocks_test.NewVariableRepository(t),
+	})
+	assert.ErrorIs(t, err, services.ErrInvalidRepository)
+}
+
+func TestVariableKeyService_ResolveScope(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		StatusCodes   map[variable.Scope]int
+		ExpectedScope variable.Scope
+		ExpectedError error
+		// ExpectServerError is set if the error of the api is expected to be returned as is, instead of ExpectedError.
+		ExpectServerError bool
+	}{
+		{
+			TestName: "success",
+			StatusCodes: map[variable.Scope]int{
+				variable.ScopeApplication: http.StatusNotFound,
+				variable.ScopeContainer:   http.StatusBadRequest,
+				variable.ScopeJob:         http.StatusNotFound,
+				variable.ScopeEnvironment: http.StatusOK,
+			},
+			ExpectedScope: variable.ScopeEnvironment,
+		},
+		{
+			TestName: "fail_with_unknown_scope_resource",
+			StatusCodes: map[variable.Scope]int{
+				variable.ScopeApplication: http.StatusNotFound,
+				variable.ScopeContainer:   http.StatusNotFound,
+				variable.ScopeJob:         http.StatusNotFound,
+				variable.ScopeEnvironment: http.StatusNotFound,
+				variable.ScopeProject:     http.StatusNotFound,
+			},
+			ExpectedError: variable.ErrScopeResourceNotFound,
+		},
+		{
+			TestName: "fail_with_server_error",
+			StatusCodes: map[variable.Scope]int{
+				variable.ScopeApplication: http.StatusInternalServerError,
+			},
+			ExpectServerError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			repositories := newVariableRepositories(t)
+			for scope, statusCode := range tc.StatusCodes {
+				if statusCode == http.StatusOK {
+					repositories[scope].EXPECT().
+						List(mock.Anything, resourceID).
+						Return(variable.Variables{}, nil).
+						Once()
+					continue
+				}
+				repositories[scope].EXPECT().
+					List(mock.Anything, resourceID).
+					Return(nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationEnvironmentVariable, resourceID, &http.Response{StatusCode: statusCode, Body: http.NoBody}, nil)).
+					Once()
+			}
+			service := newVariableKeyService(t, repositories)
+
+			scope, err := service.ResolveScope(context.Background(), resourceID)
+			if tc.ExpectServerError {
+				assert.ErrorContains(t, err, variable.ErrFailedToResolveScope.Error())
+				assert.True(t, apierrors.IsErrServer(err))
+				return
+			}
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, variable.ErrFailedToResolveScope.Error())
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedScope, scope)
+		})
+	}
+}
+
+func TestVariableKeyService_Get(t *testing.T) {
+	t.Parallel()
+
+	key := "MY_KEY"
+	environmentVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeEnvironment, Key: key, Value: gofakeit.Word()}
+	inheritedVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeProject, Key: "INHERITED", Value: gofakeit.Word()}
+
+	testCases := []struct {
+		TestName         string
+		Scope            variable.Scope
+		Key              string
+		ExpectedVariable *variable.Variable
+		ExpectedError    error
+	}{
+		{
+			TestName:         "success",
+			Scope:            variable.ScopeEnvironment,
+			Key:              key,
+			ExpectedVariable: &environmentVariable,
+		},
+		{
+			TestName:      "fail_with_inherited_variable",
+			Scope:         variable.ScopeEnvironment,
+			Key:           inheritedVariable.Key,
+			ExpectedError: variable.ErrVariableNotFound,
+		},
+		{
+			TestName:      "fail_with_invalid_scope",
+			Scope:         variable.ScopeBuiltIn,
+			Key:           key,
+			ExpectedError: variable.ErrInvalidScopeParam,
+		},
+		{
+			TestName:      "fail_with_empty_key",
+			Scope:         variable.ScopeEnvironment,
+			ExpectedError: variable.ErrInvalidKeyParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			repositories := newVariableRepositories(t)
+			repositories[variable.ScopeEnvironment].EXPECT().
+				List(mock.Anything, resourceID).
+				Return(variable.Variables{environmentVariable, inheritedVariable}, nil).
+				Maybe()
+			service := newVariableKeyService(t, repositories)
+
+			v, err := service.Get(context.Background(), tc.Scope, resourceID, tc.Key)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, variable.ErrFailedToGetVariable.Error())
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				assert.Nil(t, v)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedVariable, v)
+		})
+	}
+}
+
+func TestVariableKeyService_Create(t *testing.T) {
+	t.Parallel()
+
+	resourceID := gofakeit.UUID()
+	request := variable.UpsertRequest{Key: "MY_KEY", Value: gofakeit.Word()}
+	repositories := newVariableRepositories(t)
+	repositories[variable.ScopeContainer].EXPECT().
+		Create(mock.Anything, resourceID, request).
+		Return(&variable.Variable{ID: uuid.New(), Scope: variable.ScopeContainer, Key: request.Key, Value: request.Value}, nil).
+		Once()
+	service := newVariableKeyService(t, repositories)
+
+	v, err := service.Create(context.Background(), variable.ScopeContainer, resourceID, request)
+	require.NoError(t, err)
+	assert.Equal(t, variable.ScopeContainer, v.Scope)
+	assert.Equal(t, request.Key, v.Key)
+}
+
+func TestVariableKeyService_Delete(t *testing.T) {
+	t.Parallel()
+
+	resourceID := gofakeit.UUID()
+	variableID := gofakeit.UUID()
+	repositories := newVariableRepositories(t)
+	repositories[variable.ScopeJob].EXPECT().
+		Delete(mock.Anything, resourceID, variableID).
+		Return(nil).
+		Once()
+	service := newVariableKeyService(t, repositories)
+
+	err := service.Delete(context.Background(), variable.ScopeJob, resourceID, variableID)
+	assert.NoError(t, err)
+}
@@ -52,7 +52,7 @@ func (c variableService) Update(ctx context.Context, resourceID string, request
 		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
 	}
 
-	variables := make(variable.Variables, 0, len(request.Create)+len(request.Update))
+	variables := make(variable.Variables, 0, len(request.Create)+len(request.CreateAlias)+len(request.CreateOverride)+len(request.Update))
 	for _, toDelete := range request.Delete {
 		err := c.variableRepository.Delete(ctx, resourceID, toDelete.VariableID)
 		if err != nil {
@@ -78,6 +78,44 @@ func (c variableService) Update(ctx context.Context, resourceID string, request
 		variables = append(variables, *v)
 	}
 
+	if len(request.CreateAlias) == 0 && len(request.CreateOverride) == 0 {
+		return variables, nil
+	}
+
+	// Aliases and overrides reference other variables by key, so they are created once every other variable is up-to-date.
+	existing, err := c.variableRepository.List(ctx, resourceID)
+	if err != nil {
+		return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
+	}
+
+	for _, toCreate := range request.CreateAlias {
+		aliased := existing.FindReferenceable(toCreate.AliasedVariableKey)
+		if aliased == nil {
+			return nil, errors.Wrap(errors.Wrap(variable.ErrReferencedVariableNotFound, toCreate.AliasedVariableKey), variable.ErrFailedToUpdateVariables.Error())
+		}
+
+		v, err := c.variableRepository.CreateAlias(ctx, resourceID, aliased.ID.String(), toCreate.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
+		}
+
+		variables = append(variables, *v)
+	}
+
+	for _, toCreate := range request.CreateOverride {
+		overridden := existing.FindReferenceable(toCreate.OverriddenVariableKey)
+		if overridden == nil {
+			return nil, errors.Wrap(errors.Wrap(variable.ErrReferencedVariableNotFound, toCreate.OverriddenVariableKey), variable.ErrFailedToUpdateVariables.Error())
+		}
+
+		v, err := c.variableRepository.CreateOverride(ctx, resourceID, overridden.ID.String(), toCreate.Value)
+		if err != nil {
+			return nil, errors.Wrap(err, variable.ErrFailedToUpdateVariables.Error())
+		}
+
+		variables = append(variables, *v)
+	}
+
 	return variables, nil
 }
 
@@ -0,0 +1,109 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestVariableService_UpdateWithAliasesAndOverrides(t *testing.T) {
+	t.Parallel()
+
+	builtInVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Type: variable.TypeBuiltIn, Key: "QOVERY_POSTGRESQL_Z123_HOST", Value: gofakeit.DomainName()}
+	projectVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeProject, Type: variable.TypeValue, Key: "LOG_LEVEL", Value: "info"}
+	environmentVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeEnvironment, Type: variable.TypeValue, Key: "LOG_LEVEL", Value: "warn"}
+	existingAlias := variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeAlias, Key: "QOVERY_POSTGRESQL_Z123_HOST", Value: gofakeit.DomainName()}
+
+	testCases := []struct {
+		TestName             string
+		Request              variable.DiffRequest
+		ExpectedReferencedID uuid.UUID
+		ExpectedError        error
+	}{
+		{
+			TestName: "success_with_alias_of_built_in_variable",
+			Request: variable.DiffRequest{
+				CreateAlias: []variable.DiffCreateAliasRequest{
+					{Key: "DATABASE_HOST", AliasedVariableKey: builtInVariable.Key},
+				},
+			},
+			ExpectedReferencedID: builtInVariable.ID,
+		},
+		{
+			TestName: "success_with_override_of_most_specific_variable",
+			Request: variable.DiffRequest{
+				CreateOverride: []variable.DiffCreateOverrideRequest{
+					{OverriddenVariableKey: projectVariable.Key, Value: "debug"},
+				},
+			},
+			ExpectedReferencedID: environmentVariable.ID,
+		},
+		{
+			TestName: "fail_with_unknown_referenced_variable",
+			Request: variable.DiffRequest{
+				CreateAlias: []variable.DiffCreateAliasRequest{
+					{Key: "DATABASE_HOST", AliasedVariableKey: "UNKNOWN"},
+				},
+			},
+			ExpectedError: variable.ErrReferencedVariableNotFound,
+		},
+		{
+			TestName: "fail_with_invalid_request",
+			Request: variable.DiffRequest{
+				CreateOverride: []variable.DiffCreateOverrideRequest{
+					{OverriddenVariableKey: projectVariable.Key},
+				},
+			},
+			ExpectedError: variable.ErrInvalidDiffRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			resourceID := gofakeit.UUID()
+			variableRepository := mocks_test.NewVariableRepository(t)
+			variableRepository.EXPECT().
+				List(mock.Anything, resourceID).
+				Return(variable.Variables{existingAlias, builtInVariable, projectVariable, environmentVariable}, nil).
+				Maybe()
+			for _, a := range tc.Request.CreateAlias {
+				variableRepository.EXPECT().
+					CreateAlias(mock.Anything, resourceID, tc.ExpectedReferencedID.String(), a.Key).
+					Return(&variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeAlias, Key: a.Key}, nil).
+					Maybe()
+			}
+			for _, o := range tc.Request.CreateOverride {
+				variableRepository.EXPECT().
+					CreateOverride(mock.Anything, resourceID, tc.ExpectedReferencedID.String(), o.Value).
+					Return(&variable.Variable{ID: uuid.New(), Scope: variable.ScopeApplication, Type: variable.TypeOverride, Key: o.OverriddenVariableKey, Value: o.Value}, nil).
+					Maybe()
+			}
+
+			service, err := services.NewVariableService(variableRepository)
+			require.NoError(t, err)
+
+			vars, err := service.Update(context.Background(), resourceID, tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, variable.ErrFailedToUpdateVariables.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, vars)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, vars, len(tc.Request.CreateAlias)+len(tc.Request.CreateOverride))
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package advancedsettings_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+func TestAdvancedSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, advancedsettings.AdvancedSettings{"deployment.termination_grace_period_seconds": 60}.IsValid())
+
+	err := advancedsettings.AdvancedSettings{"invalid": make(chan int)}.Validate()
+	assert.ErrorContains(t, err, advancedsettings.ErrInvalidAdvancedSettings.Error())
+}
+
+func TestAdvancedSettings_HasChanges(t *testing.T) {
+	t.Parallel()
+
+	current := advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": float64(60),
+		"network.ingress.enable_cors":                 false,
+		"network.ingress.cors_allow_origin":           "*",
+	}
+
+	testCases := []struct {
+		TestName        string
+		Settings        advancedsettings.AdvancedSettings
+		ExpectedChanges bool
+	}{
+		{
+			TestName:        "no_changes_when_empty",
+			Settings:        advancedsettings.AdvancedSettings{},
+			ExpectedChanges: false,
+		},
+		{
+			TestName:        "no_changes_with_go_typed_values",
+			Settings:        advancedsettings.AdvancedSettings{"deployment.termination_grace_period_seconds": int64(60), "network.ingress.enable_cors": false},
+			ExpectedChanges: false,
+		},
+		{
+			TestName:        "changes_with_different_value",
+			Settings:        advancedsettings.AdvancedSettings{"network.ingress.cors_allow_origin": "https://qovery.com"},
+			ExpectedChanges: true,
+		},
+		{
+			TestName:        "changes_with_unknown_key",
+			Settings:        advancedsettings.AdvancedSettings{"network.ingress.proxy_body_size_mb": 100},
+			ExpectedChanges: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedChanges, tc.Settings.HasChanges(current))
+		})
+	}
+}
+
+func TestAdvancedSettings_Merge(t *testing.T) {
+	t.Parallel()
+
+	current := advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": float64(60),
+		"network.ingress.enable_cors":                 false,
+	}
+	settings := advancedsettings.AdvancedSettings{
+		"network.ingress.enable_cors":        true,
+		"network.ingress.proxy_body_size_mb": 100,
+	}
+
+	assert.Equal(t, advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": float64(60),
+		"network.ingress.enable_cors":                 true,
+		"network.ingress.proxy_body_size_mb":          100,
+	}, settings.Merge(current))
+	assert.Equal(t, float64(60), current["deployment.termination_grace_period_seconds"])
+	assert.Equal(t, false, current["network.ingress.enable_cors"])
+}
@@ -0,0 +1,65 @@
+package apierrors
+
+import (
+	"net/http"
+)
+
+// ApiErrorKind is an enum that classifies the errors returned by the api.
+// This is used to decide how to react to an error and to give actionable error messages to the users.
+type ApiErrorKind string
+
+const (
+	ApiErrorKindNotFound     ApiErrorKind = "not_found"
+	ApiErrorKindConflict     ApiErrorKind = "conflict"
+	ApiErrorKindUnauthorized ApiErrorKind = "unauthorized"
+	ApiErrorKindValidation   ApiErrorKind = "validation"
+	ApiErrorKindRateLimited  ApiErrorKind = "rate_limited"
+	ApiErrorKindServer       ApiErrorKind = "server"
+	ApiErrorKindUnknown      ApiErrorKind = "unknown"
+)
+
+// String returns the string value of an ApiErrorKind.
+func (k ApiErrorKind) String() string {
+	return string(k)
+}
+
+// Hint returns an actionable message telling the user how to handle an error of this ApiErrorKind.
+func (k ApiErrorKind) Hint() string {
+	switch k {
+	case ApiErrorKindNotFound:
+		return "The resource does not exist anymore: it may have been deleted outside of Terraform."
+	case ApiErrorKindConflict:
+		return "Another operation is in progress on this resource: wait for it to finish and try again."
+	case ApiErrorKindUnauthorized:
+		return "Check that your API token is valid and that it has access to this resource."
+	case ApiErrorKindValidation:
+		return "The request was rejected by the Qovery API: check the values of the resource attributes."
+	case ApiErrorKindRateLimited:
+		return "Too many requests were sent to the Qovery API: try again later."
+	case ApiErrorKindServer:
+		return "The Qovery API failed to handle the request: try again later and contact the Qovery support if the error persists."
+	default:
+		return ""
+	}
+}
+
+// newApiErrorKindFromStatusCode classifies an api error using the status code of its response.
+// A status code of 0 means that no response was received (i.e: network error).
+func newApiErrorKindFromStatusCode(statusCode int) ApiErrorKind {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ApiErrorKindNotFound
+	case statusCode == http.StatusConflict || statusCode == http.StatusLocked:
+		return ApiErrorKindConflict
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return ApiErrorKindUnauthorized
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return ApiErrorKindValidation
+	case statusCode == http.StatusTooManyRequests:
+		return ApiErrorKindRateLimited
+	case statusCode >= http.StatusInternalServerError:
+		return ApiErrorKindServer
+	default:
+		return ApiErrorKindUnknown
+	}
+}
@@ -1,6 +1,7 @@
 package apierrors
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,9 +11,9 @@ import (
 	"github.com/pkg/errors"
 )
 
-// apiErrorPayload represents the error payload that comes from Qovery's API client.
+// ApiErrorPayload represents the error payload that comes from Qovery's API client.
 // It is used to get the actual error message from the api.
-type apiErrorPayload struct {
+type ApiErrorPayload struct {
 	Status    int    `json:"status"`
 	Error     string `json:"error"`
 	Message   string `json:"message"`
@@ -23,33 +24,82 @@ type apiErrorPayload struct {
 // ApiError represents an error that comes from Qovery's API client.
 // It contains all the information needed to understand an api error.
 type ApiError struct {
-	err        error          // err is the actual error returned by the api client.
-	action     ApiAction      // action that produced the api client error.
-	resource   ApiResource    // resource that produced the api client error.
-	resourceID string         // resourceID that produced the api client error. [NOTE: it is replaced by the resource name in some cases (for failed create requests)]
-	resp       *http.Response // resp is the response returned by the api client.
+	err        error            // err is the actual error returned by the api client.
+	action     ApiAction        // action that produced the api client error.
+	resource   ApiResource      // resource that produced the api client error.
+	resourceID string           // resourceID that produced the api client error. [NOTE: it is replaced by the resource name in some cases (for failed create requests)]
+	resp       *http.Response   // resp is the response returned by the api client.
+	kind       ApiErrorKind     // kind classifies the error using the response status code.
+	payload    *ApiErrorPayload // payload is the error payload sent by the api, nil if the body is empty or not a json payload.
 }
 
-// IsNotFound returns weather the error is a 404 or not.
-// NOTE: Since the api returns a 403 when a resource is not found, we also consider those as a 404.
-func (e ApiError) IsNotFound() bool {
+// Kind returns the ApiErrorKind of the error.
+func (e ApiError) Kind() ApiErrorKind {
+	return e.kind
+}
+
+// Payload returns the error payload sent by the api or nil if there is none.
+func (e ApiError) Payload() *ApiErrorPayload {
+	return e.payload
+}
+
+// StatusCode returns the status code of the api response or 0 if no response was received.
+func (e ApiError) StatusCode() int {
 	if e.resp == nil {
-		return false
+		return 0
 	}
 
-	// NOTE: consider 400 Bad Request, 403 Forbidden as a 404 NotFound until the api is fixed
-	return (e.resp.StatusCode == http.StatusBadRequest && strings.Contains(e.Detail(), "exist")) ||
-		e.resp.StatusCode == http.StatusNotFound ||
-		e.resp.StatusCode == http.StatusForbidden
+	return e.resp.StatusCode
+}
+
+// IsNotFound returns weather the error is a 404 or not.
+func (e ApiError) IsNotFound() bool {
+	return e.kind == ApiErrorKindNotFound
+}
+
+// IsConflict returns weather the error is due to another operation in progress on the resource or not.
+func (e ApiError) IsConflict() bool {
+	return e.kind == ApiErrorKindConflict
+}
+
+// IsUnauthorized returns weather the error is a 401 / 403 or not.
+func (e ApiError) IsUnauthorized() bool {
+	return e.kind == ApiErrorKindUnauthorized
+}
+
+// IsValidation returns weather the request has been rejected by the api as invalid or not.
+func (e ApiError) IsValidation() bool {
+	return e.kind == ApiErrorKindValidation
+}
+
+// IsRateLimited returns weather the error is a 429 or not.
+func (e ApiError) IsRateLimited() bool {
+	return e.kind == ApiErrorKindRateLimited
+}
+
+// IsServerError returns weather the error is a 5xx or not.
+func (e ApiError) IsServerError() bool {
+	return e.kind == ApiErrorKindServer
 }
 
 // IsBadRequest returns weather the error is a 400 or not.
 func (e ApiError) IsBadRequest() bool {
-	if e.resp == nil {
+	return e.StatusCode() == http.StatusBadRequest
+}
+
+// IsDeploymentInProgress returns weather the action has been rejected because a deployment is already in progress or not.
+// NOTE: the api answers with a 400 Bad Request in that case, so the payload message is used to tell it from a validation error.
+func (e ApiError) IsDeploymentInProgress() bool {
+	if e.IsConflict() {
+		return true
+	}
+
+	if !e.IsBadRequest() || e.payload == nil {
 		return false
 	}
 
-	return e.resp.StatusCode == http.StatusBadRequest
+	message := strings.ToLower(e.payload.Message)
+	return strings.Contains(message, "in progress") || strings.Contains(message, "already running")
 }
 
 // Error implements the Error interface.
@@ -64,36 +114,50 @@ func (e ApiError) Summary() string {
 }
 
 // Detail return a detailed error message for this ApiError.
-// It tries to read the error payload received from the api client to give extra information about the error.
+// It uses the error payload received from the api client to give extra information about the error.
 func (e ApiError) Detail() string {
 	var extra string
-	payload := e.errorPayload()
-
 	if e.err != nil {
 		extra = fmt.Sprintf("unexpected error: %s", e.err)
-		if payload != nil && payload.Message != "" {
-			extra = fmt.Sprintf("%s - %s", extra, payload.Message)
-		}
 	} else {
-		extra = fmt.Sprintf("unexpected status code: %d", e.resp.StatusCode)
+		extra = fmt.Sprintf("unexpected status code: %d", e.StatusCode())
+	}
+
+	if e.payload != nil && e.payload.Message != "" {
+		extra = fmt.Sprintf("%s - %s", extra, e.payload.Message)
 	}
 
 	return fmt.Sprintf("Could not %s %s '%s', %s", e.action, e.resource, e.resourceID, extra)
 }
 
-// errorPayload tries to read the response body to extract the error payload sent by the api client.
-// It returns nil if the body is empty.
-func (e ApiError) errorPayload() *apiErrorPayload {
-	if e.err == nil || e.resp == nil {
-		return nil
+// Hint returns an actionable message telling the user how to handle the error.
+func (e ApiError) Hint() string {
+	return e.kind.Hint()
+}
+
+// newApiErrorPayload tries to read the error payload sent by the api.
+// The body is read from the api client error when possible since the client already consumed the response body.
+// It returns nil if the body is empty or not a json payload.
+func newApiErrorPayload(resp *http.Response, err error) *ApiErrorPayload {
+	var body []byte
+	var bodyErr interface{ Body() []byte }
+	if errors.As(err, &bodyErr) {
+		body = bodyErr.Body()
+	} else if resp != nil && resp.Body != nil {
+		raw, readErr := io.ReadAll(resp.Body)
+		if readErr != nil {
+			return nil
+		}
+		// NOTE: restore the body so it can still be read by the caller.
+		resp.Body = io.NopCloser(bytes.NewReader(raw))
+		body = raw
 	}
 
-	body, err := io.ReadAll(e.resp.Body)
-	if err != nil {
+	if len(body) == 0 {
 		return nil
 	}
 
-	var payload apiErrorPayload
+	var payload ApiErrorPayload
 	if err := json.Unmarshal(body, &payload); err != nil {
 		return nil
 	}
@@ -101,46 +165,99 @@ func (e ApiError) errorPayload() *apiErrorPayload {
 	return &payload
 }
 
-// NewApiErrorFromError tries to cast an error into an ApiError.
+// NewApiErrorFromError tries to find an ApiError in the chain of the given error.
 // This is useful when working with ApiError passed as an `error` type to get the actual ApiError type.
 func NewApiErrorFromError(err error) *ApiError {
-	switch err.(type) {
-	case *ApiError:
-		return err.(*ApiError)
-	default:
-		return nil
+	var apiErr *ApiError
+	if errors.As(err, &apiErr) {
+		return apiErr
 	}
+
+	return nil
+}
+
+// KindOf returns the ApiErrorKind of the ApiError found in the chain of the given error.
+// It returns ApiErrorKindUnknown if the error doesn't come from the api.
+func KindOf(err error) ApiErrorKind {
+	apiErr := NewApiErrorFromError(err)
+	if apiErr == nil {
+		return ApiErrorKindUnknown
+	}
+	return apiErr.Kind()
 }
 
 // IsErrNotFound takes an error type and tries to cast it into a ApiError to check weather the error is an 404 or not.
 // It returns false if the casting fails.
 func IsErrNotFound(err error) bool {
+	return KindOf(err) == ApiErrorKindNotFound
+}
+
+// IsErrConflict takes an error type and tries to cast it into a ApiError to check weather the error is a conflict or not.
+// It returns false if the casting fails.
+func IsErrConflict(err error) bool {
+	return KindOf(err) == ApiErrorKindConflict
+}
+
+// IsErrUnauthorized takes an error type and tries to cast it into a ApiError to check weather the error is a 401 / 403 or not.
+// It returns false if the casting fails.
+func IsErrUnauthorized(err error) bool {
+	return KindOf(err) == ApiErrorKindUnauthorized
+}
+
+// IsErrValidation takes an error type and tries to cast it into a ApiError to check weather the error is a validation error or not.
+// It returns false if the casting fails.
+func IsErrValidation(err error) bool {
+	return KindOf(err) == ApiErrorKindValidation
+}
+
+// IsErrRateLimited takes an error type and tries to cast it into a ApiError to check weather the error is a 429 or not.
+// It returns false if the casting fails.
+func IsErrRateLimited(err error) bool {
+	return KindOf(err) == ApiErrorKindRateLimited
+}
+
+// IsErrServer takes an error type and tries to cast it into a ApiError to check weather the error is a 5xx or not.
+// It returns false if the casting fails.
+func IsErrServer(err error) bool {
+	return KindOf(err) == ApiErrorKindServer
+}
+
+// IsErrBadRequest takes an error type and tries to cast it into a ApiError to check weather the error is an 400 or not.
+// It returns false if the casting fails.
+func IsErrBadRequest(err error) bool {
 	apiErr := NewApiErrorFromError(err)
 	if apiErr == nil {
 		return false
 	}
-	return apiErr.IsNotFound()
+	return apiErr.IsBadRequest()
 }
 
-// IsErrBadRequest takes an error type and tries to cast it into a ApiError to check weather the error is an 400 or not.
+// IsErrDeploymentInProgress takes an error type and tries to cast it into a ApiError to check weather the error is due to a deployment already in progress or not.
 // It returns false if the casting fails.
-func IsErrBadRequest(err error) bool {
+func IsErrDeploymentInProgress(err error) bool {
 	apiErr := NewApiErrorFromError(err)
 	if apiErr == nil {
 		return false
 	}
-	return apiErr.IsBadRequest()
+	return apiErr.IsDeploymentInProgress()
 }
 
 // NewApiError returns a new instance of ApiError with the given parameters.
 func NewApiError(action ApiAction, resource ApiResource, resourceID string, resp *http.Response, err error) *ApiError {
-	return &ApiError{
+	apiErr := &ApiError{
 		err:        err,
 		action:     action,
 		resource:   resource,
 		resourceID: resourceID,
 		resp:       resp,
+		kind:       ApiErrorKindUnknown,
+		payload:    newApiErrorPayload(resp, err),
 	}
+	if resp != nil {
+		apiErr.kind = newApiErrorKindFromStatusCode(resp.StatusCode)
+	}
+
+	return apiErr
 }
 
 // NewCreateApiError returns a new instance of ApiError for a `create` action with the given parameters.
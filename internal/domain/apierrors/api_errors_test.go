@@ -0,0 +1,219 @@
+package apierrors_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+// bodyError mimics the errors returned by the api client that hold the response body.
+type bodyError struct {
+	body []byte
+}
+
+func (e bodyError) Error() string {
+	return "api client error"
+}
+
+func (e bodyError) Body() []byte {
+	return e.body
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestNewApiError_Kind(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName     string
+		Response     *http.Response
+		ExpectedKind apierrors.ApiErrorKind
+	}{
+		{
+			TestName:     "not_found",
+			Response:     newResponse(http.StatusNotFound, ""),
+			ExpectedKind: apierrors.ApiErrorKindNotFound,
+		},
+		{
+			TestName:     "bad_request_is_a_validation_error",
+			Response:     newResponse(http.StatusBadRequest, `{"message": "environment does not exist"}`),
+			ExpectedKind: apierrors.ApiErrorKindValidation,
+		},
+		{
+			TestName:     "unprocessable_entity_is_a_validation_error",
+			Response:     newResponse(http.StatusUnprocessableEntity, ""),
+			ExpectedKind: apierrors.ApiErrorKindValidation,
+		},
+		{
+			TestName:     "unauthorized",
+			Response:     newResponse(http.StatusUnauthorized, ""),
+			ExpectedKind: apierrors.ApiErrorKindUnauthorized,
+		},
+		{
+			TestName:     "forbidden_is_unauthorized",
+			Response:     newResponse(http.StatusForbidden, ""),
+			ExpectedKind: apierrors.ApiErrorKindUnauthorized,
+		},
+		{
+			TestName:     "conflict",
+			Response:     newResponse(http.StatusConflict, ""),
+			ExpectedKind: apierrors.ApiErrorKindConflict,
+		},
+		{
+			TestName:     "rate_limited",
+			Response:     newResponse(http.StatusTooManyRequests, ""),
+			ExpectedKind: apierrors.ApiErrorKindRateLimited,
+		},
+		{
+			TestName:     "server_error",
+			Response:     newResponse(http.StatusBadGateway, ""),
+			ExpectedKind: apierrors.ApiErrorKindServer,
+		},
+		{
+			TestName:     "unknown_without_response",
+			ExpectedKind: apierrors.ApiErrorKindUnknown,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			apiErr := apierrors.NewReadApiError(apierrors.ApiResourceApplication, gofakeit.UUID(), tc.Response, errors.New("api client error"))
+
+			assert.Equal(t, tc.ExpectedKind, apiErr.Kind())
+			assert.Equal(t, tc.ExpectedKind, apierrors.KindOf(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindNotFound, apierrors.IsErrNotFound(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindConflict, apierrors.IsErrConflict(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindUnauthorized, apierrors.IsErrUnauthorized(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindValidation, apierrors.IsErrValidation(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindRateLimited, apierrors.IsErrRateLimited(apiErr))
+			assert.Equal(t, tc.ExpectedKind == apierrors.ApiErrorKindServer, apierrors.IsErrServer(apiErr))
+			if tc.ExpectedKind != apierrors.ApiErrorKindUnknown {
+				assert.NotEmpty(t, apiErr.Hint())
+			}
+		})
+	}
+}
+
+func TestNewApiError_Payload(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"status": 400, "error": "Bad Request", "message": "name is already used"}`
+
+	testCases := []struct {
+		TestName string
+		Response *http.Response
+		Error    error
+	}{
+		{
+			TestName: "payload_from_response_body",
+			Response: newResponse(http.StatusBadRequest, payload),
+			Error:    errors.New("api client error"),
+		},
+		{
+			TestName: "payload_from_api_client_error",
+			Response: newResponse(http.StatusBadRequest, ""),
+			Error:    &bodyError{body: []byte(payload)},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			apiErr := apierrors.NewCreateApiError(apierrors.ApiResourceApplication, "my-app", tc.Response, tc.Error)
+
+			require.NotNil(t, apiErr.Payload())
+			assert.Equal(t, http.StatusBadRequest, apiErr.Payload().Status)
+			assert.Equal(t, "name is already used", apiErr.Payload().Message)
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode())
+			assert.Contains(t, apiErr.Error(), "name is already used")
+			// The message is still available after the first read of the payload.
+			assert.Contains(t, apiErr.Error(), "name is already used")
+		})
+	}
+}
+
+func TestNewApiError_WithoutPayload(t *testing.T) {
+	t.Parallel()
+
+	apiErr := apierrors.NewDeleteApiError(apierrors.ApiResourceApplication, "my-app", newResponse(http.StatusInternalServerError, "not json"), nil)
+
+	assert.Nil(t, apiErr.Payload())
+	assert.Equal(t, "Could not delete application 'my-app', unexpected status code: 500", apiErr.Error())
+	assert.Equal(t, "Error on application delete", apiErr.Summary())
+}
+
+func TestNewApiErrorFromError(t *testing.T) {
+	t.Parallel()
+
+	apiErr := apierrors.NewNotFoundApiError(apierrors.ApiResourceApplication, gofakeit.UUID())
+	wrapped := errors.Wrap(errors.Wrap(apiErr, "failed to get application"), "failed to refresh")
+
+	assert.Equal(t, apiErr, apierrors.NewApiErrorFromError(wrapped))
+	assert.True(t, apierrors.IsErrNotFound(wrapped))
+	assert.Nil(t, apierrors.NewApiErrorFromError(errors.New("not an api error")))
+	assert.Equal(t, apierrors.ApiErrorKindUnknown, apierrors.KindOf(errors.New("not an api error")))
+}
+
+func TestIsErrDeploymentInProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName       string
+		Error          error
+		ExpectedResult bool
+	}{
+		{
+			TestName:       "conflict",
+			Error:          apierrors.NewDeployApiError(apierrors.ApiResourceEnvironment, gofakeit.UUID(), newResponse(http.StatusConflict, ""), nil),
+			ExpectedResult: true,
+		},
+		{
+			TestName:       "bad_request_with_deployment_in_progress",
+			Error:          apierrors.NewDeployApiError(apierrors.ApiResourceEnvironment, gofakeit.UUID(), newResponse(http.StatusBadRequest, `{"message": "A deployment is already In Progress for this environment"}`), nil),
+			ExpectedResult: true,
+		},
+		{
+			TestName:       "wrapped_bad_request_with_deployment_in_progress",
+			Error:          errors.Wrap(apierrors.NewStopApiError(apierrors.ApiResourceApplication, gofakeit.UUID(), newResponse(http.StatusBadRequest, `{"message": "environment deployment already running"}`), nil), "failed to stop"),
+			ExpectedResult: true,
+		},
+		{
+			TestName:       "bad_request_with_another_message",
+			Error:          apierrors.NewDeployApiError(apierrors.ApiResourceEnvironment, gofakeit.UUID(), newResponse(http.StatusBadRequest, `{"message": "invalid git commit id"}`), nil),
+			ExpectedResult: false,
+		},
+		{
+			TestName:       "bad_request_without_payload",
+			Error:          apierrors.NewDeployApiError(apierrors.ApiResourceEnvironment, gofakeit.UUID(), newResponse(http.StatusBadRequest, ""), nil),
+			ExpectedResult: false,
+		},
+		{
+			TestName:       "not_an_api_error",
+			Error:          errors.New("deployment in progress"),
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.ExpectedResult, apierrors.IsErrDeploymentInProgress(tc.Error))
+		})
+	}
+}
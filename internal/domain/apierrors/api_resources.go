@@ -7,30 +7,39 @@ type ApiResource string
 const (
 	ApiResourceAWSCredentials                 ApiResource = "aws credentials"
 	ApiResourceApplication                    ApiResource = "application"
+	ApiResourceApplicationAdvancedSettings    ApiResource = "application advanced settings"
 	ApiResourceApplicationCustomDomain        ApiResource = "application custom domain"
 	ApiResourceApplicationEnvironmentVariable ApiResource = "application environment variable"
 	ApiResourceApplicationSecret              ApiResource = "application secret"
 	ApiResourceApplicationStatus              ApiResource = "application status"
 	ApiResourceCluster                        ApiResource = "cluster"
+	ApiResourceClusterAdvancedSettings        ApiResource = "cluster advanced settings"
 	ApiResourceClusterCloudProvider           ApiResource = "cluster cloud provider"
 	ApiResourceClusterInstanceType            ApiResource = "cluster instance type"
 	ApiResourceClusterRoutingTable            ApiResource = "cluster routing table"
 	ApiResourceClusterStatus                  ApiResource = "cluster status"
 	ApiResourceContainer                      ApiResource = "container"
+	ApiResourceContainerAdvancedSettings      ApiResource = "container advanced settings"
+	ApiResourceContainerCustomDomain          ApiResource = "container custom domain"
 	ApiResourceContainerEnvironmentVariable   ApiResource = "container environment variable"
 	ApiResourceContainerRegistry              ApiResource = "container registry"
 	ApiResourceContainerSecret                ApiResource = "container secret"
 	ApiResourceContainerStatus                ApiResource = "container status"
 	ApiResourceJob                            ApiResource = "job"
+	ApiResourceJobAdvancedSettings            ApiResource = "job advanced settings"
 	ApiResourceJobEnvironmentVariable         ApiResource = "job environment variable"
 	ApiResourceJobSecret                      ApiResource = "job secret"
 	ApiResourceJobStatus                      ApiResource = "job status"
 	ApiResourceDatabase                       ApiResource = "database"
+	ApiResourceDatabaseAdvancedSettings       ApiResource = "database advanced settings"
 	ApiResourceDatabaseStatus                 ApiResource = "database status"
+	ApiResourceDigitalOceanCredentials        ApiResource = "digitalocean credentials"
 	ApiResourceEnvironment                    ApiResource = "environment"
 	ApiResourceEnvironmentEnvironmentVariable ApiResource = "environment environment variable"
 	ApiResourceEnvironmentSecret              ApiResource = "environment secret"
 	ApiResourceEnvironmentStatus              ApiResource = "environment status"
+	ApiResourceGcpCredentials                 ApiResource = "gcp credentials"
+	ApiResourceGitToken                       ApiResource = "git token"
 	ApiResourceOrganization                   ApiResource = "organization"
 	ApiResourceProject                        ApiResource = "project"
 	ApiResourceProjectEnvironmentVariable     ApiResource = "project environment variable"
@@ -0,0 +1,354 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlekSi/pointer"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+const (
+	DefaultBuildMode           = BuildModeBuildpacks
+	DefaultCPU                 = 500
+	MinCPU                     = 10
+	DefaultMemory              = 512
+	MinMemory                  = 1
+	DefaultMinRunningInstances = 1
+	MinMinRunningInstances     = 0
+	DefaultMaxRunningInstances = 1
+	MinMaxRunningInstances     = -1
+	MinStorageSize             = 1
+)
+
+var (
+	// ErrNilApplication is returned if an Application is nil.
+	ErrNilApplication = errors.New("application cannot be nil")
+	// ErrInvalidApplication is the error return if an Application is invalid.
+	ErrInvalidApplication = errors.New("invalid application")
+	// ErrInvalidEnvironmentIDParam is returned if the environment id param is invalid.
+	ErrInvalidEnvironmentIDParam = errors.New("invalid environment id param")
+	// ErrInvalidApplicationIDParam is returned if the application id param is invalid.
+	ErrInvalidApplicationIDParam = errors.New("invalid application id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid name param")
+	// ErrInvalidGitRepositoryParam is returned if the git repository param is invalid.
+	ErrInvalidGitRepositoryParam = errors.New("invalid git repository param")
+	// ErrInvalidBuildModeParam is returned if the build mode param is invalid.
+	ErrInvalidBuildModeParam = errors.New("invalid build mode param")
+	// ErrInvalidDockerfilePathParam is returned if the dockerfile path param is invalid.
+	ErrInvalidDockerfilePathParam = errors.New("invalid dockerfile path param: required when build mode is DOCKER")
+	// ErrInvalidMinRunningInstancesParam is returned if the min running instances param is invalid.
+	ErrInvalidMinRunningInstancesParam = errors.New("invalid min running instances param")
+	// ErrInvalidMaxRunningInstancesParam is returned if the max running instances param is invalid.
+	ErrInvalidMaxRunningInstancesParam = errors.New("invalid max running instances param")
+	// ErrInvalidStateParam is returned if the state param is invalid.
+	ErrInvalidStateParam = errors.New("invalid state param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid application upsert request")
+	// ErrInvalidApplicationEnvironmentVariablesParam is returned if the environment variables param is invalid.
+	ErrInvalidApplicationEnvironmentVariablesParam = errors.New("invalid application environment variables param")
+	// ErrInvalidApplicationSecretsParam is returned if the secrets param is invalid.
+	ErrInvalidApplicationSecretsParam = errors.New("invalid application secrets param")
+	// ErrInvalidApplicationCustomDomainsParam is returned if the custom domains param is invalid.
+	ErrInvalidApplicationCustomDomainsParam = errors.New("invalid application custom domains param")
+	// ErrInvalidPortsParam is returned if the ports param is invalid.
+	ErrInvalidPortsParam = errors.New("invalid ports param")
+	// ErrCustomDomainsRequireHTTPPort is returned if custom domains are added to an application without a publicly accessible HTTP or GRPC port.
+	ErrCustomDomainsRequireHTTPPort = errors.New("custom domains require a publicly accessible HTTP or GRPC port")
+	// ErrInvalidHealthchecksParam is returned if the healthchecks param is invalid.
+	ErrInvalidHealthchecksParam = errors.New("invalid healthchecks param")
+	// ErrInvalidAdvancedSettingsParam is returned if the advanced settings param is invalid.
+	ErrInvalidAdvancedSettingsParam = errors.New("invalid advanced settings param")
+	// ErrFailedToSetHosts is returned if the internal & external host failed to be set.
+	ErrFailedToSetHosts = errors.New("failed to set hosts")
+)
+
+type Application struct {
+	ID                  uuid.UUID                    `validate:"required"`
+	EnvironmentID       uuid.UUID                    `validate:"required"`
+	Name                string                       `validate:"required"`
+	GitRepository       git_repository.GitRepository `validate:"required"`
+	BuildMode           BuildMode                    `validate:"required"`
+	CPU                 int32                        `validate:"required"`
+	Memory              int32                        `validate:"required"`
+	MinRunningInstances int32
+	MaxRunningInstances int32 `validate:"required"`
+	AutoPreview         bool
+
+	DockerfilePath              *string
+	BuildpackLanguage           *string
+	Entrypoint                  *string
+	Arguments                   []string
+	Storages                    storage.Storages
+	Ports                       port.Ports
+	Healthchecks                healthcheck.Healthchecks
+	AdvancedSettings            advancedsettings.AdvancedSettings
+	EnvironmentVariables        variable.Variables
+	BuiltInEnvironmentVariables variable.Variables
+	Secrets                     secret.Secrets
+	CustomDomains               customdomain.CustomDomains
+	InternalHost                *string
+	ExternalHost                *string
+	State                       status.State
+	DeploymentStageID           string
+}
+
+// Validate returns an error to tell whether the Application domain model is valid or not.
+func (a Application) Validate() error {
+	if err := a.GitRepository.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.BuildMode.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if a.MinRunningInstances < MinMinRunningInstances {
+		return errors.Wrap(ErrInvalidMinRunningInstancesParam, ErrInvalidApplication.Error())
+	}
+
+	if a.MaxRunningInstances < MinMaxRunningInstances {
+		return errors.Wrap(ErrInvalidMaxRunningInstancesParam, ErrInvalidApplication.Error())
+	}
+
+	if err := a.Storages.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.Ports.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.CustomDomains.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.Healthchecks.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := a.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	if err := validator.New().Struct(a); err != nil {
+		return errors.Wrap(err, ErrInvalidApplication.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Application domain model is valid or not.
+func (a Application) IsValid() bool {
+	return a.Validate() == nil
+}
+
+// NewApplicationParams represents the arguments needed to create an Application.
+type NewApplicationParams struct {
+	ApplicationID       string
+	EnvironmentID       string
+	Name                string
+	GitRepository       git_repository.NewGitRepositoryParams
+	BuildMode           string
+	CPU                 int32
+	Memory              int32
+	MinRunningInstances int32
+	MaxRunningInstances int32
+	AutoPreview         bool
+
+	State                *string
+	DockerfilePath       *string
+	BuildpackLanguage    *string
+	Entrypoint           *string
+	Arguments            []string
+	Storages             storage.Storages
+	Ports                port.Ports
+	Healthchecks         healthcheck.Healthchecks
+	AdvancedSettings     advancedsettings.AdvancedSettings
+	EnvironmentVariables variable.Variables
+	Secrets              secret.Secrets
+	CustomDomains        customdomain.CustomDomains
+	DeploymentStageID    string
+}
+
+// NewApplication returns a new instance of an Application domain model.
+func NewApplication(params NewApplicationParams) (*Application, error) {
+	applicationUUID, err := uuid.Parse(params.ApplicationID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidApplicationIDParam.Error())
+	}
+
+	environmentUUID, err := uuid.Parse(params.EnvironmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	gitRepository, err := git_repository.NewGitRepository(params.GitRepository)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidGitRepositoryParam.Error())
+	}
+
+	buildMode, err := NewBuildModeFromString(params.BuildMode)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidBuildModeParam.Error())
+	}
+
+	a := &Application{
+		ID:                  applicationUUID,
+		EnvironmentID:       environmentUUID,
+		Name:                params.Name,
+		GitRepository:       *gitRepository,
+		BuildMode:           *buildMode,
+		DockerfilePath:      params.DockerfilePath,
+		BuildpackLanguage:   params.BuildpackLanguage,
+		CPU:                 params.CPU,
+		Memory:              params.Memory,
+		MinRunningInstances: params.MinRunningInstances,
+		MaxRunningInstances: params.MaxRunningInstances,
+		AutoPreview:         params.AutoPreview,
+		Entrypoint:          params.Entrypoint,
+		Arguments:           params.Arguments,
+		Storages:            params.Storages,
+		Ports:               params.Ports,
+		Healthchecks:        params.Healthchecks,
+		AdvancedSettings:    params.AdvancedSettings,
+		CustomDomains:       params.CustomDomains,
+		DeploymentStageID:   params.DeploymentStageID,
+	}
+
+	if err := a.SetEnvironmentVariables(params.EnvironmentVariables); err != nil {
+		return nil, errors.Wrap(err, ErrInvalidApplicationEnvironmentVariablesParam.Error())
+	}
+
+	if err := a.SetSecrets(params.Secrets); err != nil {
+		return nil, errors.Wrap(err, ErrInvalidApplicationSecretsParam.Error())
+	}
+
+	if params.State != nil {
+		applicationState, err := status.NewStateFromString(*params.State)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+
+		if err := a.SetState(*applicationState); err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+	}
+
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// SetEnvironmentVariables takes a variable.Variables and sets the attributes EnvironmentVariables & BuiltInEnvironmentVariables by splitting the variable with the `BUILT_IN` scope from the others.
+func (a *Application) SetEnvironmentVariables(vars variable.Variables) error {
+	if err := vars.Validate(); err != nil {
+		return err
+	}
+
+	envVars := make(variable.Variables, 0, len(vars))
+	builtIn := make(variable.Variables, 0, len(vars))
+
+	for _, v := range vars {
+		if v.Scope == variable.ScopeBuiltIn {
+			builtIn = append(builtIn, v)
+			continue
+		}
+		envVars = append(envVars, v)
+	}
+
+	a.EnvironmentVariables = envVars
+	a.BuiltInEnvironmentVariables = builtIn
+
+	if err := a.SetHosts(vars); err != nil {
+		return nil
+	}
+
+	return nil
+}
+
+// SetSecrets takes a secret.Secrets and sets the attributes Secrets of the application.
+func (a *Application) SetSecrets(secrets secret.Secrets) error {
+	if err := secrets.Validate(); err != nil {
+		return err
+	}
+
+	applicationSecrets := make(secret.Secrets, 0, len(secrets))
+	for _, s := range secrets {
+		if s.Scope == variable.ScopeBuiltIn {
+			continue
+		}
+		applicationSecrets = append(applicationSecrets, s)
+	}
+
+	a.Secrets = applicationSecrets
+
+	return nil
+}
+
+// SetCustomDomains takes a customdomain.CustomDomains and sets the attributes CustomDomains of the application.
+func (a *Application) SetCustomDomains(customDomains customdomain.CustomDomains) error {
+	if err := customDomains.Validate(); err != nil {
+		return err
+	}
+
+	a.CustomDomains = customDomains
+
+	return nil
+}
+
+// SetHosts takes a variable.Variables and sets the attributes InternalHost & ExternalHost using the built-in host variables of the application.
+func (a *Application) SetHosts(vars variable.Variables) error {
+	hostExternalKey := fmt.Sprintf("QOVERY_APPLICATION_Z%s_HOST_EXTERNAL", strings.ToUpper(strings.Split(a.ID.String(), "-")[0]))
+	hostInternalKey := fmt.Sprintf("QOVERY_APPLICATION_Z%s_HOST_INTERNAL", strings.ToUpper(strings.Split(a.ID.String(), "-")[0]))
+
+	for _, v := range vars {
+		if v.Key == hostExternalKey {
+			a.ExternalHost = pointer.ToString(v.Value)
+			continue
+		}
+		if v.Key == hostInternalKey {
+			a.InternalHost = pointer.ToString(v.Value)
+			continue
+		}
+		if a.ExternalHost != nil && a.InternalHost != nil {
+			return nil
+		}
+	}
+
+	return ErrFailedToSetHosts
+}
+
+// SetState takes a status.State and sets the attributes State.
+func (a *Application) SetState(st status.State) error {
+	if err := st.Validate(); err != nil {
+		return err
+	}
+
+	if st == status.StateReady {
+		st = status.StateStopped
+	}
+
+	a.State = st
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package application
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// BuildMode is an enum that contains all the valid values of an application build mode.
+type BuildMode string
+
+const (
+	BuildModeBuildpacks BuildMode = "BUILDPACKS"
+	BuildModeDocker     BuildMode = "DOCKER"
+)
+
+// AllowedBuildModeValues contains all the valid values of a BuildMode.
+var AllowedBuildModeValues = []BuildMode{
+	BuildModeBuildpacks,
+	BuildModeDocker,
+}
+
+// String returns the string value of a BuildMode.
+func (v BuildMode) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the BuildMode is valid or not.
+func (v BuildMode) Validate() error {
+	if slices.Contains(AllowedBuildModeValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for BuildMode: valid values are %v", v, AllowedBuildModeValues)
+}
+
+// IsValid returns a bool to tell whether the BuildMode is valid or not.
+func (v BuildMode) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewBuildModeFromString tries to turn a string into a BuildMode.
+// It returns an error if the string is not a valid value.
+func NewBuildModeFromString(v string) (*BuildMode, error) {
+	ev := BuildMode(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
@@ -0,0 +1,26 @@
+package application_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+)
+
+// TestNewBuildModeFromString validate that the build modes qovery.BuildModeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the application.BuildMode stays up to date.
+func TestNewBuildModeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, application.AllowedBuildModeValues, len(qovery.AllowedBuildModeEnumEnumValues))
+	for _, qoveryBuildMode := range qovery.AllowedBuildModeEnumEnumValues {
+		qoveryBuildModeStr := string(qoveryBuildMode)
+		t.Run(qoveryBuildModeStr, func(t *testing.T) {
+			buildMode, err := application.NewBuildModeFromString(qoveryBuildModeStr)
+			assert.NoError(t, err)
+			assert.Equal(t, buildMode.String(), qoveryBuildModeStr)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package application
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=ApplicationRepository --filename=application_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+)
+
+// Repository represents the interface to implement to handle the persistence of an Application.
+type Repository interface {
+	Create(ctx context.Context, environmentID string, request UpsertRepositoryRequest) (*Application, error)
+	Get(ctx context.Context, applicationID string) (*Application, error)
+	Update(ctx context.Context, applicationID string, request UpsertRepositoryRequest) (*Application, error)
+	Delete(ctx context.Context, applicationID string) error
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update an Application.
+type UpsertRepositoryRequest struct {
+	Name          string                     `validate:"required"`
+	GitRepository GitRepositoryUpsertRequest `validate:"required"`
+
+	BuildMode           *string
+	DockerfilePath      *string
+	BuildpackLanguage   *string
+	AutoPreview         *bool
+	Entrypoint          *string
+	CPU                 *int32
+	Memory              *int32
+	MinRunningInstances *int32
+	MaxRunningInstances *int32
+	Arguments           []string
+	Storages            []storage.UpsertRequest
+	Ports               []port.UpsertRequest
+	Healthchecks        healthcheck.Healthchecks
+	AdvancedSettings    advancedsettings.AdvancedSettings
+	DeploymentStageID   string
+}
+
+// GitRepositoryUpsertRequest represents the parameters needed to set the git repository of an Application.
+type GitRepositoryUpsertRequest struct {
+	URL string `validate:"required"`
+
+	Branch     *string
+	RootPath   *string
+	GitTokenID *string `validate:"omitempty,uuid"`
+	// CommitID is the commit of the git repository to deploy, the application follows the branch if not set.
+	CommitID *string
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if r.BuildMode != nil {
+		buildMode, err := NewBuildModeFromString(*r.BuildMode)
+		if err != nil {
+			return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+		}
+
+		if *buildMode == BuildModeDocker && (r.DockerfilePath == nil || *r.DockerfilePath == "") {
+			return errors.Wrap(ErrInvalidDockerfilePathParam, ErrInvalidUpsertRequest.Error())
+		}
+	}
+
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.GitRepository.CommitID != nil {
+		if err := git_repository.ValidateCommitID(*r.GitRepository.CommitID); err != nil {
+			return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+		}
+	}
+
+	for _, p := range r.Ports {
+		if err := p.Validate(); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidPortsParam.Error()), ErrInvalidUpsertRequest.Error())
+		}
+	}
+
+	if err := r.Healthchecks.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidHealthchecksParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Healthchecks.ValidatePorts(r.Ports); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidHealthchecksParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidAdvancedSettingsParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
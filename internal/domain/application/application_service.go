@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+//go:generate mockery --testonly --with-expecter --name=Service --structname=ApplicationService --filename=application_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateApplication = errors.New("failed to create application")
+	ErrFailedToGetApplication    = errors.New("failed to get application")
+	ErrFailedToUpdateApplication = errors.New("failed to update application")
+	ErrFailedToDeleteApplication = errors.New("failed to delete application")
+)
+
+// Service represents the interface to implement to handle the domain logic of an Application.
+type Service interface {
+	Create(ctx context.Context, environmentID string, request UpsertServiceRequest) (*Application, error)
+	Get(ctx context.Context, applicationID string) (*Application, error)
+	Update(ctx context.Context, applicationID string, request UpsertServiceRequest) (*Application, error)
+	Delete(ctx context.Context, applicationID string) error
+}
+
+// UpsertServiceRequest represents the parameters needed to create & update an Application.
+type UpsertServiceRequest struct {
+	ApplicationUpsertRequest UpsertRepositoryRequest
+	EnvironmentVariables     variable.DiffRequest
+	Secrets                  secret.DiffRequest
+	CustomDomains            customdomain.DiffRequest
+	// MountPaths are the mount paths of all the planned file environment variables and secrets, including the ones the request doesn't change.
+	MountPaths []string
+}
+
+// Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) Validate() error {
+	if err := r.ApplicationUpsertRequest.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.EnvironmentVariables.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Secrets.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.validateMountPaths(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.CustomDomains.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.validateCustomDomainsPorts(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// validateMountPaths returns an error if a planned file variable or secret is mounted on a storage.
+func (r UpsertServiceRequest) validateMountPaths() error {
+	storageMountPoints := make([]string, 0, len(r.ApplicationUpsertRequest.Storages))
+	for _, s := range r.ApplicationUpsertRequest.Storages {
+		storageMountPoints = append(storageMountPoints, s.MountPoint)
+	}
+
+	mountPaths := append(r.EnvironmentVariables.MountPaths(), r.Secrets.MountPaths()...)
+	mountPaths = append(mountPaths, r.MountPaths...)
+
+	return variable.CheckMountPathConflicts(mountPaths, storageMountPoints)
+}
+
+// validateCustomDomainsPorts returns an error if the request adds custom domains to an application without a publicly accessible HTTP or GRPC port.
+// Custom domains are served through the http routing of Qovery, so TCP and UDP ports can't use them.
+func (r UpsertServiceRequest) validateCustomDomainsPorts() error {
+	if len(r.CustomDomains.Create) == 0 {
+		return nil
+	}
+
+	for _, p := range r.ApplicationUpsertRequest.Ports {
+		if p.IsPubliclyAccessibleHTTP() {
+			return nil
+		}
+	}
+
+	return ErrCustomDomainsRequireHTTPPort
+}
@@ -0,0 +1,448 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+func TestNewApplication(t *testing.T) {
+	t.Parallel()
+
+	validGitRepository := git_repository.NewGitRepositoryParams{
+		Url:    "https://github.com/Qovery/test_http_server.git",
+		Branch: pointer.ToString("master"),
+	}
+
+	testCases := []struct {
+		TestName      string
+		Params        application.NewApplicationParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_application_id",
+			Params: application.NewApplicationParams{
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				GitRepository: validGitRepository,
+				BuildMode:     application.BuildModeBuildpacks.String(),
+			},
+			ExpectedError: application.ErrInvalidApplicationIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_environment_id",
+			Params: application.NewApplicationParams{
+				ApplicationID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				GitRepository: validGitRepository,
+				BuildMode:     application.BuildModeBuildpacks.String(),
+			},
+			ExpectedError: application.ErrInvalidEnvironmentIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_name",
+			Params: application.NewApplicationParams{
+				ApplicationID: gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				GitRepository: validGitRepository,
+				BuildMode:     application.BuildModeBuildpacks.String(),
+			},
+			ExpectedError: application.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_git_repository",
+			Params: application.NewApplicationParams{
+				ApplicationID: gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				BuildMode:     application.BuildModeBuildpacks.String(),
+			},
+			ExpectedError: application.ErrInvalidGitRepositoryParam,
+		},
+		{
+			TestName: "fail_with_invalid_build_mode",
+			Params: application.NewApplicationParams{
+				ApplicationID: gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				GitRepository: validGitRepository,
+				BuildMode:     "INVALID",
+			},
+			ExpectedError: application.ErrInvalidBuildModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_min_running_instances",
+			Params: application.NewApplicationParams{
+				ApplicationID:       gofakeit.UUID(),
+				EnvironmentID:       gofakeit.UUID(),
+				Name:                gofakeit.Name(),
+				GitRepository:       validGitRepository,
+				BuildMode:           application.BuildModeBuildpacks.String(),
+				CPU:                 application.DefaultCPU,
+				Memory:              application.DefaultMemory,
+				MinRunningInstances: -1,
+				MaxRunningInstances: application.DefaultMaxRunningInstances,
+			},
+			ExpectedError: application.ErrInvalidMinRunningInstancesParam,
+		},
+		{
+			TestName: "fail_with_invalid_state",
+			Params: application.NewApplicationParams{
+				ApplicationID:       gofakeit.UUID(),
+				EnvironmentID:       gofakeit.UUID(),
+				Name:                gofakeit.Name(),
+				GitRepository:       validGitRepository,
+				BuildMode:           application.BuildModeBuildpacks.String(),
+				CPU:                 application.DefaultCPU,
+				Memory:              application.DefaultMemory,
+				MinRunningInstances: application.DefaultMinRunningInstances,
+				MaxRunningInstances: application.DefaultMaxRunningInstances,
+				State:               pointer.ToString("INVALID"),
+			},
+			ExpectedError: application.ErrInvalidStateParam,
+		},
+		{
+			TestName: "success_with_zero_min_running_instances",
+			Params: application.NewApplicationParams{
+				ApplicationID:       gofakeit.UUID(),
+				EnvironmentID:       gofakeit.UUID(),
+				Name:                gofakeit.Name(),
+				GitRepository:       validGitRepository,
+				BuildMode:           application.BuildModeBuildpacks.String(),
+				CPU:                 application.DefaultCPU,
+				Memory:              application.DefaultMemory,
+				MinRunningInstances: 0,
+				MaxRunningInstances: application.DefaultMaxRunningInstances,
+			},
+		},
+		{
+			TestName: "success",
+			Params: application.NewApplicationParams{
+				ApplicationID:       gofakeit.UUID(),
+				EnvironmentID:       gofakeit.UUID(),
+				Name:                gofakeit.Name(),
+				GitRepository:       validGitRepository,
+				BuildMode:           application.BuildModeDocker.String(),
+				DockerfilePath:      pointer.ToString("Dockerfile"),
+				CPU:                 application.DefaultCPU,
+				Memory:              application.DefaultMemory,
+				MinRunningInstances: application.DefaultMinRunningInstances,
+				MaxRunningInstances: application.DefaultMaxRunningInstances,
+				State:               pointer.ToString(status.StateReady.String()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			app, err := application.NewApplication(tc.Params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, app)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, app)
+			assert.True(t, app.IsValid())
+			assert.Equal(t, tc.Params.ApplicationID, app.ID.String())
+			assert.Equal(t, tc.Params.EnvironmentID, app.EnvironmentID.String())
+			assert.Equal(t, tc.Params.Name, app.Name)
+			assert.Equal(t, tc.Params.GitRepository.Url, app.GitRepository.Url)
+			assert.Equal(t, tc.Params.BuildMode, app.BuildMode.String())
+			assert.Equal(t, tc.Params.DockerfilePath, app.DockerfilePath)
+			assert.Equal(t, tc.Params.MinRunningInstances, app.MinRunningInstances)
+			if tc.Params.State != nil && *tc.Params.State == status.StateReady.String() {
+				assert.Equal(t, status.StateStopped, app.State)
+			}
+		})
+	}
+}
+
+func TestApplication_SetEnvironmentVariables(t *testing.T) {
+	t.Parallel()
+
+	applicationID := uuid.New()
+	hostPrefix := "QOVERY_APPLICATION_Z" + strings.ToUpper(strings.Split(applicationID.String(), "-")[0])
+	vars := variable.Variables{
+		{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Key: hostPrefix + "_HOST_EXTERNAL", Value: "external.qovery.io"},
+		{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Key: hostPrefix + "_HOST_INTERNAL", Value: "internal"},
+		{ID: uuid.New(), Scope: variable.ScopeApplication, Key: gofakeit.Name(), Value: gofakeit.Name()},
+	}
+
+	app := application.Application{ID: applicationID}
+	assert.NoError(t, app.SetEnvironmentVariables(vars))
+	assert.Len(t, app.BuiltInEnvironmentVariables, 2)
+	assert.Len(t, app.EnvironmentVariables, 1)
+	assert.Equal(t, pointer.ToString("external.qovery.io"), app.ExternalHost)
+	assert.Equal(t, pointer.ToString("internal"), app.InternalHost)
+}
+
+func TestUpsertRepositoryRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       application.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_missing_git_repository_url",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+			},
+			ExpectedError: application.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_build_mode",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				BuildMode:     pointer.ToString("INVALID"),
+			},
+			ExpectedError: application.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_docker_build_mode_without_dockerfile_path",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				BuildMode:     pointer.ToString(application.BuildModeDocker.String()),
+			},
+			ExpectedError: application.ErrInvalidDockerfilePathParam,
+		},
+		{
+			TestName: "success_with_docker_build_mode",
+			Request: application.UpsertRepositoryRequest{
+				Name:           gofakeit.Name(),
+				GitRepository:  application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				BuildMode:      pointer.ToString(application.BuildModeDocker.String()),
+				DockerfilePath: pointer.ToString("Dockerfile"),
+			},
+		},
+		{
+			TestName: "success_with_buildpacks_build_mode",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				BuildMode:     pointer.ToString(application.BuildModeBuildpacks.String()),
+			},
+		},
+		{
+			TestName: "fail_with_short_commit_id",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL(), CommitID: pointer.ToString("42e2e5a")},
+			},
+			ExpectedError: git_repository.ErrInvalidCommitIDParam,
+		},
+		{
+			TestName: "success_with_commit_id",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL(), CommitID: pointer.ToString("42e2e5af9d49de268cd1fda3587788da4ace418a")},
+			},
+		},
+		{
+			TestName: "fail_with_probe_on_undeclared_port",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				Ports:         []port.UpsertRequest{{InternalPort: 8080}},
+				Healthchecks: healthcheck.Healthchecks{
+					ReadinessProbe: newHTTPProbe(9090),
+				},
+			},
+			ExpectedError: healthcheck.ErrProbePortNotDeclared,
+		},
+		{
+			TestName: "success_with_probe_on_declared_port",
+			Request: application.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				Ports:         []port.UpsertRequest{{InternalPort: 8080}},
+				Healthchecks: healthcheck.Healthchecks{
+					LivenessProbe:  newHTTPProbe(8080),
+					ReadinessProbe: newHTTPProbe(8080),
+				},
+			},
+		},
+		{
+			TestName: "fail_with_invalid_advanced_settings",
+			Request: application.UpsertRepositoryRequest{
+				Name:             gofakeit.Name(),
+				GitRepository:    application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				AdvancedSettings: advancedsettings.AdvancedSettings{"deployment.termination_grace_period_seconds": make(chan int)},
+			},
+			ExpectedError: application.ErrInvalidAdvancedSettingsParam,
+		},
+		{
+			TestName: "success_with_advanced_settings",
+			Request: application.UpsertRepositoryRequest{
+				Name:             gofakeit.Name(),
+				GitRepository:    application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+				AdvancedSettings: advancedsettings.AdvancedSettings{"deployment.termination_grace_period_seconds": 120},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
+
+func TestUpsertServiceRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	repositoryRequest := application.UpsertRepositoryRequest{
+		Name:          gofakeit.Name(),
+		GitRepository: application.GitRepositoryUpsertRequest{URL: gofakeit.URL()},
+		BuildMode:     pointer.ToString(application.BuildModeBuildpacks.String()),
+		Storages: []storage.UpsertRequest{
+			{Type: storage.TypeFastSSD.String(), Size: 10, MountPoint: "/data"},
+		},
+	}
+
+	testCases := []struct {
+		TestName      string
+		Variables     variable.DiffRequest
+		Secrets       secret.DiffRequest
+		MountPaths    []string
+		Ports         []port.UpsertRequest
+		CustomDomains customdomain.DiffRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_relative_mount_path",
+			Variables: variable.DiffRequest{
+				Create: []variable.DiffCreateRequest{
+					{UpsertRequest: variable.UpsertRequest{Key: "CONFIG", Value: "{}", MountPath: pointer.ToString("config.json")}},
+				},
+			},
+			ExpectedError: variable.ErrInvalidMountPathParam,
+		},
+		{
+			TestName: "fail_with_variable_mounted_in_storage",
+			Variables: variable.DiffRequest{
+				Create: []variable.DiffCreateRequest{
+					{UpsertRequest: variable.UpsertRequest{Key: "CONFIG", Value: "{}", MountPath: pointer.ToString("/data/config.json")}},
+				},
+			},
+			ExpectedError: variable.ErrConflictingMountPath,
+		},
+		{
+			TestName: "fail_with_secret_mounted_on_storage",
+			Secrets: secret.DiffRequest{
+				Create: []secret.DiffCreateRequest{
+					{UpsertRequest: secret.UpsertRequest{Key: "CREDENTIALS", Value: "{}", MountPath: pointer.ToString("/data")}},
+				},
+			},
+			ExpectedError: variable.ErrConflictingMountPath,
+		},
+		{
+			TestName:      "fail_with_unchanged_file_mounted_in_storage",
+			MountPaths:    []string{"/data/config.json"},
+			ExpectedError: variable.ErrConflictingMountPath,
+		},
+		{
+			TestName: "success_with_file_variable",
+			Variables: variable.DiffRequest{
+				Create: []variable.DiffCreateRequest{
+					{UpsertRequest: variable.UpsertRequest{Key: "CONFIG", Value: "{}", MountPath: pointer.ToString("/database/config.json")}},
+				},
+			},
+		},
+		{
+			TestName: "fail_with_public_tcp_port_without_external_port",
+			Ports: []port.UpsertRequest{
+				{InternalPort: 1883, PubliclyAccessible: true, Protocol: pointer.ToString(port.ProtocolTCP.String())},
+			},
+			ExpectedError: port.ErrMissingExternalPortParam,
+		},
+		{
+			TestName: "fail_with_custom_domain_on_tcp_port",
+			Ports: []port.UpsertRequest{
+				{InternalPort: 1883, ExternalPort: pointer.ToInt32(1883), PubliclyAccessible: true, Protocol: pointer.ToString(port.ProtocolTCP.String())},
+			},
+			CustomDomains: customdomain.DiffRequest{
+				Create: []customdomain.DiffCreateRequest{
+					{UpsertRequest: customdomain.UpsertRequest{Domain: gofakeit.DomainName()}},
+				},
+			},
+			ExpectedError: application.ErrCustomDomainsRequireHTTPPort,
+		},
+		{
+			TestName: "success_with_custom_domain_on_grpc_port",
+			Ports: []port.UpsertRequest{
+				{InternalPort: 50051, PubliclyAccessible: true, Protocol: pointer.ToString(port.ProtocolGRPC.String())},
+			},
+			CustomDomains: customdomain.DiffRequest{
+				Create: []customdomain.DiffCreateRequest{
+					{UpsertRequest: customdomain.UpsertRequest{Domain: gofakeit.DomainName()}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			applicationUpsertRequest := repositoryRequest
+			applicationUpsertRequest.Ports = tc.Ports
+			request := application.UpsertServiceRequest{
+				ApplicationUpsertRequest: applicationUpsertRequest,
+				EnvironmentVariables:     tc.Variables,
+				Secrets:                  tc.Secrets,
+				CustomDomains:            tc.CustomDomains,
+				MountPaths:               tc.MountPaths,
+			}
+
+			err := request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, request.IsValid())
+		})
+	}
+}
+
+func newHTTPProbe(port int32) *healthcheck.Probe {
+	return &healthcheck.Probe{
+		Type:             healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/", Port: port, Scheme: healthcheck.SchemeHTTP}},
+		PeriodSeconds:    healthcheck.DefaultPeriodSeconds,
+		TimeoutSeconds:   healthcheck.DefaultTimeoutSeconds,
+		SuccessThreshold: healthcheck.DefaultSuccessThreshold,
+		FailureThreshold: healthcheck.DefaultFailureThreshold,
+	}
+}
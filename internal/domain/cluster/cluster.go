@@ -0,0 +1,192 @@
+package cluster
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+const (
+	DefaultKubernetesMode  = KubernetesModeManaged
+	DefaultMinRunningNodes = 3
+	MinMinRunningNodes     = 1
+	DefaultMaxRunningNodes = 10
+	MinMaxRunningNodes     = 1
+)
+
+var (
+	// ErrNilCluster is returned if a Cluster is nil.
+	ErrNilCluster = errors.New("cluster cannot be nil")
+	// ErrInvalidCluster is the error return if a Cluster is invalid.
+	ErrInvalidCluster = errors.New("invalid cluster")
+	// ErrInvalidOrganizationIDParam is returned if the organization id param is invalid.
+	ErrInvalidOrganizationIDParam = errors.New("invalid organization id param")
+	// ErrInvalidClusterIDParam is returned if the cluster id param is invalid.
+	ErrInvalidClusterIDParam = errors.New("invalid cluster id param")
+	// ErrInvalidCredentialsIDParam is returned if the credentials id param is invalid.
+	ErrInvalidCredentialsIDParam = errors.New("invalid credentials id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid name param")
+	// ErrInvalidCloudProviderParam is returned if the cloud provider param is invalid.
+	ErrInvalidCloudProviderParam = errors.New("invalid cloud provider param")
+	// ErrInvalidRegionParam is returned if the region param is invalid.
+	ErrInvalidRegionParam = errors.New("invalid region param")
+	// ErrInvalidKubernetesModeParam is returned if the kubernetes mode param is invalid.
+	ErrInvalidKubernetesModeParam = errors.New("invalid kubernetes mode param")
+	// ErrInvalidMinRunningNodesParam is returned if the min running nodes param is invalid.
+	ErrInvalidMinRunningNodesParam = errors.New("invalid min running nodes param")
+	// ErrInvalidMaxRunningNodesParam is returned if the max running nodes param is invalid.
+	ErrInvalidMaxRunningNodesParam = errors.New("invalid max running nodes param")
+	// ErrInvalidStateParam is returned if the state param is invalid.
+	ErrInvalidStateParam = errors.New("invalid state param")
+	// ErrInvalidFeature is returned if a Feature is invalid.
+	ErrInvalidFeature = errors.New("invalid cluster feature")
+	// ErrInvalidRoute is returned if a Route is invalid.
+	ErrInvalidRoute = errors.New("invalid cluster route")
+	// ErrInvalidAdvancedSettings is returned if the AdvancedSettings are invalid.
+	ErrInvalidAdvancedSettings = errors.New("invalid cluster advanced settings")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid cluster upsert request")
+)
+
+type Cluster struct {
+	ID              uuid.UUID      `validate:"required"`
+	OrganizationID  uuid.UUID      `validate:"required"`
+	Name            string         `validate:"required"`
+	CloudProvider   CloudProvider  `validate:"required"`
+	Region          string         `validate:"required"`
+	KubernetesMode  KubernetesMode `validate:"required"`
+	MinRunningNodes int32
+	MaxRunningNodes int32
+
+	// NOTE: the credentials id can be empty for clusters that are not linked to any credentials.
+	CredentialsID    uuid.UUID
+	Description      string
+	InstanceType     string
+	Features         Features
+	RoutingTable     RoutingTable
+	AdvancedSettings AdvancedSettings
+	State            status.State
+}
+
+// Validate returns an error to tell whether the Cluster domain model is valid or not.
+func (c Cluster) Validate() error {
+	if err := c.CloudProvider.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	if err := c.KubernetesMode.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	if err := c.Features.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	if err := c.RoutingTable.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	if err := validator.New().Struct(c); err != nil {
+		return errors.Wrap(err, ErrInvalidCluster.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Cluster domain model is valid or not.
+func (c Cluster) IsValid() bool {
+	return c.Validate() == nil
+}
+
+// NewClusterParams represents the arguments needed to create a Cluster.
+type NewClusterParams struct {
+	ClusterID       string
+	OrganizationID  string
+	CredentialsID   *string
+	Name            string
+	CloudProvider   string
+	Region          string
+	Description     string
+	KubernetesMode  string
+	InstanceType    string
+	MinRunningNodes int32
+	MaxRunningNodes int32
+
+	Features         Features
+	RoutingTable     RoutingTable
+	AdvancedSettings AdvancedSettings
+	State            *string
+}
+
+// NewCluster returns a new instance of a Cluster domain model.
+func NewCluster(params NewClusterParams) (*Cluster, error) {
+	clusterUUID, err := uuid.Parse(params.ClusterID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidClusterIDParam.Error())
+	}
+
+	organizationUUID, err := uuid.Parse(params.OrganizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidOrganizationIDParam.Error())
+	}
+
+	var credentialsUUID uuid.UUID
+	if params.CredentialsID != nil {
+		credentialsUUID, err = uuid.Parse(*params.CredentialsID)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidCredentialsIDParam.Error())
+		}
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	if params.Region == "" {
+		return nil, ErrInvalidRegionParam
+	}
+
+	cloudProvider, err := NewCloudProviderFromString(params.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidCloudProviderParam.Error())
+	}
+
+	kubernetesMode, err := NewKubernetesModeFromString(params.KubernetesMode)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidKubernetesModeParam.Error())
+	}
+
+	c := &Cluster{
+		ID:               clusterUUID,
+		OrganizationID:   organizationUUID,
+		CredentialsID:    credentialsUUID,
+		Name:             params.Name,
+		CloudProvider:    *cloudProvider,
+		Region:           params.Region,
+		Description:      params.Description,
+		KubernetesMode:   *kubernetesMode,
+		InstanceType:     params.InstanceType,
+		MinRunningNodes:  params.MinRunningNodes,
+		MaxRunningNodes:  params.MaxRunningNodes,
+		Features:         params.Features,
+		RoutingTable:     params.RoutingTable,
+		AdvancedSettings: params.AdvancedSettings,
+	}
+
+	if params.State != nil {
+		clusterState, err := status.NewStateFromString(*params.State)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+		c.State = *clusterState
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
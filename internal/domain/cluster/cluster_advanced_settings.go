@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// AdvancedSettings represents the advanced settings of a cluster indexed by their key (i.e: `loki.log_retention_in_week`).
+// The values are the JSON compatible values of the settings: string, number, bool, list or map.
+type AdvancedSettings map[string]interface{}
+
+// Normalize returns a copy of the AdvancedSettings with the values as decoded from JSON.
+// This allows comparing settings set using Go types (i.e: int64, []string) with the ones returned by the API (i.e: float64, []interface{}).
+func (s AdvancedSettings) Normalize() (AdvancedSettings, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidAdvancedSettings.Error())
+	}
+
+	var normalized AdvancedSettings
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, errors.Wrap(err, ErrInvalidAdvancedSettings.Error())
+	}
+
+	return normalized, nil
+}
+
+// Validate returns an error to tell whether the AdvancedSettings are valid or not.
+func (s AdvancedSettings) Validate() error {
+	_, err := s.Normalize()
+	return err
+}
+
+// IsValid returns a bool to tell whether the AdvancedSettings are valid or not.
+func (s AdvancedSettings) IsValid() bool {
+	return s.Validate() == nil
+}
+
+// HasChanges returns a bool to tell whether at least one of the AdvancedSettings has a different value in the given current settings.
+// The current settings that are not part of the AdvancedSettings are ignored.
+func (s AdvancedSettings) HasChanges(current AdvancedSettings) bool {
+	desired, err := s.Normalize()
+	if err != nil {
+		return true
+	}
+
+	normalizedCurrent, err := current.Normalize()
+	if err != nil {
+		return true
+	}
+
+	for key, value := range desired {
+		currentValue, ok := normalizedCurrent[key]
+		if !ok || !reflect.DeepEqual(value, currentValue) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,62 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestAdvancedSettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, cluster.AdvancedSettings{"loki.log_retention_in_week": 12}.IsValid())
+
+	err := cluster.AdvancedSettings{"invalid": make(chan int)}.Validate()
+	assert.ErrorContains(t, err, cluster.ErrInvalidAdvancedSettings.Error())
+}
+
+func TestAdvancedSettings_HasChanges(t *testing.T) {
+	t.Parallel()
+
+	current := cluster.AdvancedSettings{
+		"loki.log_retention_in_week":             float64(12),
+		"aws.vpc.enable_s3_flow_logs":            false,
+		"cloud_provider.container_registry.tags": map[string]interface{}{"team": "core"},
+	}
+
+	testCases := []struct {
+		TestName        string
+		Settings        cluster.AdvancedSettings
+		ExpectedChanges bool
+	}{
+		{
+			TestName:        "no_changes_when_empty",
+			Settings:        cluster.AdvancedSettings{},
+			ExpectedChanges: false,
+		},
+		{
+			TestName:        "no_changes_with_go_typed_values",
+			Settings:        cluster.AdvancedSettings{"loki.log_retention_in_week": int64(12), "cloud_provider.container_registry.tags": map[string]string{"team": "core"}},
+			ExpectedChanges: false,
+		},
+		{
+			TestName:        "changes_with_different_value",
+			Settings:        cluster.AdvancedSettings{"loki.log_retention_in_week": 8},
+			ExpectedChanges: true,
+		},
+		{
+			TestName:        "changes_with_unknown_key",
+			Settings:        cluster.AdvancedSettings{"aws.iam.admin_group": "Admins"},
+			ExpectedChanges: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedChanges, tc.Settings.HasChanges(current))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package cluster
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// CloudProvider is an enum that contains all the valid values of a cluster cloud provider.
+type CloudProvider string
+
+const (
+	CloudProviderAWS          CloudProvider = "AWS"
+	CloudProviderDigitalOcean CloudProvider = "DO"
+	CloudProviderScaleway     CloudProvider = "SCW"
+	CloudProviderGCP          CloudProvider = "GCP"
+)
+
+// AllowedCloudProviderValues contains all the valid values of a CloudProvider.
+var AllowedCloudProviderValues = []CloudProvider{
+	CloudProviderAWS,
+	CloudProviderDigitalOcean,
+	CloudProviderScaleway,
+	CloudProviderGCP,
+}
+
+// String returns the string value of a CloudProvider.
+func (v CloudProvider) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the CloudProvider is valid or not.
+func (v CloudProvider) Validate() error {
+	if slices.Contains(AllowedCloudProviderValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for CloudProvider: valid values are %v", v, AllowedCloudProviderValues)
+}
+
+// IsValid returns a bool to tell whether the CloudProvider is valid or not.
+func (v CloudProvider) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewCloudProviderFromString tries to turn a string into a CloudProvider.
+// It returns an error if the string is not a valid value.
+func NewCloudProviderFromString(v string) (*CloudProvider, error) {
+	ev := CloudProvider(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
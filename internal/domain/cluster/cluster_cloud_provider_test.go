@@ -0,0 +1,26 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// TestNewCloudProviderFromString validate that the cloud providers qovery.CloudProviderEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the cluster.CloudProvider stays up to date.
+func TestNewCloudProviderFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, cluster.AllowedCloudProviderValues, len(qovery.AllowedCloudProviderEnumEnumValues))
+	for _, qoveryCloudProvider := range qovery.AllowedCloudProviderEnumEnumValues {
+		qoveryCloudProviderStr := string(qoveryCloudProvider)
+		t.Run(qoveryCloudProviderStr, func(t *testing.T) {
+			cloudProvider, err := cluster.NewCloudProviderFromString(qoveryCloudProviderStr)
+			assert.NoError(t, err)
+			assert.Equal(t, cloudProvider.String(), qoveryCloudProviderStr)
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+)
+
+// FeatureID is an enum that contains all the valid values of a cluster feature id.
+type FeatureID string
+
+const (
+	FeatureIDVpcSubnet FeatureID = "VPC_SUBNET"
+	FeatureIDStaticIP  FeatureID = "STATIC_IP"
+)
+
+// AllowedFeatureIDValues contains all the valid values of a FeatureID.
+var AllowedFeatureIDValues = []FeatureID{
+	FeatureIDVpcSubnet,
+	FeatureIDStaticIP,
+}
+
+// String returns the string value of a FeatureID.
+func (v FeatureID) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the FeatureID is valid or not.
+func (v FeatureID) Validate() error {
+	if slices.Contains(AllowedFeatureIDValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for FeatureID: valid values are %v", v, AllowedFeatureIDValues)
+}
+
+// IsValid returns a bool to tell whether the FeatureID is valid or not.
+func (v FeatureID) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewFeatureIDFromString tries to turn a string into a FeatureID.
+// It returns an error if the string is not a valid value.
+func NewFeatureIDFromString(v string) (*FeatureID, error) {
+	ev := FeatureID(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
+
+// Feature represents a cluster feature and its value.
+// Depending on its id, the value of a Feature is either a string (VPC_SUBNET) or a bool (STATIC_IP).
+type Feature struct {
+	ID          FeatureID
+	StringValue *string
+	BoolValue   *bool
+}
+
+// NewVpcSubnetFeature returns a VPC_SUBNET Feature using the given subnet.
+func NewVpcSubnetFeature(vpcSubnet string) Feature {
+	return Feature{
+		ID:          FeatureIDVpcSubnet,
+		StringValue: &vpcSubnet,
+	}
+}
+
+// NewStaticIPFeature returns a STATIC_IP Feature.
+func NewStaticIPFeature(enabled bool) Feature {
+	return Feature{
+		ID:        FeatureIDStaticIP,
+		BoolValue: &enabled,
+	}
+}
+
+// Validate returns an error to tell whether the Feature is valid or not.
+func (f Feature) Validate() error {
+	if err := f.ID.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidFeature.Error())
+	}
+
+	switch f.ID {
+	case FeatureIDVpcSubnet:
+		if f.StringValue == nil || f.BoolValue != nil {
+			return errors.Wrapf(ErrInvalidFeature, "feature %s expects a string value", f.ID)
+		}
+	case FeatureIDStaticIP:
+		if f.BoolValue == nil || f.StringValue != nil {
+			return errors.Wrapf(ErrInvalidFeature, "feature %s expects a bool value", f.ID)
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Feature is valid or not.
+func (f Feature) IsValid() bool {
+	return f.Validate() == nil
+}
+
+// Equal returns a bool to tell whether the Feature has the same id & value as the given one.
+func (f Feature) Equal(other Feature) bool {
+	if f.ID != other.ID {
+		return false
+	}
+
+	switch {
+	case f.StringValue != nil && other.StringValue != nil:
+		return *f.StringValue == *other.StringValue
+	case f.BoolValue != nil && other.BoolValue != nil:
+		return *f.BoolValue == *other.BoolValue
+	}
+
+	return f.StringValue == nil && other.StringValue == nil && f.BoolValue == nil && other.BoolValue == nil
+}
+
+// Features represents a list of Feature.
+type Features []Feature
+
+// Validate returns an error to tell whether the Features are valid or not.
+// A FeatureID can only be used once.
+func (ff Features) Validate() error {
+	seen := make(map[FeatureID]bool, len(ff))
+	for _, f := range ff {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+
+		if seen[f.ID] {
+			return errors.Wrapf(ErrInvalidFeature, "feature %s is defined more than once", f.ID)
+		}
+		seen[f.ID] = true
+	}
+
+	return nil
+}
+
+// Get returns the Feature with the given FeatureID or nil if it is not set.
+func (ff Features) Get(id FeatureID) *Feature {
+	for _, f := range ff {
+		if f.ID == id {
+			feature := f
+			return &feature
+		}
+	}
+
+	return nil
+}
+
+// VpcSubnet returns the value of the VPC_SUBNET Feature or nil if it is not set.
+func (ff Features) VpcSubnet() *string {
+	if f := ff.Get(FeatureIDVpcSubnet); f != nil {
+		return f.StringValue
+	}
+
+	return nil
+}
+
+// StaticIP returns the value of the STATIC_IP Feature or nil if it is not set.
+func (ff Features) StaticIP() *bool {
+	if f := ff.Get(FeatureIDStaticIP); f != nil {
+		return f.BoolValue
+	}
+
+	return nil
+}
+
+// Equal returns a bool to tell whether the Features contain the same features as the given ones, regardless of their order.
+func (ff Features) Equal(other Features) bool {
+	if len(ff) != len(other) {
+		return false
+	}
+
+	for _, f := range ff {
+		otherFeature := other.Get(f.ID)
+		if otherFeature == nil || !f.Equal(*otherFeature) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestFeatureID_Validate(t *testing.T) {
+	t.Parallel()
+
+	for _, v := range cluster.AllowedFeatureIDValues {
+		assert.NoError(t, v.Validate())
+		assert.True(t, v.IsValid())
+
+		featureID, err := cluster.NewFeatureIDFromString(v.String())
+		require.NoError(t, err)
+		assert.Equal(t, v, *featureID)
+	}
+
+	featureID, err := cluster.NewFeatureIDFromString("INVALID")
+	assert.Error(t, err)
+	assert.Nil(t, featureID)
+}
+
+func TestFeature_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Feature       cluster.Feature
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_invalid_id",
+			Feature:       cluster.Feature{ID: "INVALID", BoolValue: pointer.ToBool(true)},
+			ExpectedError: cluster.ErrInvalidFeature,
+		},
+		{
+			TestName:      "fail_with_bool_vpc_subnet",
+			Feature:       cluster.Feature{ID: cluster.FeatureIDVpcSubnet, BoolValue: pointer.ToBool(true)},
+			ExpectedError: cluster.ErrInvalidFeature,
+		},
+		{
+			TestName:      "fail_with_string_static_ip",
+			Feature:       cluster.Feature{ID: cluster.FeatureIDStaticIP, StringValue: pointer.ToString("true")},
+			ExpectedError: cluster.ErrInvalidFeature,
+		},
+		{
+			TestName: "success_with_vpc_subnet",
+			Feature:  cluster.NewVpcSubnetFeature("10.0.0.0/16"),
+		},
+		{
+			TestName: "success_with_static_ip",
+			Feature:  cluster.NewStaticIPFeature(false),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Feature.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Feature.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Feature.IsValid())
+		})
+	}
+}
+
+func TestFeatures_Getters(t *testing.T) {
+	t.Parallel()
+
+	features := cluster.Features{
+		cluster.NewVpcSubnetFeature("10.0.0.0/16"),
+		cluster.NewStaticIPFeature(true),
+	}
+	assert.Equal(t, pointer.ToString("10.0.0.0/16"), features.VpcSubnet())
+	assert.Equal(t, pointer.ToBool(true), features.StaticIP())
+
+	var empty cluster.Features
+	assert.Nil(t, empty.VpcSubnet())
+	assert.Nil(t, empty.StaticIP())
+	assert.Nil(t, empty.Get(cluster.FeatureIDStaticIP))
+}
+
+func TestFeatures_Equal(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Features      cluster.Features
+		Other         cluster.Features
+		ExpectedEqual bool
+	}{
+		{
+			TestName:      "equal_when_both_empty",
+			ExpectedEqual: true,
+		},
+		{
+			TestName:      "equal_regardless_of_order",
+			Features:      cluster.Features{cluster.NewVpcSubnetFeature("10.0.0.0/16"), cluster.NewStaticIPFeature(true)},
+			Other:         cluster.Features{cluster.NewStaticIPFeature(true), cluster.NewVpcSubnetFeature("10.0.0.0/16")},
+			ExpectedEqual: true,
+		},
+		{
+			TestName:      "different_value",
+			Features:      cluster.Features{cluster.NewStaticIPFeature(true)},
+			Other:         cluster.Features{cluster.NewStaticIPFeature(false)},
+			ExpectedEqual: false,
+		},
+		{
+			TestName:      "different_length",
+			Features:      cluster.Features{cluster.NewStaticIPFeature(true)},
+			Other:         cluster.Features{cluster.NewStaticIPFeature(true), cluster.NewVpcSubnetFeature("10.0.0.0/16")},
+			ExpectedEqual: false,
+		},
+		{
+			TestName:      "different_id",
+			Features:      cluster.Features{cluster.NewVpcSubnetFeature("10.0.0.0/16")},
+			Other:         cluster.Features{cluster.NewStaticIPFeature(true)},
+			ExpectedEqual: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedEqual, tc.Features.Equal(tc.Other))
+			assert.Equal(t, tc.ExpectedEqual, tc.Other.Equal(tc.Features))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package cluster
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// KubernetesMode is an enum that contains all the valid values of a cluster kubernetes mode.
+type KubernetesMode string
+
+const (
+	KubernetesModeManaged KubernetesMode = "MANAGED"
+	KubernetesModeK3S     KubernetesMode = "K3S"
+)
+
+// AllowedKubernetesModeValues contains all the valid values of a KubernetesMode.
+var AllowedKubernetesModeValues = []KubernetesMode{
+	KubernetesModeManaged,
+	KubernetesModeK3S,
+}
+
+// String returns the string value of a KubernetesMode.
+func (v KubernetesMode) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the KubernetesMode is valid or not.
+func (v KubernetesMode) Validate() error {
+	if slices.Contains(AllowedKubernetesModeValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for KubernetesMode: valid values are %v", v, AllowedKubernetesModeValues)
+}
+
+// IsValid returns a bool to tell whether the KubernetesMode is valid or not.
+func (v KubernetesMode) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewKubernetesModeFromString tries to turn a string into a KubernetesMode.
+// It returns an error if the string is not a valid value.
+func NewKubernetesModeFromString(v string) (*KubernetesMode, error) {
+	ev := KubernetesMode(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
@@ -0,0 +1,26 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+// TestNewKubernetesModeFromString validate that the kubernetes modes qovery.KubernetesEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the cluster.KubernetesMode stays up to date.
+func TestNewKubernetesModeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, cluster.AllowedKubernetesModeValues, len(qovery.AllowedKubernetesEnumEnumValues))
+	for _, qoveryKubernetesMode := range qovery.AllowedKubernetesEnumEnumValues {
+		qoveryKubernetesModeStr := string(qoveryKubernetesMode)
+		t.Run(qoveryKubernetesModeStr, func(t *testing.T) {
+			kubernetesMode, err := cluster.NewKubernetesModeFromString(qoveryKubernetesModeStr)
+			assert.NoError(t, err)
+			assert.Equal(t, kubernetesMode.String(), qoveryKubernetesModeStr)
+		})
+	}
+}
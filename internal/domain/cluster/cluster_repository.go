@@ -0,0 +1,110 @@
+package cluster
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=ClusterRepository --filename=cluster_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+// Repository represents the interface to implement to handle the persistence of a Cluster.
+type Repository interface {
+	Create(ctx context.Context, organizationID string, request UpsertRepositoryRequest) (*Cluster, error)
+	Get(ctx context.Context, organizationID string, clusterID string) (*Cluster, error)
+	Update(ctx context.Context, organizationID string, clusterID string, request UpsertRepositoryRequest) (*Cluster, error)
+	Delete(ctx context.Context, organizationID string, clusterID string) error
+	EditCloudProviderInfo(ctx context.Context, organizationID string, clusterID string, request CloudProviderInfoRequest) error
+	EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable RoutingTable) (RoutingTable, error)
+	EditAdvancedSettings(ctx context.Context, organizationID string, clusterID string, advancedSettings AdvancedSettings) (AdvancedSettings, error)
+	GetStatus(ctx context.Context, organizationID string, clusterID string) (*status.Status, error)
+	Deploy(ctx context.Context, organizationID string, clusterID string) (*status.Status, error)
+	Stop(ctx context.Context, organizationID string, clusterID string) (*status.Status, error)
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update a Cluster.
+type UpsertRepositoryRequest struct {
+	Name           string `validate:"required"`
+	CloudProvider  string `validate:"required"`
+	Region         string `validate:"required"`
+	KubernetesMode string `validate:"required"`
+
+	Description     *string
+	InstanceType    *string
+	MinRunningNodes *int32
+	MaxRunningNodes *int32
+	Features        Features
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if _, err := NewCloudProviderFromString(r.CloudProvider); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if _, err := NewKubernetesModeFromString(r.KubernetesMode); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.MinRunningNodes != nil && *r.MinRunningNodes < MinMinRunningNodes {
+		return errors.Wrap(ErrInvalidMinRunningNodesParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.MaxRunningNodes != nil && *r.MaxRunningNodes < MinMaxRunningNodes {
+		return errors.Wrap(ErrInvalidMaxRunningNodesParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.MinRunningNodes != nil && r.MaxRunningNodes != nil && *r.MinRunningNodes > *r.MaxRunningNodes {
+		return errors.Wrap(ErrInvalidMaxRunningNodesParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Features.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// EnabledFeatures returns the Features that can be applied on the cluster.
+// K3S clusters don't support any feature so the requested ones are ignored.
+func (r UpsertRepositoryRequest) EnabledFeatures() Features {
+	if KubernetesMode(r.KubernetesMode) == KubernetesModeK3S {
+		return nil
+	}
+
+	return r.Features
+}
+
+// CloudProviderInfoRequest represents the parameters needed to link a Cluster to its cloud provider credentials.
+type CloudProviderInfoRequest struct {
+	CloudProvider   string `validate:"required"`
+	Region          string `validate:"required"`
+	CredentialsID   string `validate:"required"`
+	CredentialsName string
+}
+
+// Validate returns an error to tell whether the CloudProviderInfoRequest is valid or not.
+func (r CloudProviderInfoRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the CloudProviderInfoRequest is valid or not.
+func (r CloudProviderInfoRequest) IsValid() bool {
+	return r.Validate() == nil
+}
@@ -0,0 +1,73 @@
+package cluster
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+// Route represents a route of a cluster RoutingTable.
+type Route struct {
+	Description string `validate:"required"`
+	Destination string `validate:"required"`
+	Target      string `validate:"required"`
+}
+
+// Validate returns an error to tell whether the Route is valid or not.
+func (r Route) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidRoute.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Route is valid or not.
+func (r Route) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// RoutingTable represents the list of Route of a cluster.
+// A destination can only be used by one Route.
+type RoutingTable []Route
+
+// Validate returns an error to tell whether the RoutingTable is valid or not.
+func (rt RoutingTable) Validate() error {
+	seen := make(map[string]bool, len(rt))
+	for _, r := range rt {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+
+		if seen[r.Destination] {
+			return errors.Wrapf(ErrInvalidRoute, "destination %s is used by more than one route", r.Destination)
+		}
+		seen[r.Destination] = true
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the RoutingTable is valid or not.
+func (rt RoutingTable) IsValid() bool {
+	return rt.Validate() == nil
+}
+
+// Equal returns a bool to tell whether the RoutingTable contains the same routes as the given one, regardless of their order.
+func (rt RoutingTable) Equal(other RoutingTable) bool {
+	if len(rt) != len(other) {
+		return false
+	}
+
+	otherByDestination := make(map[string]Route, len(other))
+	for _, r := range other {
+		otherByDestination[r.Destination] = r
+	}
+
+	for _, r := range rt {
+		if otherRoute, ok := otherByDestination[r.Destination]; !ok || otherRoute != r {
+			return false
+		}
+	}
+
+	return true
+}
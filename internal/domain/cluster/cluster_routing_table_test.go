@@ -0,0 +1,110 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestRoutingTable_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		RoutingTable  cluster.RoutingTable
+		ExpectedError error
+	}{
+		{
+			TestName: "success_with_empty_routing_table",
+		},
+		{
+			TestName:      "fail_with_missing_target",
+			RoutingTable:  cluster.RoutingTable{{Description: "peering", Destination: "10.0.0.0/20"}},
+			ExpectedError: cluster.ErrInvalidRoute,
+		},
+		{
+			TestName: "fail_with_duplicated_destination",
+			RoutingTable: cluster.RoutingTable{
+				{Description: "first", Destination: "10.0.0.0/20", Target: "pcx-1"},
+				{Description: "second", Destination: "10.0.0.0/20", Target: "pcx-2"},
+			},
+			ExpectedError: cluster.ErrInvalidRoute,
+		},
+		{
+			TestName: "success",
+			RoutingTable: cluster.RoutingTable{
+				{Description: "first", Destination: "10.0.0.0/20", Target: "pcx-1"},
+				{Description: "second", Destination: "10.1.0.0/20", Target: "pcx-1"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.RoutingTable.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.RoutingTable.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.RoutingTable.IsValid())
+		})
+	}
+}
+
+func TestRoutingTable_Equal(t *testing.T) {
+	t.Parallel()
+
+	first := cluster.Route{Description: "first", Destination: "10.0.0.0/20", Target: "pcx-1"}
+	second := cluster.Route{Description: "second", Destination: "10.1.0.0/20", Target: "pcx-2"}
+
+	testCases := []struct {
+		TestName      string
+		RoutingTable  cluster.RoutingTable
+		Other         cluster.RoutingTable
+		ExpectedEqual bool
+	}{
+		{
+			TestName:      "equal_when_both_empty",
+			RoutingTable:  cluster.RoutingTable{},
+			ExpectedEqual: true,
+		},
+		{
+			TestName:      "equal_regardless_of_order",
+			RoutingTable:  cluster.RoutingTable{first, second},
+			Other:         cluster.RoutingTable{second, first},
+			ExpectedEqual: true,
+		},
+		{
+			TestName:      "different_target",
+			RoutingTable:  cluster.RoutingTable{first},
+			Other:         cluster.RoutingTable{{Description: first.Description, Destination: first.Destination, Target: "pcx-3"}},
+			ExpectedEqual: false,
+		},
+		{
+			TestName:      "different_destination",
+			RoutingTable:  cluster.RoutingTable{first},
+			Other:         cluster.RoutingTable{second},
+			ExpectedEqual: false,
+		},
+		{
+			TestName:      "different_length",
+			RoutingTable:  cluster.RoutingTable{first},
+			Other:         cluster.RoutingTable{first, second},
+			ExpectedEqual: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			assert.Equal(t, tc.ExpectedEqual, tc.RoutingTable.Equal(tc.Other))
+			assert.Equal(t, tc.ExpectedEqual, tc.Other.Equal(tc.RoutingTable))
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+//go:generate mockery --testonly --with-expecter --name=Service --structname=ClusterService --filename=cluster_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateCluster = errors.New("failed to create cluster")
+	ErrFailedToGetCluster    = errors.New("failed to get cluster")
+	ErrFailedToUpdateCluster = errors.New("failed to update cluster")
+	ErrFailedToDeleteCluster = errors.New("failed to delete cluster")
+)
+
+// AllowedDesiredStateValues contains the states a Cluster can be asked to reach.
+var AllowedDesiredStateValues = []status.State{
+	status.StateDeployed,
+	status.StateStopped,
+}
+
+// Service represents the interface to implement to handle the domain logic of a Cluster.
+type Service interface {
+	Create(ctx context.Context, organizationID string, request UpsertServiceRequest) (*Cluster, error)
+	Get(ctx context.Context, organizationID string, clusterID string) (*Cluster, error)
+	Update(ctx context.Context, organizationID string, clusterID string, request UpsertServiceRequest) (*Cluster, error)
+	Delete(ctx context.Context, organizationID string, clusterID string) error
+}
+
+// UpsertServiceRequest represents the parameters needed to create & update a Cluster with its configuration & desired state.
+type UpsertServiceRequest struct {
+	ClusterUpsertRequest UpsertRepositoryRequest
+	CredentialsID        string `validate:"required"`
+	RoutingTable         RoutingTable
+	AdvancedSettings     AdvancedSettings
+	DesiredState         status.State `validate:"required"`
+}
+
+// Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) Validate() error {
+	if err := r.ClusterUpsertRequest.Validate(); err != nil {
+		return err
+	}
+
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if !slices.Contains(AllowedDesiredStateValues, r.DesiredState) {
+		return errors.Wrap(ErrInvalidStateParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.RoutingTable.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertServiceRequest is valid or not.
+func (r UpsertServiceRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// CloudProviderInfoRequest returns the CloudProviderInfoRequest linking the cluster to the requested credentials.
+func (r UpsertServiceRequest) CloudProviderInfoRequest() CloudProviderInfoRequest {
+	return CloudProviderInfoRequest{
+		CloudProvider:   r.ClusterUpsertRequest.CloudProvider,
+		Region:          r.ClusterUpsertRequest.Region,
+		CredentialsID:   r.CredentialsID,
+		CredentialsName: r.ClusterUpsertRequest.Name,
+	}
+}
+
+// HasCredentialsChanges returns a bool to tell whether the request links the given cluster to other credentials.
+// It always returns true for a cluster that doesn't exist yet.
+func (r UpsertServiceRequest) HasCredentialsChanges(current *Cluster) bool {
+	return current == nil || current.CredentialsID.String() != r.CredentialsID
+}
+
+// HasFeaturesChanges returns a bool to tell whether the requested features differ from the ones of the given cluster.
+func (r UpsertServiceRequest) HasFeaturesChanges(current *Cluster) bool {
+	features := r.ClusterUpsertRequest.EnabledFeatures()
+	if current == nil {
+		return len(features) > 0
+	}
+
+	if current.KubernetesMode == KubernetesModeK3S || KubernetesMode(r.ClusterUpsertRequest.KubernetesMode) == KubernetesModeK3S {
+		return false
+	}
+
+	return !features.Equal(current.Features)
+}
+
+// HasRoutingTableChanges returns a bool to tell whether the requested routing table differs from the one of the given cluster.
+func (r UpsertServiceRequest) HasRoutingTableChanges(current *Cluster) bool {
+	if current == nil {
+		return len(r.RoutingTable) > 0
+	}
+
+	return !r.RoutingTable.Equal(current.RoutingTable)
+}
+
+// HasAdvancedSettingsChanges returns a bool to tell whether the requested advanced settings differ from the ones of the given cluster.
+func (r UpsertServiceRequest) HasAdvancedSettingsChanges(current *Cluster) bool {
+	if len(r.AdvancedSettings) == 0 {
+		return false
+	}
+
+	if current == nil {
+		return true
+	}
+
+	return r.AdvancedSettings.HasChanges(current.AdvancedSettings)
+}
+
+// RequiresRedeploy returns a bool to tell whether the cluster has to be redeployed to apply the request.
+// This is the case when its features, routing table or advanced settings have changed.
+func (r UpsertServiceRequest) RequiresRedeploy(current *Cluster) bool {
+	return r.HasFeaturesChanges(current) || r.HasRoutingTableChanges(current) || r.HasAdvancedSettingsChanges(current)
+}
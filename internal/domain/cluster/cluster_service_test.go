@@ -0,0 +1,232 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+func newValidUpsertServiceRequest() cluster.UpsertServiceRequest {
+	return cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: cluster.UpsertRepositoryRequest{
+			Name:            gofakeit.Name(),
+			CloudProvider:   cluster.CloudProviderAWS.String(),
+			Region:          "eu-west-3",
+			KubernetesMode:  cluster.KubernetesModeManaged.String(),
+			MinRunningNodes: pointer.ToInt32(3),
+			MaxRunningNodes: pointer.ToInt32(10),
+			Features:        cluster.Features{cluster.NewStaticIPFeature(true)},
+		},
+		CredentialsID:    gofakeit.UUID(),
+		RoutingTable:     cluster.RoutingTable{{Description: "peering", Destination: "10.0.0.0/20", Target: "pcx-1"}},
+		AdvancedSettings: cluster.AdvancedSettings{"loki.log_retention_in_week": 12},
+		DesiredState:     status.StateDeployed,
+	}
+}
+
+func newClusterMatchingRequest(request cluster.UpsertServiceRequest) *cluster.Cluster {
+	return &cluster.Cluster{
+		ID:               uuid.New(),
+		OrganizationID:   uuid.New(),
+		CredentialsID:    uuid.MustParse(request.CredentialsID),
+		Name:             request.ClusterUpsertRequest.Name,
+		CloudProvider:    cluster.CloudProvider(request.ClusterUpsertRequest.CloudProvider),
+		Region:           request.ClusterUpsertRequest.Region,
+		KubernetesMode:   cluster.KubernetesMode(request.ClusterUpsertRequest.KubernetesMode),
+		Features:         request.ClusterUpsertRequest.Features,
+		RoutingTable:     request.RoutingTable,
+		AdvancedSettings: cluster.AdvancedSettings{"loki.log_retention_in_week": float64(12), "aws.vpc.enable_s3_flow_logs": false},
+		State:            status.StateDeployed,
+	}
+}
+
+func TestUpsertServiceRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       func() cluster.UpsertServiceRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_cluster_request",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.ClusterUpsertRequest.Region = ""
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_missing_credentials",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.CredentialsID = ""
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_desired_state",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.DesiredState = status.StateDeleted
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidStateParam,
+		},
+		{
+			TestName: "fail_with_invalid_routing_table",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.RoutingTable = append(r.RoutingTable, r.RoutingTable[0])
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidRoute,
+		},
+		{
+			TestName: "success",
+			Request:  newValidUpsertServiceRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			request := tc.Request()
+			err := request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, request.IsValid())
+		})
+	}
+}
+
+func TestUpsertServiceRequest_Changes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName                   string
+		Request                    func() cluster.UpsertServiceRequest
+		Current                    func(request cluster.UpsertServiceRequest) *cluster.Cluster
+		ExpectedCredentialsChanges bool
+		ExpectedFeaturesChanges    bool
+		ExpectedRoutingChanges     bool
+		ExpectedSettingsChanges    bool
+	}{
+		{
+			TestName:                   "everything_changes_for_new_cluster",
+			Request:                    newValidUpsertServiceRequest,
+			Current:                    func(cluster.UpsertServiceRequest) *cluster.Cluster { return nil },
+			ExpectedCredentialsChanges: true,
+			ExpectedFeaturesChanges:    true,
+			ExpectedRoutingChanges:     true,
+			ExpectedSettingsChanges:    true,
+		},
+		{
+			TestName: "nothing_changes_for_identical_cluster",
+			Request:  newValidUpsertServiceRequest,
+			Current:  newClusterMatchingRequest,
+		},
+		{
+			TestName: "credentials_changes",
+			Request:  newValidUpsertServiceRequest,
+			Current: func(request cluster.UpsertServiceRequest) *cluster.Cluster {
+				c := newClusterMatchingRequest(request)
+				c.CredentialsID = uuid.New()
+				return c
+			},
+			ExpectedCredentialsChanges: true,
+		},
+		{
+			TestName: "features_changes",
+			Request:  newValidUpsertServiceRequest,
+			Current: func(request cluster.UpsertServiceRequest) *cluster.Cluster {
+				c := newClusterMatchingRequest(request)
+				c.Features = cluster.Features{cluster.NewStaticIPFeature(false)}
+				return c
+			},
+			ExpectedFeaturesChanges: true,
+		},
+		{
+			TestName: "features_are_ignored_for_k3s",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.ClusterUpsertRequest.KubernetesMode = cluster.KubernetesModeK3S.String()
+				return r
+			},
+			Current: func(request cluster.UpsertServiceRequest) *cluster.Cluster {
+				c := newClusterMatchingRequest(request)
+				c.Features = nil
+				return c
+			},
+		},
+		{
+			TestName: "routing_table_changes",
+			Request:  newValidUpsertServiceRequest,
+			Current: func(request cluster.UpsertServiceRequest) *cluster.Cluster {
+				c := newClusterMatchingRequest(request)
+				c.RoutingTable = nil
+				return c
+			},
+			ExpectedRoutingChanges: true,
+		},
+		{
+			TestName: "advanced_settings_changes",
+			Request:  newValidUpsertServiceRequest,
+			Current: func(request cluster.UpsertServiceRequest) *cluster.Cluster {
+				c := newClusterMatchingRequest(request)
+				c.AdvancedSettings = cluster.AdvancedSettings{"loki.log_retention_in_week": float64(8)}
+				return c
+			},
+			ExpectedSettingsChanges: true,
+		},
+		{
+			TestName: "empty_advanced_settings_are_ignored",
+			Request: func() cluster.UpsertServiceRequest {
+				r := newValidUpsertServiceRequest()
+				r.AdvancedSettings = nil
+				return r
+			},
+			Current: newClusterMatchingRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			request := tc.Request()
+			current := tc.Current(request)
+
+			assert.Equal(t, tc.ExpectedCredentialsChanges, request.HasCredentialsChanges(current))
+			assert.Equal(t, tc.ExpectedFeaturesChanges, request.HasFeaturesChanges(current))
+			assert.Equal(t, tc.ExpectedRoutingChanges, request.HasRoutingTableChanges(current))
+			assert.Equal(t, tc.ExpectedSettingsChanges, request.HasAdvancedSettingsChanges(current))
+			assert.Equal(t, tc.ExpectedFeaturesChanges || tc.ExpectedRoutingChanges || tc.ExpectedSettingsChanges, request.RequiresRedeploy(current))
+		})
+	}
+}
+
+func TestUpsertServiceRequest_CloudProviderInfoRequest(t *testing.T) {
+	t.Parallel()
+
+	request := newValidUpsertServiceRequest()
+	info := request.CloudProviderInfoRequest()
+
+	assert.True(t, info.IsValid())
+	assert.Equal(t, request.ClusterUpsertRequest.CloudProvider, info.CloudProvider)
+	assert.Equal(t, request.ClusterUpsertRequest.Region, info.Region)
+	assert.Equal(t, request.CredentialsID, info.CredentialsID)
+	assert.Equal(t, request.ClusterUpsertRequest.Name, info.CredentialsName)
+}
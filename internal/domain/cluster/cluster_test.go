@@ -0,0 +1,308 @@
+package cluster_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+func TestNewCluster(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        cluster.NewClusterParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_cluster_id",
+			Params: cluster.NewClusterParams{
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidClusterIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_organization_id",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidOrganizationIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_credentials_id",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				CredentialsID:  pointer.ToString("invalid"),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidCredentialsIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_name",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_region",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidRegionParam,
+		},
+		{
+			TestName: "fail_with_invalid_cloud_provider",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  "INVALID",
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedError: cluster.ErrInvalidCloudProviderParam,
+		},
+		{
+			TestName: "fail_with_invalid_kubernetes_mode",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: "INVALID",
+			},
+			ExpectedError: cluster.ErrInvalidKubernetesModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_state",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+				State:          pointer.ToString("INVALID"),
+			},
+			ExpectedError: cluster.ErrInvalidStateParam,
+		},
+		{
+			TestName: "fail_with_duplicated_routes",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+				RoutingTable: cluster.RoutingTable{
+					{Description: "first", Destination: "10.0.0.0/20", Target: "pcx-1"},
+					{Description: "second", Destination: "10.0.0.0/20", Target: "pcx-2"},
+				},
+			},
+			ExpectedError: cluster.ErrInvalidCluster,
+		},
+		{
+			TestName: "success_without_credentials",
+			Params: cluster.NewClusterParams{
+				ClusterID:      gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderScaleway.String(),
+				Region:         "fr-par",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+		},
+		{
+			TestName: "success_with_all_params",
+			Params: cluster.NewClusterParams{
+				ClusterID:       gofakeit.UUID(),
+				OrganizationID:  gofakeit.UUID(),
+				CredentialsID:   pointer.ToString(gofakeit.UUID()),
+				Name:            gofakeit.Name(),
+				CloudProvider:   cluster.CloudProviderAWS.String(),
+				Region:          "eu-west-3",
+				Description:     gofakeit.Word(),
+				KubernetesMode:  cluster.KubernetesModeManaged.String(),
+				InstanceType:    "t3a.large",
+				MinRunningNodes: 3,
+				MaxRunningNodes: 10,
+				Features: cluster.Features{
+					cluster.NewVpcSubnetFeature("10.0.0.0/16"),
+					cluster.NewStaticIPFeature(true),
+				},
+				RoutingTable: cluster.RoutingTable{
+					{Description: "peering", Destination: "10.0.0.0/20", Target: "pcx-1"},
+				},
+				AdvancedSettings: cluster.AdvancedSettings{"loki.log_retention_in_week": 12},
+				State:            pointer.ToString(status.StateDeployed.String()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			c, err := cluster.NewCluster(tc.Params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, c)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, c)
+			assert.True(t, c.IsValid())
+			assert.Equal(t, tc.Params.ClusterID, c.ID.String())
+			assert.Equal(t, tc.Params.OrganizationID, c.OrganizationID.String())
+			assert.Equal(t, tc.Params.Name, c.Name)
+			assert.Equal(t, tc.Params.CloudProvider, c.CloudProvider.String())
+			assert.Equal(t, tc.Params.Region, c.Region)
+			assert.Equal(t, tc.Params.Description, c.Description)
+			assert.Equal(t, tc.Params.KubernetesMode, c.KubernetesMode.String())
+			assert.Equal(t, tc.Params.InstanceType, c.InstanceType)
+			assert.Equal(t, tc.Params.MinRunningNodes, c.MinRunningNodes)
+			assert.Equal(t, tc.Params.MaxRunningNodes, c.MaxRunningNodes)
+			assert.Equal(t, tc.Params.Features, c.Features)
+			assert.Equal(t, tc.Params.RoutingTable, c.RoutingTable)
+			assert.Equal(t, tc.Params.AdvancedSettings, c.AdvancedSettings)
+			if tc.Params.CredentialsID != nil {
+				assert.Equal(t, *tc.Params.CredentialsID, c.CredentialsID.String())
+			}
+			if tc.Params.State != nil {
+				assert.Equal(t, *tc.Params.State, c.State.String())
+			}
+		})
+	}
+}
+
+func TestUpsertRepositoryRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	validRequest := func() cluster.UpsertRepositoryRequest {
+		return cluster.UpsertRepositoryRequest{
+			Name:           gofakeit.Name(),
+			CloudProvider:  cluster.CloudProviderAWS.String(),
+			Region:         "eu-west-3",
+			KubernetesMode: cluster.KubernetesModeManaged.String(),
+		}
+	}
+
+	testCases := []struct {
+		TestName      string
+		Request       func() cluster.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_cloud_provider",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.CloudProvider = "INVALID"
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_kubernetes_mode",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.KubernetesMode = "INVALID"
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_min_running_nodes_too_low",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.MinRunningNodes = pointer.ToInt32(0)
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidMinRunningNodesParam,
+		},
+		{
+			TestName: "fail_with_min_greater_than_max_running_nodes",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.MinRunningNodes = pointer.ToInt32(5)
+				r.MaxRunningNodes = pointer.ToInt32(3)
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidMaxRunningNodesParam,
+		},
+		{
+			TestName: "fail_with_duplicated_features",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.Features = cluster.Features{cluster.NewStaticIPFeature(true), cluster.NewStaticIPFeature(false)}
+				return r
+			},
+			ExpectedError: cluster.ErrInvalidFeature,
+		},
+		{
+			TestName: "success",
+			Request: func() cluster.UpsertRepositoryRequest {
+				r := validRequest()
+				r.MinRunningNodes = pointer.ToInt32(3)
+				r.MaxRunningNodes = pointer.ToInt32(3)
+				r.Features = cluster.Features{cluster.NewVpcSubnetFeature("10.0.0.0/16")}
+				return r
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			request := tc.Request()
+			err := request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, request.IsValid())
+		})
+	}
+}
+
+func TestUpsertRepositoryRequest_EnabledFeatures(t *testing.T) {
+	t.Parallel()
+
+	features := cluster.Features{cluster.NewStaticIPFeature(true)}
+
+	managed := cluster.UpsertRepositoryRequest{KubernetesMode: cluster.KubernetesModeManaged.String(), Features: features}
+	assert.Equal(t, features, managed.EnabledFeatures())
+
+	k3s := cluster.UpsertRepositoryRequest{KubernetesMode: cluster.KubernetesModeK3S.String(), Features: features}
+	assert.Nil(t, k3s.EnabledFeatures())
+}
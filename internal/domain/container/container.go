@@ -9,6 +9,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
@@ -54,6 +56,12 @@ var (
 	ErrInvalidContainerEnvironmentVariablesParam = errors.New("invalid container environment variables param")
 	// ErrInvalidContainerSecretsParam is returned if the secrets param is invalid.
 	ErrInvalidContainerSecretsParam = errors.New("invalid container secrets param")
+	// ErrInvalidPortsParam is returned if the ports param is invalid.
+	ErrInvalidPortsParam = errors.New("invalid ports param")
+	// ErrInvalidHealthchecksParam is returned if the healthchecks param is invalid.
+	ErrInvalidHealthchecksParam = errors.New("invalid healthchecks param")
+	// ErrInvalidAdvancedSettingsParam is returned if the advanced settings param is invalid.
+	ErrInvalidAdvancedSettingsParam = errors.New("invalid advanced settings param")
 	// ErrFailedToSetHosts is returned if the internal & external host failed to be set.
 	ErrFailedToSetHosts = errors.New("failed to set hosts")
 )
@@ -75,6 +83,8 @@ type Container struct {
 	Arguments                   []string
 	Storages                    storage.Storages
 	Ports                       port.Ports
+	Healthchecks                healthcheck.Healthchecks
+	AdvancedSettings            advancedsettings.AdvancedSettings
 	EnvironmentVariables        variable.Variables
 	BuiltInEnvironmentVariables variable.Variables
 	Secrets                     secret.Secrets
@@ -94,6 +104,14 @@ func (c Container) Validate() error {
 		return errors.Wrap(err, ErrInvalidContainer.Error())
 	}
 
+	if err := c.Healthchecks.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidContainer.Error())
+	}
+
+	if err := c.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidContainer.Error())
+	}
+
 	if err := validator.New().Struct(c); err != nil {
 		return errors.Wrap(err, ErrInvalidContainer.Error())
 	}
@@ -123,8 +141,10 @@ type NewContainerParams struct {
 	State                *string
 	Entrypoint           *string
 	Arguments            []string
-	Storages             storage.Storages   // TODO(benjaminch): use `storage.NewStoragesParam`
-	Ports                port.Ports         // TODO(benjaminch): use `storage.NewPortsParam`
+	Storages             storage.Storages // TODO(benjaminch): use `storage.NewStoragesParam`
+	Ports                port.Ports       // TODO(benjaminch): use `storage.NewPortsParam`
+	Healthchecks         healthcheck.Healthchecks
+	AdvancedSettings     advancedsettings.AdvancedSettings
 	EnvironmentVariables variable.Variables // TODO(benjaminch): use `storage.NewVariablesParam`
 	Secrets              secret.Secrets     // TODO(benjaminch): use `storage.NewSecretsParam`
 	DeploymentStageID    string
@@ -175,6 +195,8 @@ func NewContainer(params NewContainerParams) (*Container, error) {
 		Arguments:           params.Arguments,
 		Storages:            params.Storages,
 		Ports:               params.Ports,
+		Healthchecks:        params.Healthchecks,
+		AdvancedSettings:    params.AdvancedSettings,
 		DeploymentStageID:   params.DeploymentStageID,
 	}
 
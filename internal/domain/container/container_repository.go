@@ -8,6 +8,8 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
 )
@@ -36,6 +38,8 @@ type UpsertRepositoryRequest struct {
 	Arguments           []string
 	Storages            []storage.UpsertRequest
 	Ports               []port.UpsertRequest
+	Healthchecks        healthcheck.Healthchecks
+	AdvancedSettings    advancedsettings.AdvancedSettings
 	DeploymentStageID   string
 }
 
@@ -45,6 +49,24 @@ func (r UpsertRepositoryRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	for _, p := range r.Ports {
+		if err := p.Validate(); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidPortsParam.Error()), ErrInvalidUpsertRequest.Error())
+		}
+	}
+
+	if err := r.Healthchecks.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidHealthchecksParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Healthchecks.ValidatePorts(r.Ports); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidHealthchecksParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidAdvancedSettingsParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
 	return nil
 }
 
@@ -30,6 +30,8 @@ type UpsertServiceRequest struct {
 	ContainerUpsertRequest UpsertRepositoryRequest
 	EnvironmentVariables   variable.DiffRequest
 	Secrets                secret.DiffRequest
+	// MountPaths are the mount paths of all the planned file environment variables and secrets, including the ones the request doesn't change.
+	MountPaths []string
 }
 
 // Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
@@ -46,6 +48,10 @@ func (r UpsertServiceRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	if err := r.validateMountPaths(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
 	if err := validator.New().Struct(r); err != nil {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
@@ -57,3 +63,16 @@ func (r UpsertServiceRequest) Validate() error {
 func (r UpsertServiceRequest) IsValid() bool {
 	return r.Validate() == nil
 }
+
+// validateMountPaths returns an error if a planned file variable or secret is mounted on a storage.
+func (r UpsertServiceRequest) validateMountPaths() error {
+	storageMountPoints := make([]string, 0, len(r.ContainerUpsertRequest.Storages))
+	for _, s := range r.ContainerUpsertRequest.Storages {
+		storageMountPoints = append(storageMountPoints, s.MountPoint)
+	}
+
+	mountPaths := append(r.EnvironmentVariables.MountPaths(), r.Secrets.MountPaths()...)
+	mountPaths = append(mountPaths, r.MountPaths...)
+
+	return variable.CheckMountPathConflicts(mountPaths, storageMountPoints)
+}
@@ -1,19 +1,36 @@
 package credentials
 
 import (
+	"regexp"
+
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 )
 
 var (
 	ErrInvalidUpsertAwsRequest = errors.New("invalid credentials upsert aws request")
+	// ErrInvalidAwsCredentialsMode is returned if both or none of the static keys and role modes are set on AWS Credentials.
+	ErrInvalidAwsCredentialsMode = errors.New("invalid aws credentials: exactly one of the static keys (access key id and secret access key) or the role (role arn and external id) must be set")
+	// ErrInvalidAwsRoleArn is returned if the role arn of AWS Credentials is not the arn of an IAM role.
+	ErrInvalidAwsRoleArn = errors.New("invalid aws role arn: must be the arn of an IAM role")
 )
 
+// awsRoleArnRegexp matches the arn of an IAM role, e.g. `arn:aws:iam::123456789012:role/qovery`.
+var awsRoleArnRegexp = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/.+$`)
+
 // UpsertAwsRequest represents the parameters needed to create & update AWS Credentials.
+// The credentials either use static keys (AccessKeyID and SecretAccessKey) or a role to assume (RoleArn and ExternalID), not both.
 type UpsertAwsRequest struct {
 	Name            string `validate:"required"`
-	AccessKeyID     string `validate:"required"`
-	SecretAccessKey string `validate:"required"`
+	AccessKeyID     string
+	SecretAccessKey string
+	RoleArn         string
+	ExternalID      string
+}
+
+// IsRoleBased returns a bool to tell whether the UpsertAwsRequest uses a role to assume instead of static keys.
+func (r UpsertAwsRequest) IsRoleBased() bool {
+	return r.RoleArn != "" || r.ExternalID != ""
 }
 
 // Validate returns an error to tell whether the UpsertAwsRequest is valid or not.
@@ -22,6 +39,33 @@ func (r UpsertAwsRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidUpsertAwsRequest.Error())
 	}
 
+	if err := r.ValidateMode(); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertAwsRequest.Error())
+	}
+
+	return nil
+}
+
+// ValidateMode returns an error if the UpsertAwsRequest doesn't set exactly one of the static keys or role modes.
+func (r UpsertAwsRequest) ValidateMode() error {
+	hasStaticKeys := r.AccessKeyID != "" || r.SecretAccessKey != ""
+	if hasStaticKeys == r.IsRoleBased() {
+		return ErrInvalidAwsCredentialsMode
+	}
+
+	if hasStaticKeys && (r.AccessKeyID == "" || r.SecretAccessKey == "") {
+		return ErrInvalidAwsCredentialsMode
+	}
+
+	if r.IsRoleBased() {
+		if r.RoleArn == "" || r.ExternalID == "" {
+			return ErrInvalidAwsCredentialsMode
+		}
+		if !awsRoleArnRegexp.MatchString(r.RoleArn) {
+			return ErrInvalidAwsRoleArn
+		}
+	}
+
 	return nil
 }
 
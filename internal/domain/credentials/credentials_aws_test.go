@@ -44,6 +44,41 @@ func TestUpsertAwsRequest_Validate(t *testing.T) {
 			},
 			ExpectedError: credentials.ErrInvalidUpsertAwsRequest,
 		},
+		{
+			TestName: "fail_without_static_keys_nor_role",
+			Request: credentials.UpsertAwsRequest{
+				Name: gofakeit.Name(),
+			},
+			ExpectedError: credentials.ErrInvalidAwsCredentialsMode,
+		},
+		{
+			TestName: "fail_with_static_keys_and_role",
+			Request: credentials.UpsertAwsRequest{
+				Name:            gofakeit.Name(),
+				AccessKeyID:     gofakeit.Word(),
+				SecretAccessKey: gofakeit.Word(),
+				RoleArn:         "arn:aws:iam::123456789012:role/qovery",
+				ExternalID:      gofakeit.UUID(),
+			},
+			ExpectedError: credentials.ErrInvalidAwsCredentialsMode,
+		},
+		{
+			TestName: "fail_with_role_without_external_id",
+			Request: credentials.UpsertAwsRequest{
+				Name:    gofakeit.Name(),
+				RoleArn: "arn:aws:iam::123456789012:role/qovery",
+			},
+			ExpectedError: credentials.ErrInvalidAwsCredentialsMode,
+		},
+		{
+			TestName: "fail_with_invalid_role_arn",
+			Request: credentials.UpsertAwsRequest{
+				Name:       gofakeit.Name(),
+				RoleArn:    "arn:aws:iam::123456789012:user/qovery",
+				ExternalID: gofakeit.UUID(),
+			},
+			ExpectedError: credentials.ErrInvalidAwsRoleArn,
+		},
 		{
 			TestName: "success",
 			Request: credentials.UpsertAwsRequest{
@@ -52,6 +87,14 @@ func TestUpsertAwsRequest_Validate(t *testing.T) {
 				SecretAccessKey: gofakeit.Word(),
 			},
 		},
+		{
+			TestName: "success_with_role",
+			Request: credentials.UpsertAwsRequest{
+				Name:       gofakeit.Name(),
+				RoleArn:    "arn:aws:iam::123456789012:role/qovery",
+				ExternalID: gofakeit.UUID(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,32 @@
+package credentials
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidUpsertDigitalOceanRequest = errors.New("invalid credentials upsert digitalocean request")
+)
+
+// UpsertDigitalOceanRequest represents the parameters needed to create & update DigitalOcean Credentials.
+type UpsertDigitalOceanRequest struct {
+	Name                        string `validate:"required"`
+	DigitalOceanToken           string `validate:"required"`
+	DigitalOceanSpacesAccessID  string `validate:"required"`
+	DigitalOceanSpacesSecretKey string `validate:"required"`
+}
+
+// Validate returns an error to tell whether the UpsertDigitalOceanRequest is valid or not.
+func (r UpsertDigitalOceanRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertDigitalOceanRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertDigitalOceanRequest is valid or not.
+func (r UpsertDigitalOceanRequest) IsValid() bool {
+	return r.Validate() == nil
+}
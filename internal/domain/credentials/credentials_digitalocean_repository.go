@@ -0,0 +1,15 @@
+package credentials
+
+//go:generate mockery --testonly --with-expecter --name=DigitalOceanRepository --structname=CredentialsDigitalOceanRepository --filename=credentials_digitalocean_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+)
+
+// DigitalOceanRepository represents the interface to implement to handle the persistence of DigitalOcean Credentials.
+type DigitalOceanRepository interface {
+	Create(ctx context.Context, organizationID string, request UpsertDigitalOceanRequest) (*Credentials, error)
+	Get(ctx context.Context, organizationID string, credentialsID string) (*Credentials, error)
+	Update(ctx context.Context, organizationID string, credentialsID string, request UpsertDigitalOceanRequest) (*Credentials, error)
+	Delete(ctx context.Context, organizationID string, credentialsID string) error
+}
@@ -0,0 +1,22 @@
+package credentials
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrFailedToCreateDigitalOceanCredentials = errors.New("failed to create digitalocean credentials")
+	ErrFailedToGetDigitalOceanCredentials    = errors.New("failed to get digitalocean credentials")
+	ErrFailedToUpdateDigitalOceanCredentials = errors.New("failed to update digitalocean credentials")
+	ErrFailedToDeleteDigitalOceanCredentials = errors.New("failed to delete digitalocean credentials")
+)
+
+// DigitalOceanService represents the interface to implement to handle the domain logic of DigitalOcean Credentials.
+type DigitalOceanService interface {
+	Create(ctx context.Context, organizationID string, request UpsertDigitalOceanRequest) (*Credentials, error)
+	Get(ctx context.Context, organizationID string, credentialsID string) (*Credentials, error)
+	Update(ctx context.Context, organizationID string, credentialsID string, request UpsertDigitalOceanRequest) (*Credentials, error)
+	Delete(ctx context.Context, organizationID string, credentialsID string) error
+}
@@ -0,0 +1,81 @@
+package credentials_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+func TestUpsertDigitalOceanRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       credentials.UpsertDigitalOceanRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_name",
+			Request: credentials.UpsertDigitalOceanRequest{
+				DigitalOceanToken:           gofakeit.Word(),
+				DigitalOceanSpacesAccessID:  gofakeit.Word(),
+				DigitalOceanSpacesSecretKey: gofakeit.Word(),
+			},
+			ExpectedError: credentials.ErrInvalidUpsertDigitalOceanRequest,
+		},
+		{
+			TestName: "fail_with_invalid_digitalocean_token",
+			Request: credentials.UpsertDigitalOceanRequest{
+				Name:                        gofakeit.Name(),
+				DigitalOceanSpacesAccessID:  gofakeit.Word(),
+				DigitalOceanSpacesSecretKey: gofakeit.Word(),
+			},
+			ExpectedError: credentials.ErrInvalidUpsertDigitalOceanRequest,
+		},
+		{
+			TestName: "fail_with_digitalocean_spaces_access_id",
+			Request: credentials.UpsertDigitalOceanRequest{
+				Name:                        gofakeit.Name(),
+				DigitalOceanToken:           gofakeit.Word(),
+				DigitalOceanSpacesSecretKey: gofakeit.Word(),
+			},
+			ExpectedError: credentials.ErrInvalidUpsertDigitalOceanRequest,
+		},
+		{
+			TestName: "fail_with_digitalocean_spaces_secret_key",
+			Request: credentials.UpsertDigitalOceanRequest{
+				Name:                       gofakeit.Name(),
+				DigitalOceanToken:          gofakeit.Word(),
+				DigitalOceanSpacesAccessID: gofakeit.Word(),
+			},
+			ExpectedError: credentials.ErrInvalidUpsertDigitalOceanRequest,
+		},
+		{
+			TestName: "success",
+			Request: credentials.UpsertDigitalOceanRequest{
+				Name:                        gofakeit.Name(),
+				DigitalOceanToken:           gofakeit.Word(),
+				DigitalOceanSpacesAccessID:  gofakeit.Word(),
+				DigitalOceanSpacesSecretKey: gofakeit.Word(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package credentials
+
+import (
+	"encoding/json"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidUpsertGcpRequest = errors.New("invalid credentials upsert gcp request")
+	// ErrInvalidGcpCredentials is returned if the GCP credentials are not a valid service account key.
+	ErrInvalidGcpCredentials = errors.New("invalid gcp credentials: must be the json key of a service account")
+)
+
+// gcpServiceAccountKey represents the fields of the json key of a GCP service account needed by Qovery.
+type gcpServiceAccountKey struct {
+	Type        string `json:"type" validate:"eq=service_account"`
+	ProjectID   string `json:"project_id" validate:"required"`
+	PrivateKey  string `json:"private_key" validate:"required"`
+	ClientEmail string `json:"client_email" validate:"required"`
+}
+
+// UpsertGcpRequest represents the parameters needed to create & update GCP Credentials.
+type UpsertGcpRequest struct {
+	Name string `validate:"required"`
+	// GcpCredentials is the json key of the service account used by Qovery.
+	GcpCredentials string `validate:"required"`
+}
+
+// Validate returns an error to tell whether the UpsertGcpRequest is valid or not.
+func (r UpsertGcpRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertGcpRequest.Error())
+	}
+
+	if err := validateGcpCredentials(r.GcpCredentials); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertGcpRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertGcpRequest is valid or not.
+func (r UpsertGcpRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// validateGcpCredentials returns an error if the given credentials are not the json key of a GCP service account.
+func validateGcpCredentials(gcpCredentials string) error {
+	var key gcpServiceAccountKey
+	if err := json.Unmarshal([]byte(gcpCredentials), &key); err != nil {
+		return errors.Wrap(err, ErrInvalidGcpCredentials.Error())
+	}
+
+	if err := validator.New().Struct(key); err != nil {
+		return errors.Wrap(err, ErrInvalidGcpCredentials.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package credentials
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrFailedToCreateGcpCredentials = errors.New("failed to create gcp credentials")
+	ErrFailedToGetGcpCredentials    = errors.New("failed to get gcp credentials")
+	ErrFailedToUpdateGcpCredentials = errors.New("failed to update gcp credentials")
+	ErrFailedToDeleteGcpCredentials = errors.New("failed to delete gcp credentials")
+)
+
+// GcpService represents the interface to implement to handle the domain logic of GCP Credentials.
+type GcpService interface {
+	Create(ctx context.Context, organizationID string, request UpsertGcpRequest) (*Credentials, error)
+	Get(ctx context.Context, organizationID string, credentialsID string) (*Credentials, error)
+	Update(ctx context.Context, organizationID string, credentialsID string, request UpsertGcpRequest) (*Credentials, error)
+	Delete(ctx context.Context, organizationID string, credentialsID string) error
+}
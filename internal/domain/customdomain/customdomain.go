@@ -0,0 +1,127 @@
+package customdomain
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNilCustomDomain is returned if a CustomDomain is nil.
+	ErrNilCustomDomain = errors.New("custom domain cannot be nil")
+	// ErrInvalidCustomDomain is the error return if a CustomDomain is invalid.
+	ErrInvalidCustomDomain = errors.New("invalid custom domain")
+	// ErrInvalidCustomDomains is the error return if a CustomDomains is invalid.
+	ErrInvalidCustomDomains = errors.New("invalid custom domains")
+	// ErrInvalidResourceIDParam is returned if the resource id param is invalid.
+	ErrInvalidResourceIDParam = errors.New("invalid resource id param")
+	// ErrInvalidCustomDomainIDParam is returned if the custom domain id param is invalid.
+	ErrInvalidCustomDomainIDParam = errors.New("invalid custom domain id param")
+	// ErrInvalidDomainParam is returned if the domain param is invalid.
+	ErrInvalidDomainParam = errors.New("invalid domain param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid custom domain upsert request")
+	// ErrInvalidDiffRequest is returned if the diff request is invalid.
+	ErrInvalidDiffRequest = errors.New("invalid custom domain diff request")
+)
+
+// StatusValidationPending is the status of a CustomDomain whose certificate has not been issued yet.
+const StatusValidationPending = "VALIDATION_PENDING"
+
+type CustomDomains []CustomDomain
+
+// Validate returns an error to tell whether the CustomDomains domain model is valid or not.
+func (dd CustomDomains) Validate() error {
+	for _, it := range dd {
+		if err := it.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidCustomDomains.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the CustomDomains domain model is valid or not.
+func (dd CustomDomains) IsValid() bool {
+	return dd.Validate() == nil
+}
+
+type CustomDomain struct {
+	ID     uuid.UUID `validate:"required"`
+	Domain string    `validate:"required"`
+
+	ValidationDomain *string
+	Status           *string
+}
+
+// Validate returns an error to tell whether the CustomDomain domain model is valid or not.
+func (d CustomDomain) Validate() error {
+	if err := validator.New().Struct(d); err != nil {
+		return errors.Wrap(err, ErrInvalidCustomDomain.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the CustomDomain domain model is valid or not.
+func (d CustomDomain) IsValid() bool {
+	return d.Validate() == nil
+}
+
+// IsCertificateIssued returns a bool to tell whether the certificate of the CustomDomain has been issued or not.
+// The certificate is considered pending as long as the api does not return a status.
+func (d CustomDomain) IsCertificateIssued() bool {
+	return d.Status != nil && *d.Status != StatusValidationPending
+}
+
+// NewCustomDomainParams represents the arguments needed to create a CustomDomain.
+type NewCustomDomainParams struct {
+	CustomDomainID   string
+	Domain           string
+	ValidationDomain *string
+	Status           *string
+}
+
+// NewCustomDomain returns a new instance of a CustomDomain domain model.
+func NewCustomDomain(params NewCustomDomainParams) (*CustomDomain, error) {
+	customDomainUUID, err := uuid.Parse(params.CustomDomainID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidCustomDomainIDParam.Error())
+	}
+
+	if params.Domain == "" {
+		return nil, ErrInvalidDomainParam
+	}
+
+	d := &CustomDomain{
+		ID:               customDomainUUID,
+		Domain:           params.Domain,
+		ValidationDomain: params.ValidationDomain,
+		Status:           params.Status,
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// UpsertRequest represents the parameters needed to create a CustomDomain.
+type UpsertRequest struct {
+	Domain string `validate:"required"`
+}
+
+// Validate returns an error to tell whether the UpsertRequest is valid or not.
+func (r UpsertRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRequest is valid or not.
+func (r UpsertRequest) IsValid() bool {
+	return r.Validate() == nil
+}
@@ -0,0 +1,16 @@
+package customdomain
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=CustomDomainRepository --filename=customdomain_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+)
+
+// Repository represents the interface to implement to handle the persistence of a CustomDomain.
+// scopeResourceID is the id of the service owning the custom domain.
+type Repository interface {
+	Create(ctx context.Context, scopeResourceID string, request UpsertRequest) (*CustomDomain, error)
+	Get(ctx context.Context, scopeResourceID string, customDomainID string) (*CustomDomain, error)
+	List(ctx context.Context, scopeResourceID string) (CustomDomains, error)
+	Delete(ctx context.Context, scopeResourceID string, customDomainID string) error
+}
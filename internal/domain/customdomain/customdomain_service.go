@@ -0,0 +1,71 @@
+package customdomain
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --testonly --with-expecter --name=Service --structname=CustomDomainService --filename=customdomain_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateCustomDomain             = errors.New("failed to create custom domain")
+	ErrFailedToGetCustomDomain                = errors.New("failed to get custom domain")
+	ErrFailedToDeleteCustomDomain             = errors.New("failed to delete custom domain")
+	ErrFailedToWaitForCustomDomainCertificate = errors.New("failed to wait for custom domain certificate")
+	ErrFailedToListCustomDomains              = errors.New("failed to list custom domains")
+	ErrFailedToUpdateCustomDomains            = errors.New("failed to update custom domains")
+)
+
+// Service represents the interface to implement to handle the domain logic of a CustomDomain.
+type Service interface {
+	Create(ctx context.Context, scopeResourceID string, request UpsertRequest) (*CustomDomain, error)
+	Get(ctx context.Context, scopeResourceID string, customDomainID string) (*CustomDomain, error)
+	Delete(ctx context.Context, scopeResourceID string, customDomainID string) error
+	WaitForCertificate(ctx context.Context, scopeResourceID string, customDomainID string) (*CustomDomain, error)
+	List(ctx context.Context, scopeResourceID string) (CustomDomains, error)
+	Update(ctx context.Context, scopeResourceID string, request DiffRequest) (CustomDomains, error)
+}
+
+// DiffRequest represents the parameters needed to create & delete CustomDomains.
+type DiffRequest struct {
+	Create []DiffCreateRequest
+	Delete []DiffDeleteRequest
+}
+
+// Validate returns an error to tell whether the DiffRequest is valid or not.
+func (r DiffRequest) Validate() error {
+	for _, c := range r.Create {
+		if err := c.Validate(); err != nil {
+			return errors.Wrap(err, ErrInvalidDiffRequest.Error())
+		}
+	}
+
+	for _, d := range r.Delete {
+		if err := validator.New().Struct(d); err != nil {
+			return errors.Wrap(err, ErrInvalidDiffRequest.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the DiffRequest is valid or not.
+func (r DiffRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// IsEmpty returns a bool to tell whether the DiffRequest is empty or not.
+func (r DiffRequest) IsEmpty() bool {
+	return len(r.Create) == 0 &&
+		len(r.Delete) == 0
+}
+
+type DiffCreateRequest struct {
+	UpsertRequest
+}
+
+type DiffDeleteRequest struct {
+	CustomDomainID string `validate:"required"`
+}
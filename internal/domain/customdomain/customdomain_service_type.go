@@ -0,0 +1,52 @@
+package customdomain
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// ServiceType is an enum that contains all the types of service a CustomDomain can be attached to.
+type ServiceType string
+
+const (
+	ServiceTypeApplication ServiceType = "APPLICATION"
+	ServiceTypeContainer   ServiceType = "CONTAINER"
+)
+
+// AllowedServiceTypeValues contains all the valid values of a ServiceType.
+var AllowedServiceTypeValues = []ServiceType{
+	ServiceTypeApplication,
+	ServiceTypeContainer,
+}
+
+// String returns the string value of a ServiceType.
+func (v ServiceType) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the ServiceType is valid or not.
+func (v ServiceType) Validate() error {
+	if slices.Contains(AllowedServiceTypeValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for ServiceType: valid values are %v", v, AllowedServiceTypeValues)
+}
+
+// IsValid returns a bool to tell whether the ServiceType is valid or not.
+func (v ServiceType) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewServiceTypeFromString tries to turn a string into a ServiceType.
+// It returns an error if the string is not a valid value.
+func NewServiceTypeFromString(v string) (*ServiceType, error) {
+	ev := ServiceType(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
@@ -0,0 +1,123 @@
+package customdomain_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+func TestNewCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        customdomain.NewCustomDomainParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_custom_domain_id",
+			Params: customdomain.NewCustomDomainParams{
+				Domain: gofakeit.DomainName(),
+			},
+			ExpectedError: customdomain.ErrInvalidCustomDomainIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_domain",
+			Params: customdomain.NewCustomDomainParams{
+				CustomDomainID: gofakeit.UUID(),
+			},
+			ExpectedError: customdomain.ErrInvalidDomainParam,
+		},
+		{
+			TestName: "success_without_optional_fields",
+			Params: customdomain.NewCustomDomainParams{
+				CustomDomainID: gofakeit.UUID(),
+				Domain:         gofakeit.DomainName(),
+			},
+		},
+		{
+			TestName: "success",
+			Params: customdomain.NewCustomDomainParams{
+				CustomDomainID:   gofakeit.UUID(),
+				Domain:           gofakeit.DomainName(),
+				ValidationDomain: pointer.ToString(gofakeit.DomainName()),
+				Status:           pointer.ToString("VALIDATION_PENDING"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			d, err := customdomain.NewCustomDomain(tc.Params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, d)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, d)
+			assert.True(t, d.IsValid())
+			assert.Equal(t, tc.Params.CustomDomainID, d.ID.String())
+			assert.Equal(t, tc.Params.Domain, d.Domain)
+			assert.Equal(t, tc.Params.ValidationDomain, d.ValidationDomain)
+			assert.Equal(t, tc.Params.Status, d.Status)
+		})
+	}
+}
+
+func TestDiffRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       customdomain.DiffRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "success_with_empty_request",
+			Request:  customdomain.DiffRequest{},
+		},
+		{
+			TestName: "fail_with_empty_domain_to_create",
+			Request: customdomain.DiffRequest{
+				Create: []customdomain.DiffCreateRequest{{}},
+			},
+			ExpectedError: customdomain.ErrInvalidDiffRequest,
+		},
+		{
+			TestName: "fail_with_empty_custom_domain_id_to_delete",
+			Request: customdomain.DiffRequest{
+				Delete: []customdomain.DiffDeleteRequest{{}},
+			},
+			ExpectedError: customdomain.ErrInvalidDiffRequest,
+		},
+		{
+			TestName: "success",
+			Request: customdomain.DiffRequest{
+				Create: []customdomain.DiffCreateRequest{{UpsertRequest: customdomain.UpsertRequest{Domain: gofakeit.DomainName()}}},
+				Delete: []customdomain.DiffDeleteRequest{{CustomDomainID: gofakeit.UUID()}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
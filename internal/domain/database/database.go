@@ -0,0 +1,231 @@
+package database
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+const (
+	DefaultAccessibility = AccessibilityPublic
+	DefaultCPU           = 250
+	MinCPU               = 250
+	DefaultMemory        = 256
+	MinMemory            = 100
+	DefaultStorage       = 10
+	MinStorage           = 10
+)
+
+var (
+	// ErrNilDatabase is returned if a Database is nil.
+	ErrNilDatabase = errors.New("database cannot be nil")
+	// ErrInvalidDatabase is the error return if a Database is invalid.
+	ErrInvalidDatabase = errors.New("invalid database")
+	// ErrInvalidEnvironmentIDParam is returned if the environment id param is invalid.
+	ErrInvalidEnvironmentIDParam = errors.New("invalid environment id param")
+	// ErrInvalidDatabaseIDParam is returned if the database id param is invalid.
+	ErrInvalidDatabaseIDParam = errors.New("invalid database id param")
+	// ErrInvalidNameParam is returned if the name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid name param")
+	// ErrInvalidTypeParam is returned if the type param is invalid.
+	ErrInvalidTypeParam = errors.New("invalid type param")
+	// ErrInvalidVersionParam is returned if the version param is invalid.
+	ErrInvalidVersionParam = errors.New("invalid version param")
+	// ErrInvalidModeParam is returned if the mode param is invalid.
+	ErrInvalidModeParam = errors.New("invalid mode param")
+	// ErrInvalidAccessibilityParam is returned if the accessibility param is invalid.
+	ErrInvalidAccessibilityParam = errors.New("invalid accessibility param")
+	// ErrInvalidCPUParam is returned if the cpu param is invalid.
+	ErrInvalidCPUParam = errors.New("invalid cpu param")
+	// ErrInvalidMemoryParam is returned if the memory param is invalid.
+	ErrInvalidMemoryParam = errors.New("invalid memory param")
+	// ErrInvalidStorageParam is returned if the storage param is invalid.
+	ErrInvalidStorageParam = errors.New("invalid storage param")
+	// ErrInvalidAdvancedSettingsParam is returned if the advanced settings param is invalid.
+	ErrInvalidAdvancedSettingsParam = errors.New("invalid advanced settings param")
+	// ErrInvalidStateParam is returned if the state param is invalid.
+	ErrInvalidStateParam = errors.New("invalid state param")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid database upsert request")
+	// ErrUnsupportedModeParam is returned if the mode can't be used for the database type on the environment cloud provider.
+	ErrUnsupportedModeParam = errors.New("unsupported mode param")
+)
+
+type Database struct {
+	ID            uuid.UUID     `validate:"required"`
+	EnvironmentID uuid.UUID     `validate:"required"`
+	Name          string        `validate:"required"`
+	Type          Type          `validate:"required"`
+	Version       string        `validate:"required"`
+	Mode          Mode          `validate:"required"`
+	Accessibility Accessibility `validate:"required"`
+	CPU           int32         `validate:"required"`
+	Memory        int32         `validate:"required"`
+	Storage       int32         `validate:"required"`
+
+	ExternalHost      *string
+	InternalHost      *string
+	Port              *int32
+	Credentials       *Credentials
+	AdvancedSettings  advancedsettings.AdvancedSettings
+	State             status.State
+	DeploymentStageID string
+}
+
+// Validate returns an error to tell whether the Database domain model is valid or not.
+func (d Database) Validate() error {
+	if err := d.Type.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if err := d.Mode.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if err := d.Accessibility.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if err := d.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	if err := validator.New().Struct(d); err != nil {
+		return errors.Wrap(err, ErrInvalidDatabase.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Database domain model is valid or not.
+func (d Database) IsValid() bool {
+	return d.Validate() == nil
+}
+
+// NewDatabaseParams represents the arguments needed to create a Database.
+type NewDatabaseParams struct {
+	DatabaseID    string
+	EnvironmentID string
+	Name          string
+	Type          string
+	Version       string
+	Mode          string
+	Accessibility string
+	CPU           int32
+	Memory        int32
+	Storage       int32
+
+	ExternalHost      *string
+	Port              *int32
+	Credentials       *Credentials
+	AdvancedSettings  advancedsettings.AdvancedSettings
+	State             *string
+	DeploymentStageID string
+}
+
+// NewDatabase returns a new instance of a Database domain model.
+func NewDatabase(params NewDatabaseParams) (*Database, error) {
+	databaseUUID, err := uuid.Parse(params.DatabaseID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidDatabaseIDParam.Error())
+	}
+
+	environmentUUID, err := uuid.Parse(params.EnvironmentID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidEnvironmentIDParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	if params.Version == "" {
+		return nil, ErrInvalidVersionParam
+	}
+
+	databaseType, err := NewTypeFromString(params.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidTypeParam.Error())
+	}
+
+	mode, err := NewModeFromString(params.Mode)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidModeParam.Error())
+	}
+
+	accessibility, err := NewAccessibilityFromString(params.Accessibility)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidAccessibilityParam.Error())
+	}
+
+	d := &Database{
+		ID:                databaseUUID,
+		EnvironmentID:     environmentUUID,
+		Name:              params.Name,
+		Type:              *databaseType,
+		Version:           params.Version,
+		Mode:              *mode,
+		Accessibility:     *accessibility,
+		CPU:               params.CPU,
+		Memory:            params.Memory,
+		Storage:           params.Storage,
+		ExternalHost:      params.ExternalHost,
+		Port:              params.Port,
+		Credentials:       params.Credentials,
+		AdvancedSettings:  params.AdvancedSettings,
+		DeploymentStageID: params.DeploymentStageID,
+	}
+
+	if params.State != nil {
+		databaseState, err := status.NewStateFromString(*params.State)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+
+		if err := d.SetState(*databaseState); err != nil {
+			return nil, errors.Wrap(err, ErrInvalidStateParam.Error())
+		}
+	}
+
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// SetInternalHost takes the variable.Variables of the database environment and sets the attribute InternalHost using the built-in host variable of the database.
+// The internal host is only exposed through the environment variables with the key `QOVERY_{DB-TYPE}_Z{DB-ID}_HOST_INTERNAL`.
+func (d *Database) SetInternalHost(vars variable.Variables) {
+	hostInternalKey := fmt.Sprintf("QOVERY_%s_Z%s_HOST_INTERNAL", d.Type, strings.ToUpper(strings.Split(d.ID.String(), "-")[0]))
+
+	for _, v := range vars {
+		if v.Key == hostInternalKey {
+			host := v.Value
+			d.InternalHost = &host
+			return
+		}
+	}
+}
+
+// SetState takes a status.State and sets the attributes State.
+func (d *Database) SetState(st status.State) error {
+	if err := st.Validate(); err != nil {
+		return err
+	}
+
+	if st == status.StateReady {
+		st = status.StateStopped
+	}
+
+	d.State = st
+
+	return nil
+}
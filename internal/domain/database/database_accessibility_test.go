@@ -0,0 +1,26 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// TestNewAccessibilityFromString validate that the accessibilities qovery.DatabaseAccessibilityEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Accessibility stays up to date.
+func TestNewAccessibilityFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedAccessibilityValues, len(qovery.AllowedDatabaseAccessibilityEnumEnumValues))
+	for _, qoveryAccessibility := range qovery.AllowedDatabaseAccessibilityEnumEnumValues {
+		qoveryAccessibilityStr := string(qoveryAccessibility)
+		t.Run(qoveryAccessibilityStr, func(t *testing.T) {
+			accessibility, err := database.NewAccessibilityFromString(qoveryAccessibilityStr)
+			assert.NoError(t, err)
+			assert.Equal(t, accessibility.String(), qoveryAccessibilityStr)
+		})
+	}
+}
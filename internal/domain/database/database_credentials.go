@@ -0,0 +1,10 @@
+package database
+
+// Credentials represents the master credentials used to connect to a Database.
+// Some database types (e.g. REDIS) don't have a login, hence none of the fields are required.
+type Credentials struct {
+	Login    string
+	Password string
+	Host     string
+	Port     int32
+}
@@ -0,0 +1,72 @@
+package database
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Mode is an enum that contains all the valid values of a database mode.
+type Mode string
+
+const (
+	ModeContainer Mode = "CONTAINER"
+	ModeManaged   Mode = "MANAGED"
+)
+
+// AllowedModeValues contains all the valid values of a Mode.
+var AllowedModeValues = []Mode{
+	ModeContainer,
+	ModeManaged,
+}
+
+// String returns the string value of a Mode.
+func (v Mode) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the Mode is valid or not.
+func (v Mode) Validate() error {
+	if slices.Contains(AllowedModeValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for Mode: valid values are %v", v, AllowedModeValues)
+}
+
+// IsValid returns a bool to tell whether the Mode is valid or not.
+func (v Mode) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// NewModeFromString tries to turn a string into a Mode.
+// It returns an error if the string is not a valid value.
+func NewModeFromString(v string) (*Mode, error) {
+	ev := Mode(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
+
+// managedModeTypesByCloudProvider lists the database types that can be run in ModeManaged for each cloud provider.
+// A cloud provider that is not listed doesn't support ModeManaged at all.
+var managedModeTypesByCloudProvider = map[string][]Type{
+	"AWS": {TypeMongoDB, TypeMySQL, TypePostgreSQL, TypeRedis},
+	"SCW": {TypeMySQL, TypePostgreSQL},
+}
+
+// ValidateForCloudProvider returns an error to tell whether a database of the given Type can be run with this Mode on the given cloud provider.
+func (v Mode) ValidateForCloudProvider(databaseType Type, cloudProvider string) error {
+	if v != ModeManaged {
+		return nil
+	}
+
+	if slices.Contains(managedModeTypesByCloudProvider[cloudProvider], databaseType) {
+		return nil
+	}
+
+	return fmt.Errorf("%s database can't be run in %s mode on cloud provider '%s'", databaseType, v, cloudProvider)
+}
@@ -0,0 +1,26 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// TestNewModeFromString validate that the modes qovery.DatabaseModeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Mode stays up to date.
+func TestNewModeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedModeValues, len(qovery.AllowedDatabaseModeEnumEnumValues))
+	for _, qoveryMode := range qovery.AllowedDatabaseModeEnumEnumValues {
+		qoveryModeStr := string(qoveryMode)
+		t.Run(qoveryModeStr, func(t *testing.T) {
+			mode, err := database.NewModeFromString(qoveryModeStr)
+			assert.NoError(t, err)
+			assert.Equal(t, mode.String(), qoveryModeStr)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package database
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=DatabaseRepository --filename=database_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+// Repository represents the interface to implement to handle the persistence of a Database.
+type Repository interface {
+	Create(ctx context.Context, environmentID string, request UpsertRepositoryRequest) (*Database, error)
+	Get(ctx context.Context, databaseID string) (*Database, error)
+	Update(ctx context.Context, databaseID string, request UpsertRepositoryRequest) (*Database, error)
+	Delete(ctx context.Context, databaseID string) error
+}
+
+// UpsertRepositoryRequest represents the parameters needed to create & update a Database.
+type UpsertRepositoryRequest struct {
+	Name    string `validate:"required"`
+	Type    string `validate:"required"`
+	Version string `validate:"required"`
+	Mode    string `validate:"required"`
+
+	Accessibility     *string
+	CPU               *int32
+	Memory            *int32
+	Storage           *int32
+	AdvancedSettings  advancedsettings.AdvancedSettings
+	DeploymentStageID string
+}
+
+// Validate returns an error to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if _, err := NewTypeFromString(r.Type); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if _, err := NewModeFromString(r.Mode); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.Accessibility != nil {
+		if _, err := NewAccessibilityFromString(*r.Accessibility); err != nil {
+			return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+		}
+	}
+
+	if r.CPU != nil && *r.CPU < MinCPU {
+		return errors.Wrap(ErrInvalidCPUParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.Memory != nil && *r.Memory < MinMemory {
+		return errors.Wrap(ErrInvalidMemoryParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.Storage != nil && *r.Storage < MinStorage {
+		return errors.Wrap(ErrInvalidStorageParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidAdvancedSettingsParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
+func (r UpsertRepositoryRequest) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// ValidateForCloudProvider returns an error to tell whether the UpsertRepositoryRequest can be applied on an environment running on the given cloud provider.
+func (r UpsertRepositoryRequest) ValidateForCloudProvider(cloudProvider string) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	mode := Mode(r.Mode)
+	if err := mode.ValidateForCloudProvider(Type(r.Type), cloudProvider); err != nil {
+		return errors.Wrap(err, ErrUnsupportedModeParam.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package database
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --testonly --with-expecter --name=Service --structname=DatabaseService --filename=database_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateDatabase = errors.New("failed to create database")
+	ErrFailedToGetDatabase    = errors.New("failed to get database")
+	ErrFailedToUpdateDatabase = errors.New("failed to update database")
+	ErrFailedToDeleteDatabase = errors.New("failed to delete database")
+)
+
+// Service represents the interface to implement to handle the domain logic of a Database.
+type Service interface {
+	Create(ctx context.Context, environmentID string, request UpsertRepositoryRequest) (*Database, error)
+	Get(ctx context.Context, databaseID string) (*Database, error)
+	Update(ctx context.Context, databaseID string, request UpsertRepositoryRequest) (*Database, error)
+	Delete(ctx context.Context, databaseID string) error
+}
@@ -0,0 +1,397 @@
+package database_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+func TestNewDatabase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        database.NewDatabaseParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_database_id",
+			Params: database.NewDatabaseParams{
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidDatabaseIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_environment_id",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidEnvironmentIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_name",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidNameParam,
+		},
+		{
+			TestName: "fail_with_invalid_type",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          "INVALID",
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidTypeParam,
+		},
+		{
+			TestName: "fail_with_invalid_version",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidVersionParam,
+		},
+		{
+			TestName: "fail_with_invalid_mode",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          "INVALID",
+				Accessibility: database.AccessibilityPrivate.String(),
+			},
+			ExpectedError: database.ErrInvalidModeParam,
+		},
+		{
+			TestName: "fail_with_invalid_accessibility",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: "INVALID",
+			},
+			ExpectedError: database.ErrInvalidAccessibilityParam,
+		},
+		{
+			TestName: "fail_with_invalid_state",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: database.AccessibilityPrivate.String(),
+				CPU:           database.DefaultCPU,
+				Memory:        database.DefaultMemory,
+				Storage:       database.DefaultStorage,
+				State:         pointer.ToString("INVALID"),
+			},
+			ExpectedError: database.ErrInvalidStateParam,
+		},
+		{
+			TestName: "success",
+			Params: database.NewDatabaseParams{
+				DatabaseID:    gofakeit.UUID(),
+				EnvironmentID: gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				Type:          database.TypeRedis.String(),
+				Version:       "6",
+				Mode:          database.ModeManaged.String(),
+				Accessibility: database.AccessibilityPublic.String(),
+				CPU:           database.DefaultCPU,
+				Memory:        database.DefaultMemory,
+				Storage:       database.DefaultStorage,
+				ExternalHost:  pointer.ToString("redis.qovery.io"),
+				Port:          pointer.ToInt32(6379),
+				Credentials: &database.Credentials{
+					Password: gofakeit.Password(true, true, true, false, false, 16),
+					Host:     "redis.qovery.io",
+					Port:     6379,
+				},
+				State: pointer.ToString(status.StateReady.String()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			db, err := database.NewDatabase(tc.Params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, db)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, db)
+			assert.True(t, db.IsValid())
+			assert.Equal(t, tc.Params.DatabaseID, db.ID.String())
+			assert.Equal(t, tc.Params.EnvironmentID, db.EnvironmentID.String())
+			assert.Equal(t, tc.Params.Name, db.Name)
+			assert.Equal(t, tc.Params.Type, db.Type.String())
+			assert.Equal(t, tc.Params.Version, db.Version)
+			assert.Equal(t, tc.Params.Mode, db.Mode.String())
+			assert.Equal(t, tc.Params.Accessibility, db.Accessibility.String())
+			assert.Equal(t, tc.Params.ExternalHost, db.ExternalHost)
+			assert.Equal(t, tc.Params.Port, db.Port)
+			assert.Equal(t, tc.Params.Credentials, db.Credentials)
+			if tc.Params.State != nil && *tc.Params.State == status.StateReady.String() {
+				assert.Equal(t, status.StateStopped, db.State)
+			}
+		})
+	}
+}
+
+func TestDatabase_SetInternalHost(t *testing.T) {
+	t.Parallel()
+
+	databaseID := uuid.New()
+	hostKey := "QOVERY_POSTGRESQL_Z" + strings.ToUpper(strings.Split(databaseID.String(), "-")[0]) + "_HOST_INTERNAL"
+	vars := variable.Variables{
+		{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Key: "QOVERY_POSTGRESQL_Z12345678_HOST_INTERNAL", Value: "other"},
+		{ID: uuid.New(), Scope: variable.ScopeBuiltIn, Key: hostKey, Value: "internal"},
+	}
+
+	db := database.Database{ID: databaseID, Type: database.TypePostgreSQL}
+	db.SetInternalHost(vars)
+	assert.Equal(t, pointer.ToString("internal"), db.InternalHost)
+
+	unknown := database.Database{ID: uuid.New(), Type: database.TypePostgreSQL}
+	unknown.SetInternalHost(vars)
+	assert.Nil(t, unknown.InternalHost)
+}
+
+func TestUpsertRepositoryRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       database.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_missing_name",
+			Request: database.UpsertRepositoryRequest{
+				Type:    database.TypePostgreSQL.String(),
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+			},
+			ExpectedError: database.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_type",
+			Request: database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    "INVALID",
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+			},
+			ExpectedError: database.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_accessibility",
+			Request: database.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: pointer.ToString("INVALID"),
+			},
+			ExpectedError: database.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_cpu_below_minimum",
+			Request: database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    database.TypePostgreSQL.String(),
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+				CPU:     pointer.ToInt32(database.MinCPU - 1),
+			},
+			ExpectedError: database.ErrInvalidCPUParam,
+		},
+		{
+			TestName: "fail_with_memory_below_minimum",
+			Request: database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    database.TypePostgreSQL.String(),
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+				Memory:  pointer.ToInt32(database.MinMemory - 1),
+			},
+			ExpectedError: database.ErrInvalidMemoryParam,
+		},
+		{
+			TestName: "fail_with_storage_below_minimum",
+			Request: database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    database.TypePostgreSQL.String(),
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+				Storage: pointer.ToInt32(database.MinStorage - 1),
+			},
+			ExpectedError: database.ErrInvalidStorageParam,
+		},
+		{
+			TestName: "success",
+			Request: database.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: pointer.ToString(database.AccessibilityPrivate.String()),
+				CPU:           pointer.ToInt32(database.DefaultCPU),
+				Memory:        pointer.ToInt32(database.DefaultMemory),
+				Storage:       pointer.ToInt32(database.DefaultStorage),
+			},
+		},
+		{
+			TestName: "fail_with_invalid_advanced_settings",
+			Request: database.UpsertRepositoryRequest{
+				Name:             gofakeit.Name(),
+				Type:             database.TypePostgreSQL.String(),
+				Version:          "13",
+				Mode:             database.ModeContainer.String(),
+				AdvancedSettings: advancedsettings.AdvancedSettings{"network.ingress.whitelist_source_range": make(chan int)},
+			},
+			ExpectedError: database.ErrInvalidAdvancedSettingsParam,
+		},
+		{
+			TestName: "success_with_advanced_settings",
+			Request: database.UpsertRepositoryRequest{
+				Name:             gofakeit.Name(),
+				Type:             database.TypePostgreSQL.String(),
+				Version:          "13",
+				Mode:             database.ModeContainer.String(),
+				AdvancedSettings: advancedsettings.AdvancedSettings{"network.ingress.whitelist_source_range": "10.0.0.0/24"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
+
+func TestUpsertRepositoryRequest_ValidateForCloudProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Type          database.Type
+		Mode          database.Mode
+		CloudProvider string
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_managed_redis_on_scaleway",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeManaged,
+			CloudProvider: "SCW",
+			ExpectedError: database.ErrUnsupportedModeParam,
+		},
+		{
+			TestName:      "fail_with_managed_mongodb_on_scaleway",
+			Type:          database.TypeMongoDB,
+			Mode:          database.ModeManaged,
+			CloudProvider: "SCW",
+			ExpectedError: database.ErrUnsupportedModeParam,
+		},
+		{
+			TestName:      "fail_with_managed_postgresql_on_unsupported_cloud_provider",
+			Type:          database.TypePostgreSQL,
+			Mode:          database.ModeManaged,
+			CloudProvider: "DO",
+			ExpectedError: database.ErrUnsupportedModeParam,
+		},
+		{
+			TestName:      "success_with_managed_redis_on_aws",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeManaged,
+			CloudProvider: "AWS",
+		},
+		{
+			TestName:      "success_with_managed_postgresql_on_scaleway",
+			Type:          database.TypePostgreSQL,
+			Mode:          database.ModeManaged,
+			CloudProvider: "SCW",
+		},
+		{
+			TestName:      "success_with_container_redis_on_any_cloud_provider",
+			Type:          database.TypeRedis,
+			Mode:          database.ModeContainer,
+			CloudProvider: "DO",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			request := database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    tc.Type.String(),
+				Version: "13",
+				Mode:    tc.Mode.String(),
+			}
+
+			err := request.ValidateForCloudProvider(tc.CloudProvider)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
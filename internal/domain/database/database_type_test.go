@@ -0,0 +1,26 @@
+package database_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// TestNewTypeFromString validate that the types qovery.DatabaseTypeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the database.Type stays up to date.
+func TestNewTypeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, database.AllowedTypeValues, len(qovery.AllowedDatabaseTypeEnumEnumValues))
+	for _, qoveryType := range qovery.AllowedDatabaseTypeEnumEnumValues {
+		qoveryTypeStr := string(qoveryType)
+		t.Run(qoveryTypeStr, func(t *testing.T) {
+			databaseType, err := database.NewTypeFromString(qoveryTypeStr)
+			assert.NoError(t, err)
+			assert.Equal(t, databaseType.String(), qoveryTypeStr)
+		})
+	}
+}
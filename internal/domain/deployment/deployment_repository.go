@@ -11,6 +11,7 @@ import (
 // Repository represents the interface to implement to handle the deployments of qovery services.
 type Repository interface {
 	GetStatus(ctx context.Context, resourceID string) (*status.Status, error)
+	GetEnvironmentID(ctx context.Context, resourceID string) (string, error)
 	Deploy(ctx context.Context, resourceID string, version string) (*status.Status, error)
 	Redeploy(ctx context.Context, resourceID string) (*status.Status, error)
 	Stop(ctx context.Context, resourceID string) (*status.Status, error)
@@ -40,6 +40,7 @@ type Environment struct {
 	ID                          uuid.UUID
 	ProjectID                   uuid.UUID
 	ClusterID                   uuid.UUID
+	CloudProvider               string
 	Name                        string
 	Mode                        Mode
 	EnvironmentVariables        variable.Variables
@@ -62,6 +63,7 @@ type NewEnvironmentParams struct {
 	EnvironmentID        string
 	ProjectID            string
 	ClusterID            string
+	CloudProvider        string
 	Name                 string
 	Mode                 string
 	EnvironmentVariables variable.Variables
@@ -95,11 +97,12 @@ func NewEnvironment(params NewEnvironmentParams) (*Environment, error) {
 	}
 
 	v := &Environment{
-		ID:        environmentUUID,
-		ProjectID: projectUUID,
-		ClusterID: clusterUUID,
-		Name:      params.Name,
-		Mode:      *mode,
+		ID:            environmentUUID,
+		ProjectID:     projectUUID,
+		ClusterID:     clusterUUID,
+		CloudProvider: params.CloudProvider,
+		Name:          params.Name,
+		Mode:          *mode,
 	}
 
 	if err := v.SetEnvironmentVariables(params.EnvironmentVariables); err != nil {
@@ -1,19 +1,31 @@
 package git_repository
 
 import (
+	"regexp"
+
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 )
 
 var (
 	// ErrInvalidURLParam is returned if the URL param is invalid.
 	ErrInvalidURLParam = errors.New("invalid URL param")
+	// ErrInvalidGitTokenIDParam is returned if the git token id param is invalid.
+	ErrInvalidGitTokenIDParam = errors.New("invalid git token id param")
+	// ErrInvalidCommitIDParam is returned if the commit id param is not a full git commit sha.
+	ErrInvalidCommitIDParam = errors.New("invalid commit id param: must be a full 40 characters git commit sha")
 )
 
+// commitIDRegexp matches a full git commit sha, as returned by the api for the deployed commit.
+var commitIDRegexp = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
 type GitRepository struct {
 	Url      string
 	Branch   *string
 	CommitID *string
 	RootPath *string
+	// GitTokenID is the id of the git token used to access the repository instead of the git provider app of the organization.
+	GitTokenID *string
 }
 
 func (i GitRepository) Validate() error {
@@ -21,22 +33,45 @@ func (i GitRepository) Validate() error {
 		return ErrInvalidURLParam
 	}
 
+	if i.CommitID != nil {
+		if err := ValidateCommitID(*i.CommitID); err != nil {
+			return err
+		}
+	}
+
+	if i.GitTokenID != nil {
+		if _, err := uuid.Parse(*i.GitTokenID); err != nil {
+			return ErrInvalidGitTokenIDParam
+		}
+	}
+
+	return nil
+}
+
+// ValidateCommitID returns an error if the given commit id is not a full git commit sha.
+func ValidateCommitID(commitID string) error {
+	if !commitIDRegexp.MatchString(commitID) {
+		return ErrInvalidCommitIDParam
+	}
+
 	return nil
 }
 
 type NewGitRepositoryParams struct {
-	Url      string
-	Branch   *string
-	CommitID *string
-	RootPath *string
+	Url        string
+	Branch     *string
+	CommitID   *string
+	RootPath   *string
+	GitTokenID *string
 }
 
 func NewGitRepository(params NewGitRepositoryParams) (*GitRepository, error) {
 	gitRepository := &GitRepository{
-		Url:      params.Url,
-		Branch:   params.Branch,
-		CommitID: params.CommitID,
-		RootPath: params.RootPath,
+		Url:        params.Url,
+		Branch:     params.Branch,
+		CommitID:   params.CommitID,
+		RootPath:   params.RootPath,
+		GitTokenID: params.GitTokenID,
 	}
 
 	if err := gitRepository.Validate(); err != nil {
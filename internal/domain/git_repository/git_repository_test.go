@@ -17,6 +17,7 @@ func TestGitRepositoryValidate(t *testing.T) {
 		branch        *string
 		commitID      *string
 		rootPath      *string
+		gitTokenID    *string
 		expectedError error
 	}{
 		{description: "case 1: url is blank", url: "", branch: &test_helper.DefaultBranchName, commitID: &test_helper.DefaultCommitID, rootPath: &test_helper.DefaultRootPath, expectedError: git_repository.ErrInvalidURLParam},
@@ -25,6 +26,9 @@ func TestGitRepositoryValidate(t *testing.T) {
 		{description: "case 4: rootPath is nil", url: "https://github.com/Qovery/terraform-provider-qovery.git", branch: &test_helper.DefaultBranchName, commitID: &test_helper.DefaultCommitID, rootPath: nil, expectedError: nil},
 		{description: "case 5: all fields are set", url: "https://github.com/Qovery/terraform-provider-qovery.git", branch: &test_helper.DefaultBranchName, commitID: &test_helper.DefaultCommitID, rootPath: &test_helper.DefaultRootPath, expectedError: nil},
 		{description: "case 6: url only is set", url: "https://github.com/Qovery/terraform-provider-qovery.git", branch: nil, commitID: nil, rootPath: nil, expectedError: nil},
+		{description: "case 7: git token id is not a uuid", url: "https://github.com/Qovery/terraform-provider-qovery.git", gitTokenID: &test_helper.DefaultBranchName, expectedError: git_repository.ErrInvalidGitTokenIDParam},
+		{description: "case 8: git token id is set", url: "https://github.com/Qovery/terraform-provider-qovery.git", gitTokenID: &test_helper.DefaultGitTokenID, expectedError: nil},
+		{description: "case 9: commit ID is a short sha", url: "https://github.com/Qovery/terraform-provider-qovery.git", commitID: &test_helper.DefaultShortCommitID, expectedError: git_repository.ErrInvalidCommitIDParam},
 	}
 
 	t.Parallel()
@@ -32,10 +36,11 @@ func TestGitRepositoryValidate(t *testing.T) {
 		t.Run(tc.description, func(t *testing.T) {
 			// execute:
 			i := git_repository.GitRepository{
-				Url:      tc.url,
-				Branch:   tc.branch,
-				CommitID: tc.commitID,
-				RootPath: tc.rootPath,
+				Url:        tc.url,
+				Branch:     tc.branch,
+				CommitID:   tc.commitID,
+				RootPath:   tc.rootPath,
+				GitTokenID: tc.gitTokenID,
 			}
 
 			// verify:
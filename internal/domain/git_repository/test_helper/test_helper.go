@@ -5,10 +5,12 @@ import (
 )
 
 var (
-	DefaultUrl        = "https://github.com/Qovery/terraform-provider-qovery.git"
-	DefaultBranchName = "main"
-	DefaultCommitID   = "42e2e5af9d49de268cd1fda3587788da4ace418a"
-	DefaultRootPath   = "/"
+	DefaultUrl           = "https://github.com/Qovery/terraform-provider-qovery.git"
+	DefaultBranchName    = "main"
+	DefaultCommitID      = "42e2e5af9d49de268cd1fda3587788da4ace418a"
+	DefaultShortCommitID = "42e2e5a"
+	DefaultRootPath      = "/"
+	DefaultGitTokenID    = "2f4e5c8a-7d3b-4c1e-9a6f-0b8d2e1c3a5f"
 
 	/// Exposed to tests needing to get such object without having to know internal sauce magic
 	DefaultValidNewGitRepositoryParams = git_repository.NewGitRepositoryParams{
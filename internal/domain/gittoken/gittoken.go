@@ -0,0 +1,129 @@
+package gittoken
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNilGitToken is returned if a GitToken is nil.
+	ErrNilGitToken = errors.New("git token cannot be nil")
+	// ErrInvalidGitToken is the error return if a GitToken is invalid.
+	ErrInvalidGitToken = errors.New("invalid git token")
+	// ErrInvalidOrganizationIDParam is returned if the organization id param is invalid.
+	ErrInvalidOrganizationIDParam = errors.New("invalid organization id param")
+	// ErrInvalidGitTokenIDParam is returned if the git token id param is invalid.
+	ErrInvalidGitTokenIDParam = errors.New("invalid git token id param")
+	// ErrInvalidTypeParam is returned if the git token type param is invalid.
+	ErrInvalidTypeParam = errors.New("invalid type param")
+	// ErrInvalidNameParam is returned if the git token name param is invalid.
+	ErrInvalidNameParam = errors.New("invalid git token name param")
+	// ErrInvalidWorkspaceParam is returned if the workspace param is missing for a Bitbucket token.
+	ErrInvalidWorkspaceParam = errors.New("invalid workspace param: required for bitbucket tokens")
+	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
+	ErrInvalidUpsertRequest = errors.New("invalid git token upsert request")
+)
+
+// GitToken represents a token used by Qovery to access the git repositories of an organization.
+// The value of the token is never returned by the api.
+type GitToken struct {
+	ID             uuid.UUID `validate:"required"`
+	OrganizationID uuid.UUID `validate:"required"`
+	Name           string    `validate:"required"`
+	Type           Type      `validate:"required"`
+
+	Description *string
+	Workspace   *string
+}
+
+// Validate returns an error to tell whether the GitToken domain model is valid or not.
+func (t GitToken) Validate() error {
+	return validator.New().Struct(t)
+}
+
+// IsValid returns a bool to tell whether the GitToken domain model is valid or not.
+func (t GitToken) IsValid() bool {
+	return t.Validate() == nil
+}
+
+// NewGitTokenParams represents the arguments needed to create a GitToken.
+type NewGitTokenParams struct {
+	GitTokenID     string
+	OrganizationID string
+	Name           string
+	Type           string
+	Description    *string
+	Workspace      *string
+}
+
+// NewGitToken returns a new instance of a GitToken domain model.
+func NewGitToken(params NewGitTokenParams) (*GitToken, error) {
+	gitTokenUUID, err := uuid.Parse(params.GitTokenID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidGitTokenIDParam.Error())
+	}
+
+	organizationUUID, err := uuid.Parse(params.OrganizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidOrganizationIDParam.Error())
+	}
+
+	tokenType, err := NewTypeFromString(params.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidTypeParam.Error())
+	}
+
+	if params.Name == "" {
+		return nil, ErrInvalidNameParam
+	}
+
+	t := &GitToken{
+		ID:             gitTokenUUID,
+		OrganizationID: organizationUUID,
+		Name:           params.Name,
+		Type:           *tokenType,
+		Description:    params.Description,
+		Workspace:      params.Workspace,
+	}
+
+	if err := t.Validate(); err != nil {
+		return nil, errors.Wrap(err, ErrInvalidGitToken.Error())
+	}
+
+	return t, nil
+}
+
+// UpsertRequest represents the parameters needed to create & update a GitToken.
+type UpsertRequest struct {
+	Name  string `validate:"required"`
+	Type  string `validate:"required"`
+	Token string `validate:"required"`
+
+	Description *string
+	// Workspace is the Bitbucket workspace the token belongs to, it is required for Bitbucket tokens only.
+	Workspace *string
+}
+
+// Validate returns an error to tell whether the UpsertRequest is valid or not.
+func (r UpsertRequest) Validate() error {
+	if err := validator.New().Struct(r); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
+	tokenType, err := NewTypeFromString(r.Type)
+	if err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidTypeParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if *tokenType == TypeBitbucket && (r.Workspace == nil || *r.Workspace == "") {
+		return errors.Wrap(ErrInvalidWorkspaceParam, ErrInvalidUpsertRequest.Error())
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the UpsertRequest is valid or not.
+func (r UpsertRequest) IsValid() bool {
+	return r.Validate() == nil
+}
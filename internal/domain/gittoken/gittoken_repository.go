@@ -0,0 +1,15 @@
+package gittoken
+
+//go:generate mockery --testonly --with-expecter --name=Repository --structname=GitTokenRepository --filename=git_token_repository_mock.go --output=../../infrastructure/repositories/mocks_test/ --outpkg=mocks_test
+
+import (
+	"context"
+)
+
+// Repository represents the interface to implement to handle the persistence of a GitToken.
+type Repository interface {
+	Create(ctx context.Context, organizationID string, request UpsertRequest) (*GitToken, error)
+	Get(ctx context.Context, organizationID string, gitTokenID string) (*GitToken, error)
+	Update(ctx context.Context, organizationID string, gitTokenID string, request UpsertRequest) (*GitToken, error)
+	Delete(ctx context.Context, organizationID string, gitTokenID string) error
+}
@@ -0,0 +1,22 @@
+package gittoken
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrFailedToCreateGitToken = errors.New("failed to create git token")
+	ErrFailedToGetGitToken    = errors.New("failed to get git token")
+	ErrFailedToUpdateGitToken = errors.New("failed to update git token")
+	ErrFailedToDeleteGitToken = errors.New("failed to delete git token")
+)
+
+// Service represents the interface to implement to handle the domain logic of a GitToken.
+type Service interface {
+	Create(ctx context.Context, organizationID string, request UpsertRequest) (*GitToken, error)
+	Get(ctx context.Context, organizationID string, gitTokenID string) (*GitToken, error)
+	Update(ctx context.Context, organizationID string, gitTokenID string, request UpsertRequest) (*GitToken, error)
+	Delete(ctx context.Context, organizationID string, gitTokenID string) error
+}
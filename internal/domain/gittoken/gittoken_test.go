@@ -0,0 +1,170 @@
+package gittoken_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+func TestNewGitToken(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Params        gittoken.NewGitTokenParams
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_git_token_id",
+			Params: gittoken.NewGitTokenParams{
+				GitTokenID:     gofakeit.Word(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				Type:           gittoken.TypeGitHub.String(),
+			},
+			ExpectedError: gittoken.ErrInvalidGitTokenIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_organization_id",
+			Params: gittoken.NewGitTokenParams{
+				GitTokenID:     gofakeit.UUID(),
+				OrganizationID: gofakeit.Word(),
+				Name:           gofakeit.Name(),
+				Type:           gittoken.TypeGitHub.String(),
+			},
+			ExpectedError: gittoken.ErrInvalidOrganizationIDParam,
+		},
+		{
+			TestName: "fail_with_invalid_type",
+			Params: gittoken.NewGitTokenParams{
+				GitTokenID:     gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				Type:           gofakeit.Word(),
+			},
+			ExpectedError: gittoken.ErrInvalidTypeParam,
+		},
+		{
+			TestName: "fail_with_invalid_name",
+			Params: gittoken.NewGitTokenParams{
+				GitTokenID:     gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Type:           gittoken.TypeGitLab.String(),
+			},
+			ExpectedError: gittoken.ErrInvalidNameParam,
+		},
+		{
+			TestName: "success",
+			Params: gittoken.NewGitTokenParams{
+				GitTokenID:     gofakeit.UUID(),
+				OrganizationID: gofakeit.UUID(),
+				Name:           gofakeit.Name(),
+				Type:           gittoken.TypeBitbucket.String(),
+				Description:    pointer.ToString(gofakeit.Sentence(5)),
+				Workspace:      pointer.ToString(gofakeit.Word()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			token, err := gittoken.NewGitToken(tc.Params)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, token)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, token.IsValid())
+			assert.Equal(t, tc.Params.GitTokenID, token.ID.String())
+			assert.Equal(t, tc.Params.OrganizationID, token.OrganizationID.String())
+			assert.Equal(t, tc.Params.Name, token.Name)
+			assert.Equal(t, tc.Params.Type, token.Type.String())
+			assert.Equal(t, tc.Params.Description, token.Description)
+			assert.Equal(t, tc.Params.Workspace, token.Workspace)
+		})
+	}
+}
+
+func TestUpsertRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       gittoken.UpsertRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_name",
+			Request: gittoken.UpsertRequest{
+				Type:  gittoken.TypeGitHub.String(),
+				Token: gofakeit.Password(true, true, true, false, false, 32),
+			},
+			ExpectedError: gittoken.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_token",
+			Request: gittoken.UpsertRequest{
+				Name: gofakeit.Name(),
+				Type: gittoken.TypeGitHub.String(),
+			},
+			ExpectedError: gittoken.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_type",
+			Request: gittoken.UpsertRequest{
+				Name:  gofakeit.Name(),
+				Type:  gofakeit.Word(),
+				Token: gofakeit.Password(true, true, true, false, false, 32),
+			},
+			ExpectedError: gittoken.ErrInvalidTypeParam,
+		},
+		{
+			TestName: "fail_with_bitbucket_without_workspace",
+			Request: gittoken.UpsertRequest{
+				Name:  gofakeit.Name(),
+				Type:  gittoken.TypeBitbucket.String(),
+				Token: gofakeit.Password(true, true, true, false, false, 32),
+			},
+			ExpectedError: gittoken.ErrInvalidWorkspaceParam,
+		},
+		{
+			TestName: "success_with_github",
+			Request: gittoken.UpsertRequest{
+				Name:  gofakeit.Name(),
+				Type:  gittoken.TypeGitHub.String(),
+				Token: gofakeit.Password(true, true, true, false, false, 32),
+			},
+		},
+		{
+			TestName: "success_with_bitbucket",
+			Request: gittoken.UpsertRequest{
+				Name:      gofakeit.Name(),
+				Type:      gittoken.TypeBitbucket.String(),
+				Token:     gofakeit.Password(true, true, true, false, false, 32),
+				Workspace: pointer.ToString(gofakeit.Word()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package healthcheck
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+)
+
+const (
+	SchemeHTTP  = "HTTP"
+	SchemeHTTPS = "HTTPS"
+
+	DefaultScheme              = SchemeHTTP
+	DefaultInitialDelaySeconds = 30
+	DefaultPeriodSeconds       = 10
+	DefaultTimeoutSeconds      = 5
+	DefaultSuccessThreshold    = 1
+	DefaultFailureThreshold    = 3
+)
+
+// AllowedSchemeValues contains all the valid values of the scheme of an HTTP probe.
+var AllowedSchemeValues = []string{
+	SchemeHTTP,
+	SchemeHTTPS,
+}
+
+var (
+	// ErrInvalidHealthchecks is returned if a Healthchecks is invalid.
+	ErrInvalidHealthchecks = errors.New("invalid healthchecks")
+	// ErrInvalidLivenessProbe is returned if the liveness probe is invalid.
+	ErrInvalidLivenessProbe = errors.New("invalid liveness probe")
+	// ErrInvalidReadinessProbe is returned if the readiness probe is invalid.
+	ErrInvalidReadinessProbe = errors.New("invalid readiness probe")
+	// ErrInvalidProbeTypeParam is returned if a probe does not have exactly one type set.
+	ErrInvalidProbeTypeParam = errors.New("invalid probe type param: exactly one of http, tcp, grpc or exec must be set")
+	// ErrInvalidPortParam is returned if the port of a probe is invalid.
+	ErrInvalidPortParam = errors.New("invalid port param")
+	// ErrInvalidPathParam is returned if the path of an HTTP probe is invalid.
+	ErrInvalidPathParam = errors.New("invalid path param")
+	// ErrInvalidSchemeParam is returned if the scheme of an HTTP probe is invalid.
+	ErrInvalidSchemeParam = errors.New("invalid scheme param")
+	// ErrInvalidCommandParam is returned if the command of an exec probe is invalid.
+	ErrInvalidCommandParam = errors.New("invalid command param")
+	// ErrInvalidInitialDelaySecondsParam is returned if the initial delay seconds param is invalid.
+	ErrInvalidInitialDelaySecondsParam = errors.New("invalid initial delay seconds param")
+	// ErrInvalidPeriodSecondsParam is returned if the period seconds param is invalid.
+	ErrInvalidPeriodSecondsParam = errors.New("invalid period seconds param")
+	// ErrInvalidTimeoutSecondsParam is returned if the timeout seconds param is invalid.
+	ErrInvalidTimeoutSecondsParam = errors.New("invalid timeout seconds param")
+	// ErrInvalidSuccessThresholdParam is returned if the success threshold param is invalid.
+	ErrInvalidSuccessThresholdParam = errors.New("invalid success threshold param")
+	// ErrInvalidFailureThresholdParam is returned if the failure threshold param is invalid.
+	ErrInvalidFailureThresholdParam = errors.New("invalid failure threshold param")
+	// ErrInvalidLivenessSuccessThresholdParam is returned if the success threshold of a liveness probe is not 1.
+	ErrInvalidLivenessSuccessThresholdParam = errors.New("success threshold of a liveness probe must be 1")
+	// ErrProbePortNotDeclared is returned if a probe targets a port that is not declared by the service.
+	ErrProbePortNotDeclared = errors.New("probe port is not declared in the service ports")
+	// ErrProbePortProtocolMismatch is returned if a probe targets a port whose protocol cannot be checked by the probe type.
+	ErrProbePortProtocolMismatch = errors.New("probe type is not compatible with the protocol of the probe port")
+)
+
+// Healthchecks represents the probes used by Kubernetes to know whether a service is ready to serve traffic and still alive.
+// A nil probe is not configured.
+type Healthchecks struct {
+	LivenessProbe  *Probe
+	ReadinessProbe *Probe
+}
+
+// Validate returns an error to tell whether the Healthchecks domain model is valid or not.
+func (h Healthchecks) Validate() error {
+	if h.LivenessProbe != nil {
+		if err := h.LivenessProbe.Validate(); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidLivenessProbe.Error()), ErrInvalidHealthchecks.Error())
+		}
+
+		// NOTE: Kubernetes rejects liveness probes with a success threshold other than 1.
+		if h.LivenessProbe.SuccessThreshold != 1 {
+			return errors.Wrap(errors.Wrap(ErrInvalidLivenessSuccessThresholdParam, ErrInvalidLivenessProbe.Error()), ErrInvalidHealthchecks.Error())
+		}
+	}
+
+	if h.ReadinessProbe != nil {
+		if err := h.ReadinessProbe.Validate(); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidReadinessProbe.Error()), ErrInvalidHealthchecks.Error())
+		}
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Healthchecks domain model is valid or not.
+func (h Healthchecks) IsValid() bool {
+	return h.Validate() == nil
+}
+
+// ValidatePorts returns an error to tell whether the probes of the Healthchecks target ports declared by the service.
+// HTTP and gRPC probes must target a port of the same protocol, TCP probes any port that is not UDP.
+func (h Healthchecks) ValidatePorts(ports []port.UpsertRequest) error {
+	if h.LivenessProbe != nil {
+		if err := h.LivenessProbe.validatePort(ports); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidLivenessProbe.Error()), ErrInvalidHealthchecks.Error())
+		}
+	}
+
+	if h.ReadinessProbe != nil {
+		if err := h.ReadinessProbe.validatePort(ports); err != nil {
+			return errors.Wrap(errors.Wrap(err, ErrInvalidReadinessProbe.Error()), ErrInvalidHealthchecks.Error())
+		}
+	}
+
+	return nil
+}
+
+// Probe represents a check run periodically by Kubernetes on a service.
+type Probe struct {
+	Type ProbeType
+
+	InitialDelaySeconds int32
+	PeriodSeconds       int32
+	TimeoutSeconds      int32
+	SuccessThreshold    int32
+	FailureThreshold    int32
+}
+
+// Validate returns an error to tell whether the Probe domain model is valid or not.
+func (p Probe) Validate() error {
+	if err := p.Type.Validate(); err != nil {
+		return err
+	}
+
+	if p.InitialDelaySeconds < 0 {
+		return ErrInvalidInitialDelaySecondsParam
+	}
+
+	if p.PeriodSeconds < 1 {
+		return ErrInvalidPeriodSecondsParam
+	}
+
+	if p.TimeoutSeconds < 1 {
+		return ErrInvalidTimeoutSecondsParam
+	}
+
+	if p.SuccessThreshold < 1 {
+		return ErrInvalidSuccessThresholdParam
+	}
+
+	if p.FailureThreshold < 1 {
+		return ErrInvalidFailureThresholdParam
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the Probe domain model is valid or not.
+func (p Probe) IsValid() bool {
+	return p.Validate() == nil
+}
+
+// validatePort returns an error if the Probe targets a port that is not declared or whose protocol is not compatible with the probe type.
+func (p Probe) validatePort(ports []port.UpsertRequest) error {
+	probePort := p.Type.Port()
+	if probePort == nil {
+		return nil
+	}
+
+	for _, declared := range ports {
+		if declared.InternalPort != *probePort {
+			continue
+		}
+
+		protocol := port.DefaultProtocol
+		if declared.Protocol != nil {
+			protocol = port.Protocol(*declared.Protocol)
+		}
+
+		if !p.Type.isCompatibleWith(protocol) {
+			return errors.Wrap(ErrProbePortProtocolMismatch, fmt.Sprintf("port %d is %s", *probePort, protocol))
+		}
+
+		return nil
+	}
+
+	return errors.Wrap(ErrProbePortNotDeclared, fmt.Sprintf("port %d", *probePort))
+}
+
+// ProbeType represents how a Probe checks the service, exactly one of its fields must be set.
+type ProbeType struct {
+	HTTP *HTTPProbe
+	TCP  *TCPProbe
+	GRPC *GRPCProbe
+	Exec *ExecProbe
+}
+
+// HTTPProbe checks the service with an HTTP GET request, any status code between 200 and 399 is a success.
+type HTTPProbe struct {
+	Path   string
+	Port   int32
+	Scheme string
+}
+
+// TCPProbe checks the service by opening a TCP connection.
+type TCPProbe struct {
+	Port int32
+	Host *string
+}
+
+// GRPCProbe checks the service using the gRPC health checking protocol.
+type GRPCProbe struct {
+	Port    int32
+	Service *string
+}
+
+// ExecProbe checks the service by running a command inside its container, an exit code of 0 is a success.
+type ExecProbe struct {
+	Command []string
+}
+
+// Validate returns an error to tell whether the ProbeType is valid or not.
+func (t ProbeType) Validate() error {
+	nbTypes := 0
+	for _, isSet := range []bool{t.HTTP != nil, t.TCP != nil, t.GRPC != nil, t.Exec != nil} {
+		if isSet {
+			nbTypes++
+		}
+	}
+	if nbTypes != 1 {
+		return ErrInvalidProbeTypeParam
+	}
+
+	switch {
+	case t.HTTP != nil:
+		if t.HTTP.Path == "" {
+			return ErrInvalidPathParam
+		}
+		if !slices.Contains(AllowedSchemeValues, t.HTTP.Scheme) {
+			return errors.Wrap(ErrInvalidSchemeParam, fmt.Sprintf("valid values are %v", AllowedSchemeValues))
+		}
+	case t.Exec != nil:
+		if len(t.Exec.Command) == 0 {
+			return ErrInvalidCommandParam
+		}
+	}
+
+	if probePort := t.Port(); probePort != nil && (*probePort < port.MinPort || *probePort > port.MaxPort) {
+		return ErrInvalidPortParam
+	}
+
+	return nil
+}
+
+// IsValid returns a bool to tell whether the ProbeType is valid or not.
+func (t ProbeType) IsValid() bool {
+	return t.Validate() == nil
+}
+
+// Port returns the port checked by the ProbeType, nil for exec probes.
+func (t ProbeType) Port() *int32 {
+	switch {
+	case t.HTTP != nil:
+		return &t.HTTP.Port
+	case t.TCP != nil:
+		return &t.TCP.Port
+	case t.GRPC != nil:
+		return &t.GRPC.Port
+	default:
+		return nil
+	}
+}
+
+// isCompatibleWith returns a bool to tell whether the ProbeType can check a port of the given protocol.
+func (t ProbeType) isCompatibleWith(protocol port.Protocol) bool {
+	switch {
+	case t.HTTP != nil:
+		return protocol == port.ProtocolHTTP
+	case t.GRPC != nil:
+		return protocol == port.ProtocolGRPC
+	case t.TCP != nil:
+		return protocol != port.ProtocolUDP
+	default:
+		return true
+	}
+}
@@ -0,0 +1,184 @@
+package healthcheck_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+)
+
+func newTestProbe(probeType healthcheck.ProbeType) *healthcheck.Probe {
+	return &healthcheck.Probe{
+		Type:                probeType,
+		InitialDelaySeconds: healthcheck.DefaultInitialDelaySeconds,
+		PeriodSeconds:       healthcheck.DefaultPeriodSeconds,
+		TimeoutSeconds:      healthcheck.DefaultTimeoutSeconds,
+		SuccessThreshold:    healthcheck.DefaultSuccessThreshold,
+		FailureThreshold:    healthcheck.DefaultFailureThreshold,
+	}
+}
+
+func TestHealthchecks_Validate(t *testing.T) {
+	t.Parallel()
+
+	httpProbe := healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/health", Port: 8080, Scheme: healthcheck.DefaultScheme}}
+
+	testCases := []struct {
+		TestName      string
+		Healthchecks  healthcheck.Healthchecks
+		ExpectedError error
+	}{
+		{
+			TestName:     "success_without_probes",
+			Healthchecks: healthcheck.Healthchecks{},
+		},
+		{
+			TestName: "success_with_all_probe_types",
+			Healthchecks: healthcheck.Healthchecks{
+				LivenessProbe:  newTestProbe(healthcheck.ProbeType{Exec: &healthcheck.ExecProbe{Command: []string{"cat", "/tmp/healthy"}}}),
+				ReadinessProbe: newTestProbe(httpProbe),
+			},
+		},
+		{
+			TestName: "fail_without_probe_type",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newTestProbe(healthcheck.ProbeType{}),
+			},
+			ExpectedError: healthcheck.ErrInvalidProbeTypeParam,
+		},
+		{
+			TestName: "fail_with_several_probe_types",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newTestProbe(healthcheck.ProbeType{
+					TCP:  &healthcheck.TCPProbe{Port: 8080},
+					GRPC: &healthcheck.GRPCProbe{Port: 8080},
+				}),
+			},
+			ExpectedError: healthcheck.ErrInvalidProbeTypeParam,
+		},
+		{
+			TestName: "fail_with_invalid_http_scheme",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newTestProbe(healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/", Port: 8080, Scheme: "FTP"}}),
+			},
+			ExpectedError: healthcheck.ErrInvalidSchemeParam,
+		},
+		{
+			TestName: "fail_with_empty_exec_command",
+			Healthchecks: healthcheck.Healthchecks{
+				LivenessProbe: newTestProbe(healthcheck.ProbeType{Exec: &healthcheck.ExecProbe{}}),
+			},
+			ExpectedError: healthcheck.ErrInvalidCommandParam,
+		},
+		{
+			TestName: "fail_with_invalid_port",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newTestProbe(healthcheck.ProbeType{TCP: &healthcheck.TCPProbe{Port: 0}}),
+			},
+			ExpectedError: healthcheck.ErrInvalidPortParam,
+		},
+		{
+			TestName: "fail_with_invalid_period",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: &healthcheck.Probe{Type: httpProbe, TimeoutSeconds: 1, SuccessThreshold: 1, FailureThreshold: 1},
+			},
+			ExpectedError: healthcheck.ErrInvalidPeriodSecondsParam,
+		},
+		{
+			TestName: "fail_with_liveness_success_threshold_greater_than_one",
+			Healthchecks: healthcheck.Healthchecks{
+				LivenessProbe: &healthcheck.Probe{Type: httpProbe, PeriodSeconds: 10, TimeoutSeconds: 1, SuccessThreshold: 2, FailureThreshold: 3},
+			},
+			ExpectedError: healthcheck.ErrInvalidLivenessSuccessThresholdParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			err := tc.Healthchecks.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Healthchecks.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Healthchecks.IsValid())
+		})
+	}
+}
+
+func TestHealthchecks_ValidatePorts(t *testing.T) {
+	t.Parallel()
+
+	ports := []port.UpsertRequest{
+		{InternalPort: 8080},
+		{InternalPort: 50051, Protocol: pointer.ToString(port.ProtocolGRPC.String())},
+		{InternalPort: 5432, Protocol: pointer.ToString(port.ProtocolTCP.String())},
+		{InternalPort: 5353, Protocol: pointer.ToString(port.ProtocolUDP.String())},
+	}
+
+	testCases := []struct {
+		TestName      string
+		ProbeType     healthcheck.ProbeType
+		ExpectedError error
+	}{
+		{
+			TestName:  "success_with_http_probe_on_http_port",
+			ProbeType: healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/", Port: 8080, Scheme: healthcheck.SchemeHTTP}},
+		},
+		{
+			TestName:  "success_with_grpc_probe_on_grpc_port",
+			ProbeType: healthcheck.ProbeType{GRPC: &healthcheck.GRPCProbe{Port: 50051}},
+		},
+		{
+			TestName:  "success_with_tcp_probe_on_http_port",
+			ProbeType: healthcheck.ProbeType{TCP: &healthcheck.TCPProbe{Port: 8080}},
+		},
+		{
+			TestName:  "success_with_exec_probe",
+			ProbeType: healthcheck.ProbeType{Exec: &healthcheck.ExecProbe{Command: []string{"true"}}},
+		},
+		{
+			TestName:      "fail_with_undeclared_port",
+			ProbeType:     healthcheck.ProbeType{TCP: &healthcheck.TCPProbe{Port: 9090}},
+			ExpectedError: healthcheck.ErrProbePortNotDeclared,
+		},
+		{
+			TestName:      "fail_with_http_probe_on_tcp_port",
+			ProbeType:     healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/", Port: 5432, Scheme: healthcheck.SchemeHTTP}},
+			ExpectedError: healthcheck.ErrProbePortProtocolMismatch,
+		},
+		{
+			TestName:      "fail_with_grpc_probe_on_http_port",
+			ProbeType:     healthcheck.ProbeType{GRPC: &healthcheck.GRPCProbe{Port: 8080}},
+			ExpectedError: healthcheck.ErrProbePortProtocolMismatch,
+		},
+		{
+			TestName:      "fail_with_tcp_probe_on_udp_port",
+			ProbeType:     healthcheck.ProbeType{TCP: &healthcheck.TCPProbe{Port: 5353}},
+			ExpectedError: healthcheck.ErrProbePortProtocolMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			healthchecks := healthcheck.Healthchecks{
+				ReadinessProbe: newTestProbe(tc.ProbeType),
+			}
+
+			err := healthchecks.ValidatePorts(ports)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
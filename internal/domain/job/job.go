@@ -5,6 +5,8 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
@@ -46,6 +48,10 @@ var (
 	ErrInvalidJobScheduleParam = errors.New("invalid job schedule param")
 	// ErrInvalidPortParam is returned if the port param is invalid.
 	ErrInvalidJobPortParam = errors.New("invalid port param")
+	// ErrInvalidJobHealthchecksParam is returned if the healthchecks param is invalid.
+	ErrInvalidJobHealthchecksParam = errors.New("invalid healthchecks param")
+	// ErrInvalidJobAdvancedSettingsParam is returned if the advanced settings param is invalid.
+	ErrInvalidJobAdvancedSettingsParam = errors.New("invalid advanced settings param")
 	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
 	ErrInvalidJobUpsertRequest = errors.New("invalid job upsert request")
 	// ErrInvalidJobEnvironmentVariablesParam is returned if the environment variables param is invalid.
@@ -70,6 +76,8 @@ type Job struct {
 	Schedule JobSchedule `validate:"required"`
 
 	Port                        *port.Port
+	Healthchecks                healthcheck.Healthchecks
+	AdvancedSettings            advancedsettings.AdvancedSettings
 	EnvironmentVariables        variable.Variables
 	BuiltInEnvironmentVariables variable.Variables
 	Secrets                     secret.Secrets
@@ -99,6 +107,14 @@ func (j Job) Validate() error {
 		}
 	}
 
+	if err := j.Healthchecks.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidJobHealthchecksParam.Error())
+	}
+
+	if err := j.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(err, ErrInvalidJobAdvancedSettingsParam.Error())
+	}
+
 	if err := j.Source.Validate(); err != nil {
 		return errors.Wrap(err, ErrInvalidJobSourceParam.Error())
 	}
@@ -149,6 +165,8 @@ type NewJobParams struct {
 	Secrets              secret.NewSecretsParams
 	DeploymentStageID    string
 	Port                 *port.NewPortParams
+	Healthchecks         healthcheck.Healthchecks
+	AdvancedSettings     advancedsettings.AdvancedSettings
 }
 
 // NewJob returns a new instance of a Job domain model.
@@ -203,6 +221,8 @@ func NewJob(params NewJobParams) (*Job, error) {
 		Schedule:           *jobSchedule,
 		Source:             *jobSource,
 		Port:               prt,
+		Healthchecks:       params.Healthchecks,
+		AdvancedSettings:   params.AdvancedSettings,
 		DeploymentStageID:  params.DeploymentStageID,
 	}
 
@@ -8,6 +8,9 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
@@ -34,6 +37,8 @@ type UpsertRepositoryRequest struct {
 	Source               JobSource
 	Schedule             JobSchedule
 	Port                 *int32
+	Healthchecks         healthcheck.Healthchecks
+	AdvancedSettings     advancedsettings.AdvancedSettings
 	EnvironmentVariables []variable.UpsertRequest
 	Secrets              []secret.UpsertRequest
 	DeploymentStageID    string
@@ -54,9 +59,32 @@ func (r UpsertRepositoryRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidJobUpsertRequest.Error())
 	}
 
+	if err := r.validateHealthchecks(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidJobHealthchecksParam.Error()), ErrInvalidJobUpsertRequest.Error())
+	}
+
+	if err := r.AdvancedSettings.Validate(); err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidJobAdvancedSettingsParam.Error()), ErrInvalidJobUpsertRequest.Error())
+	}
+
 	return nil
 }
 
+// validateHealthchecks returns an error if the healthchecks of the request are invalid or do not target the port of the job.
+// A job exposes at most one port, which is served over HTTP.
+func (r UpsertRepositoryRequest) validateHealthchecks() error {
+	if err := r.Healthchecks.Validate(); err != nil {
+		return err
+	}
+
+	var ports []port.UpsertRequest
+	if r.Port != nil {
+		ports = append(ports, port.UpsertRequest{InternalPort: *r.Port})
+	}
+
+	return r.Healthchecks.ValidatePorts(ports)
+}
+
 // IsValid returns a bool to tell whether the UpsertRepositoryRequest is valid or not.
 func (r UpsertRepositoryRequest) IsValid() bool {
 	return r.Validate() == nil
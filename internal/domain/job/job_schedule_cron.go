@@ -23,10 +23,7 @@ func (c JobScheduleCron) Validate() error {
 	}
 
 	if err := c.Command.Validate(); err != nil {
-		{
-			return errors.Wrap(err, ErrInvalidJobScheduleOnStartParam.Error())
-		}
-		return ErrInvalidJobScheduleCronCommandParam
+		return errors.Wrap(err, ErrInvalidJobScheduleCronCommandParam.Error())
 	}
 
 	return nil
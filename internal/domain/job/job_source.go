@@ -105,3 +105,21 @@ func NewJobSource(params NewJobSourceParams) (*JobSource, error) {
 
 	return newSource, nil
 }
+
+// GitTokenID returns the id of the git token used to access the git repository of the job source, if any.
+func (s JobSource) GitTokenID() *string {
+	if s.Docker == nil {
+		return nil
+	}
+
+	return s.Docker.GitRepository.GitTokenID
+}
+
+// GitCommitID returns the commit of the git repository of the job source to deploy, if any.
+func (s JobSource) GitCommitID() *string {
+	if s.Docker == nil {
+		return nil
+	}
+
+	return s.Docker.GitRepository.CommitID
+}
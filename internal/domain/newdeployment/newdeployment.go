@@ -3,6 +3,8 @@ package newdeployment
 import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
 )
 
 var (
@@ -51,6 +53,22 @@ func (c DeploymentDesiredState) String() string {
 	return "UNDEFINED"
 }
 
+// ExpectedStates returns the environment states meaning the desired state has been reached.
+func (c DeploymentDesiredState) ExpectedStates() []status.State {
+	switch c {
+	case RUNNING:
+		return []status.State{status.StateDeployed}
+	case STOPPED:
+		return []status.State{status.StateStopped}
+	case RESTARTED:
+		return []status.State{status.StateRestarted, status.StateDeployed}
+	case DELETED:
+		return []status.State{status.StateDeleted}
+	}
+
+	return nil
+}
+
 type Deployment struct {
 	ID            *uuid.UUID
 	EnvironmentID *uuid.UUID
@@ -31,6 +31,8 @@ var (
 	ErrInvalidProtocolParam = errors.New("invalid protocol param")
 	// ErrInvalidUpsertRequest is returned if the create request is invalid.
 	ErrInvalidUpsertRequest = errors.New("invalid port upsert request")
+	// ErrMissingExternalPortParam is returned if a publicly accessible TCP or UDP port has no external port.
+	ErrMissingExternalPortParam = errors.New("external port is required when a TCP or UDP port is publicly accessible")
 )
 
 // Validate returns an error to tell whether the Ports' domain model is valid or not.
@@ -129,22 +131,49 @@ func NewPort(params NewPortParams) (*Port, error) {
 // UpsertRequest represents the parameters needed to create & update a Variable.
 type UpsertRequest struct {
 	InternalPort       int32 `validate:"required"`
-	PubliclyAccessible bool  `validate:"required"`
+	PubliclyAccessible bool
 
+	ID           *string
 	Protocol     *string
 	Name         *string
 	ExternalPort *int32
 }
 
 // Validate returns an error to tell whether the UpsertRequest is valid or not.
+// TCP and UDP ports are not served through the http routing of Qovery, so they need an external port to be publicly accessible.
 func (r UpsertRequest) Validate() error {
 	if err := validator.New().Struct(r); err != nil {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	protocol, err := r.protocol()
+	if err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidProtocolParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if r.PubliclyAccessible && !protocol.IsHTTP() && r.ExternalPort == nil {
+		return errors.Wrap(ErrMissingExternalPortParam, ErrInvalidUpsertRequest.Error())
+	}
+
 	return nil
 }
 
+// IsPubliclyAccessibleHTTP returns a bool to tell whether the UpsertRequest is a publicly accessible port served through the http routing of Qovery.
+func (r UpsertRequest) IsPubliclyAccessibleHTTP() bool {
+	protocol, err := r.protocol()
+	return err == nil && r.PubliclyAccessible && protocol.IsHTTP()
+}
+
+// protocol returns the Protocol of the UpsertRequest, DefaultProtocol if not set.
+func (r UpsertRequest) protocol() (*Protocol, error) {
+	if r.Protocol == nil {
+		protocol := DefaultProtocol
+		return &protocol, nil
+	}
+
+	return NewProtocolFromString(*r.Protocol)
+}
+
 // IsValid returns a bool to tell whether the UpsertRequest is valid or not.
 func (r UpsertRequest) IsValid() bool {
 	return r.Validate() == nil
@@ -11,11 +11,17 @@ type Protocol string
 
 const (
 	ProtocolHTTP Protocol = "HTTP"
+	ProtocolGRPC Protocol = "GRPC"
+	ProtocolTCP  Protocol = "TCP"
+	ProtocolUDP  Protocol = "UDP"
 )
 
 // AllowedProtocolValues contains all the valid values of a Protocol.
 var AllowedProtocolValues = []Protocol{
 	ProtocolHTTP,
+	ProtocolGRPC,
+	ProtocolTCP,
+	ProtocolUDP,
 }
 
 // String returns the string value of a Protocol.
@@ -23,6 +29,12 @@ func (v Protocol) String() string {
 	return string(v)
 }
 
+// IsHTTP returns a bool to tell whether the Protocol is served through the http routing of Qovery, like HTTP and GRPC.
+// Only those protocols can use the http features such as custom domains.
+func (v Protocol) IsHTTP() bool {
+	return v == ProtocolHTTP || v == ProtocolGRPC
+}
+
 // Validate returns an error to tell whether the Protocol is valid or not.
 func (v Protocol) Validate() error {
 	if slices.Contains(AllowedProtocolValues, v) {
@@ -14,7 +14,7 @@ import (
 func TestNewProtocolFromString(t *testing.T) {
 	t.Parallel()
 
-	assert.Len(t, port.AllowedProtocolValues, len(qovery.AllowedPortProtocolEnumEnumValues))
+	assert.GreaterOrEqual(t, len(port.AllowedProtocolValues), len(qovery.AllowedPortProtocolEnumEnumValues))
 	for _, portType := range qovery.AllowedPortProtocolEnumEnumValues {
 		portTypeStr := string(portType)
 		t.Run(portTypeStr, func(t *testing.T) {
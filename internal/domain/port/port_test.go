@@ -0,0 +1,135 @@
+package port_test
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+)
+
+func TestUpsertRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       port.UpsertRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_protocol",
+			Request: port.UpsertRequest{
+				InternalPort: 8080,
+				Protocol:     pointer.ToString("SCTP"),
+			},
+			ExpectedError: port.ErrInvalidProtocolParam,
+		},
+		{
+			TestName: "fail_with_public_tcp_port_without_external_port",
+			Request: port.UpsertRequest{
+				InternalPort:       1883,
+				PubliclyAccessible: true,
+				Protocol:           pointer.ToString(port.ProtocolTCP.String()),
+			},
+			ExpectedError: port.ErrMissingExternalPortParam,
+		},
+		{
+			TestName: "fail_with_public_udp_port_without_external_port",
+			Request: port.UpsertRequest{
+				InternalPort:       5353,
+				PubliclyAccessible: true,
+				Protocol:           pointer.ToString(port.ProtocolUDP.String()),
+			},
+			ExpectedError: port.ErrMissingExternalPortParam,
+		},
+		{
+			TestName: "success_with_public_tcp_port",
+			Request: port.UpsertRequest{
+				InternalPort:       1883,
+				ExternalPort:       pointer.ToInt32(1883),
+				PubliclyAccessible: true,
+				Protocol:           pointer.ToString(port.ProtocolTCP.String()),
+			},
+		},
+		{
+			TestName: "success_with_private_tcp_port",
+			Request: port.UpsertRequest{
+				InternalPort: 1883,
+				Protocol:     pointer.ToString(port.ProtocolTCP.String()),
+			},
+		},
+		{
+			TestName: "success_with_public_grpc_port",
+			Request: port.UpsertRequest{
+				InternalPort:       50051,
+				PubliclyAccessible: true,
+				Protocol:           pointer.ToString(port.ProtocolGRPC.String()),
+			},
+		},
+		{
+			TestName: "success_with_default_protocol",
+			Request: port.UpsertRequest{
+				InternalPort:       8080,
+				PubliclyAccessible: true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.Request.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.False(t, tc.Request.IsValid())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, tc.Request.IsValid())
+		})
+	}
+}
+
+func TestUpsertRequest_IsPubliclyAccessibleHTTP(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName       string
+		Request        port.UpsertRequest
+		ExpectedResult bool
+	}{
+		{
+			TestName:       "public_port_with_default_protocol",
+			Request:        port.UpsertRequest{InternalPort: 8080, PubliclyAccessible: true},
+			ExpectedResult: true,
+		},
+		{
+			TestName:       "public_grpc_port",
+			Request:        port.UpsertRequest{InternalPort: 50051, PubliclyAccessible: true, Protocol: pointer.ToString(port.ProtocolGRPC.String())},
+			ExpectedResult: true,
+		},
+		{
+			TestName:       "private_http_port",
+			Request:        port.UpsertRequest{InternalPort: 8080, Protocol: pointer.ToString(port.ProtocolHTTP.String())},
+			ExpectedResult: false,
+		},
+		{
+			TestName:       "public_tcp_port",
+			Request:        port.UpsertRequest{InternalPort: 1883, ExternalPort: pointer.ToInt32(1883), PubliclyAccessible: true, Protocol: pointer.ToString(port.ProtocolTCP.String())},
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.ExpectedResult, tc.Request.IsPubliclyAccessibleHTTP())
+		})
+	}
+}
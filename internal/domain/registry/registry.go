@@ -110,24 +110,42 @@ type UpsertRequest struct {
 
 	Description *string
 	Config      UpsertRequestConfig
+
+	// ValidateCredentials tells whether the credentials of the config must be checked against the registry once it is created or updated.
+	ValidateCredentials bool
 }
 
+// UpsertRequestConfig represents the parameters needed to authenticate a registry.
+// The fields needed depend on the kind of the registry, see UpsertRequest.Validate.
 type UpsertRequestConfig struct {
-	AccessKeyID       *string
-	SecretAccessKey   *string
-	Region            *string
-	ScalewayAccessKey *string
-	ScalewaySecretKey *string
-	Username          *string
-	Password          *string
+	AccessKeyID         *string
+	SecretAccessKey     *string
+	Region              *string
+	ScalewayAccessKey   *string
+	ScalewaySecretKey   *string
+	Username            *string
+	Password            *string
+	JSONCredentials     *string
+	AzureTenantID       *string
+	AzureSubscriptionID *string
 }
 
 // Validate returns an error to tell whether the UpsertRequest is valid or not.
+// The config must contain the fields required by the kind of the registry.
 func (r UpsertRequest) Validate() error {
 	if err := validator.New().Struct(r); err != nil {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	kind, err := NewKindFromString(r.Kind)
+	if err != nil {
+		return errors.Wrap(errors.Wrap(err, ErrInvalidKindParam.Error()), ErrInvalidUpsertRequest.Error())
+	}
+
+	if err := r.Config.validateForKind(*kind); err != nil {
+		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+	}
+
 	return nil
 }
 
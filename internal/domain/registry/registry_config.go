@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidConfigParam is returned if the registry config doesn't match the kind of the registry.
+var ErrInvalidConfigParam = errors.New("invalid config param")
+
+// Names of the config fields, as exposed to the users.
+const (
+	configFieldAccessKeyID         = "access_key_id"
+	configFieldSecretAccessKey     = "secret_access_key"
+	configFieldRegion              = "region"
+	configFieldScalewayAccessKey   = "scaleway_access_key"
+	configFieldScalewaySecretKey   = "scaleway_secret_key"
+	configFieldUsername            = "username"
+	configFieldPassword            = "password"
+	configFieldJSONCredentials     = "json_credentials"
+	configFieldAzureTenantID       = "azure_tenant_id"
+	configFieldAzureSubscriptionID = "azure_subscription_id"
+)
+
+// requiredConfigFieldsByKind contains the config fields that must be set for each Kind.
+// The kinds that are not listed can be used anonymously, see optionalCredentialsKinds.
+var requiredConfigFieldsByKind = map[Kind][]string{
+	KindECR:                 {configFieldRegion, configFieldAccessKeyID, configFieldSecretAccessKey},
+	KindPublicECR:           {configFieldAccessKeyID, configFieldSecretAccessKey},
+	KindScalewayCR:          {configFieldRegion, configFieldScalewayAccessKey, configFieldScalewaySecretKey},
+	KindGcpArtifactRegistry: {configFieldRegion, configFieldJSONCredentials},
+	// DOCR authenticates with a DigitalOcean api token, given as both the username and the password.
+	KindDocker: {configFieldUsername, configFieldPassword},
+	// AZURE_CR authenticates with a service principal: its client id is the username and its client secret the password.
+	KindAzureCR:  {configFieldAzureTenantID, configFieldAzureSubscriptionID, configFieldUsername, configFieldPassword},
+	KindGitHubCR: {configFieldUsername, configFieldPassword},
+	KindGitLabCR: {configFieldUsername, configFieldPassword},
+}
+
+// optionalCredentialsKinds contains the kinds that can be used anonymously:
+// the username and password are optional but must be set together.
+var optionalCredentialsKinds = []Kind{
+	KindDockerHub,
+	KindGenericCR,
+}
+
+// fields returns the values of the config fields by name.
+func (c UpsertRequestConfig) fields() map[string]*string {
+	return map[string]*string{
+		configFieldAccessKeyID:         c.AccessKeyID,
+		configFieldSecretAccessKey:     c.SecretAccessKey,
+		configFieldRegion:              c.Region,
+		configFieldScalewayAccessKey:   c.ScalewayAccessKey,
+		configFieldScalewaySecretKey:   c.ScalewaySecretKey,
+		configFieldUsername:            c.Username,
+		configFieldPassword:            c.Password,
+		configFieldJSONCredentials:     c.JSONCredentials,
+		configFieldAzureTenantID:       c.AzureTenantID,
+		configFieldAzureSubscriptionID: c.AzureSubscriptionID,
+	}
+}
+
+// validateForKind returns an error if a config field required by the given Kind is missing.
+func (c UpsertRequestConfig) validateForKind(kind Kind) error {
+	fields := c.fields()
+
+	missing := make([]string, 0)
+	for _, name := range requiredConfigFieldsByKind[kind] {
+		if v := fields[name]; v == nil || *v == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.Wrap(fmt.Errorf("kind '%s' requires the config fields: %s", kind, strings.Join(missing, ", ")), ErrInvalidConfigParam.Error())
+	}
+
+	for _, k := range optionalCredentialsKinds {
+		if k == kind && isSet(c.Username) != isSet(c.Password) {
+			return errors.Wrap(fmt.Errorf("kind '%s' requires both or none of the config fields: %s, %s", kind, configFieldUsername, configFieldPassword), ErrInvalidConfigParam.Error())
+		}
+	}
+
+	return nil
+}
+
+// isSet returns true if the given config value is set and not empty.
+func isSet(v *string) bool {
+	return v != nil && *v != ""
+}
@@ -0,0 +1,188 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
+)
+
+func TestUpsertRequest_Validate(t *testing.T) {
+	t.Parallel()
+
+	value := func() *string {
+		v := gofakeit.Word()
+		return &v
+	}
+
+	testCases := []struct {
+		TestName      string
+		Kind          registry.Kind
+		Config        registry.UpsertRequestConfig
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_invalid_kind",
+			Kind:          registry.Kind("INVALID"),
+			ExpectedError: registry.ErrInvalidKindParam,
+		},
+		{
+			TestName: "success_with_ecr",
+			Kind:     registry.KindECR,
+			Config:   registry.UpsertRequestConfig{Region: value(), AccessKeyID: value(), SecretAccessKey: value()},
+		},
+		{
+			TestName:      "fail_with_ecr_without_secret_access_key",
+			Kind:          registry.KindECR,
+			Config:        registry.UpsertRequestConfig{Region: value(), AccessKeyID: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_public_ecr",
+			Kind:     registry.KindPublicECR,
+			Config:   registry.UpsertRequestConfig{AccessKeyID: value(), SecretAccessKey: value()},
+		},
+		{
+			TestName:      "fail_with_public_ecr_without_access_key_id",
+			Kind:          registry.KindPublicECR,
+			Config:        registry.UpsertRequestConfig{SecretAccessKey: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_docr",
+			Kind:     registry.KindDocker,
+			Config:   registry.UpsertRequestConfig{Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_docr_without_credentials",
+			Kind:          registry.KindDocker,
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_scaleway_cr",
+			Kind:     registry.KindScalewayCR,
+			Config:   registry.UpsertRequestConfig{Region: value(), ScalewayAccessKey: value(), ScalewaySecretKey: value()},
+		},
+		{
+			TestName:      "fail_with_scaleway_cr_without_region",
+			Kind:          registry.KindScalewayCR,
+			Config:        registry.UpsertRequestConfig{ScalewayAccessKey: value(), ScalewaySecretKey: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_gcp_artifact_registry",
+			Kind:     registry.KindGcpArtifactRegistry,
+			Config:   registry.UpsertRequestConfig{Region: value(), JSONCredentials: value()},
+		},
+		{
+			TestName:      "fail_with_gcp_artifact_registry_without_json_credentials",
+			Kind:          registry.KindGcpArtifactRegistry,
+			Config:        registry.UpsertRequestConfig{Region: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_azure_cr",
+			Kind:     registry.KindAzureCR,
+			Config:   registry.UpsertRequestConfig{AzureTenantID: value(), AzureSubscriptionID: value(), Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_azure_cr_without_subscription_id",
+			Kind:          registry.KindAzureCR,
+			Config:        registry.UpsertRequestConfig{AzureTenantID: value(), Username: value(), Password: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName:      "fail_with_azure_cr_without_credentials",
+			Kind:          registry.KindAzureCR,
+			Config:        registry.UpsertRequestConfig{AzureTenantID: value(), AzureSubscriptionID: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_github_cr",
+			Kind:     registry.KindGitHubCR,
+			Config:   registry.UpsertRequestConfig{Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_github_cr_without_password",
+			Kind:          registry.KindGitHubCR,
+			Config:        registry.UpsertRequestConfig{Username: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_gitlab_cr",
+			Kind:     registry.KindGitLabCR,
+			Config:   registry.UpsertRequestConfig{Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_gitlab_cr_without_credentials",
+			Kind:          registry.KindGitLabCR,
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_anonymous_docker_hub",
+			Kind:     registry.KindDockerHub,
+		},
+		{
+			TestName: "success_with_docker_hub",
+			Kind:     registry.KindDockerHub,
+			Config:   registry.UpsertRequestConfig{Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_docker_hub_without_password",
+			Kind:          registry.KindDockerHub,
+			Config:        registry.UpsertRequestConfig{Username: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+		{
+			TestName: "success_with_anonymous_generic_cr",
+			Kind:     registry.KindGenericCR,
+		},
+		{
+			TestName: "success_with_generic_cr",
+			Kind:     registry.KindGenericCR,
+			Config:   registry.UpsertRequestConfig{Username: value(), Password: value()},
+		},
+		{
+			TestName:      "fail_with_generic_cr_without_password",
+			Kind:          registry.KindGenericCR,
+			Config:        registry.UpsertRequestConfig{Username: value()},
+			ExpectedError: registry.ErrInvalidConfigParam,
+		},
+	}
+
+	// Ensure every kind is covered by a successful test case.
+	testedKinds := make(map[registry.Kind]bool)
+	for _, tc := range testCases {
+		if tc.ExpectedError == nil {
+			testedKinds[tc.Kind] = true
+		}
+	}
+	for _, kind := range registry.AllowedKindValues {
+		assert.True(t, testedKinds[kind], "missing test case for kind %s", kind)
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			req := registry.UpsertRequest{
+				Name:   gofakeit.Name(),
+				Kind:   tc.Kind.String(),
+				URL:    gofakeit.URL(),
+				Config: tc.Config,
+			}
+
+			err := req.Validate()
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, registry.ErrInvalidUpsertRequest.Error())
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -10,11 +10,16 @@ import (
 type Kind string
 
 const (
-	KindECR        Kind = "ECR"
-	KindDocker     Kind = "DOCR"
-	KindScalewayCR Kind = "SCALEWAY_CR"
-	KindDockerHub  Kind = "DOCKER_HUB"
-	KindPublicECR  Kind = "PUBLIC_ECR"
+	KindECR                 Kind = "ECR"
+	KindDocker              Kind = "DOCR"
+	KindScalewayCR          Kind = "SCALEWAY_CR"
+	KindDockerHub           Kind = "DOCKER_HUB"
+	KindPublicECR           Kind = "PUBLIC_ECR"
+	KindGcpArtifactRegistry Kind = "GCP_ARTIFACT_REGISTRY"
+	KindAzureCR             Kind = "AZURE_CR"
+	KindGitHubCR            Kind = "GITHUB_CR"
+	KindGitLabCR            Kind = "GITLAB_CR"
+	KindGenericCR           Kind = "GENERIC_CR"
 )
 
 // AllowedKindValues contains all the valid values of a Kind.
@@ -24,6 +29,11 @@ var AllowedKindValues = []Kind{
 	KindScalewayCR,
 	KindDockerHub,
 	KindPublicECR,
+	KindGcpArtifactRegistry,
+	KindAzureCR,
+	KindGitHubCR,
+	KindGitLabCR,
+	KindGenericCR,
 }
 
 // String returns the string value of a Kind.
@@ -11,10 +11,11 @@ import (
 
 // TestNewKindFromString validate that the kinds qovery.ContainerRegistryKindEnum defined in Qovery's API Client are valid.
 // This is useful to make sure the registry.Kind stays up to date.
+// NOTE: registry.Kind may contain kinds that are not defined yet in the api client.
 func TestNewKindFromString(t *testing.T) {
 	t.Parallel()
 
-	assert.Len(t, registry.AllowedKindValues, len(qovery.AllowedContainerRegistryKindEnumEnumValues))
+	assert.GreaterOrEqual(t, len(registry.AllowedKindValues), len(qovery.AllowedContainerRegistryKindEnumEnumValues))
 	for _, registryKind := range qovery.AllowedContainerRegistryKindEnumEnumValues {
 		registryKindStr := string(registryKind)
 		t.Run(registryKindStr, func(t *testing.T) {
@@ -13,4 +13,6 @@ type Repository interface {
 	Get(ctx context.Context, organizationID string, registryID string) (*Registry, error)
 	Update(ctx context.Context, organizationID string, registryID string, request UpsertRequest) (*Registry, error)
 	Delete(ctx context.Context, organizationID string, registryID string) error
+	// CheckCredentials returns an error if the registry rejects the credentials of its config.
+	CheckCredentials(ctx context.Context, organizationID string, registryID string) error
 }
@@ -11,6 +11,8 @@ var (
 	ErrFailedToGetRegistry    = errors.New("failed to get registry")
 	ErrFailedToUpdateRegistry = errors.New("failed to update registry")
 	ErrFailedToDeleteRegistry = errors.New("failed to delete registry")
+	// ErrInvalidRegistryCredentials is returned if the registry rejects its credentials when they are validated.
+	ErrInvalidRegistryCredentials = errors.New("invalid registry credentials: the registry rejected the authentication with the credentials of the config")
 )
 
 // Service represents the interface to implement to handle the domain logic of an Registry.
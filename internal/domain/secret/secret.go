@@ -23,6 +23,8 @@ var (
 	ErrInvalidKeyParam = errors.New("invalid key param")
 	// ErrInvalidScopeParam is returned if the scope param is invalid.
 	ErrInvalidScopeParam = errors.New("invalid scope param")
+	// ErrInvalidTypeParam is returned if the type param is invalid.
+	ErrInvalidTypeParam = errors.New("invalid type param")
 	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
 	ErrInvalidUpsertRequest = errors.New("invalid secrets upsert request")
 	// ErrInvalidDiffRequest is returned if the diff request is invalid.
@@ -47,10 +49,31 @@ func (s Secrets) IsValid() bool {
 	return s.Validate() == nil
 }
 
+// FindReferenceable returns the secret with the given key that can be targeted by an alias or an override.
+// Aliases and overrides can't be referenced, and the secret of the most specific scope is returned if the key is defined in several scopes.
+func (s Secrets) FindReferenceable(key string) *Secret {
+	var found *Secret
+	for i, it := range s {
+		if it.Key != key || it.Type.IsReference() {
+			continue
+		}
+
+		if found == nil || it.Scope.Precedence() < found.Scope.Precedence() {
+			found = &s[i]
+		}
+	}
+
+	return found
+}
+
 type Secret struct {
-	ID    uuid.UUID      `validate:"required"`
-	Scope variable.Scope `validate:"required"`
-	Key   string         `validate:"required"`
+	ID               uuid.UUID      `validate:"required"`
+	Scope            variable.Scope `validate:"required"`
+	Key              string         `validate:"required"`
+	Type             variable.Type
+	MountPath        *string
+	AliasedSecret    *variable.Reference
+	OverriddenSecret *variable.Reference
 }
 
 // Validate returns an error to tell whether the Secret domain model is valid or not.
@@ -74,9 +97,13 @@ func (s Secret) IsValid() bool {
 // NewSecretParams represents the arguments needed to create a Secret.
 type NewSecretsParams = []NewSecretParams
 type NewSecretParams struct {
-	SecretID string
-	Scope    string
-	Key      string
+	SecretID         string
+	Scope            string
+	Type             string
+	Key              string
+	MountPath        *string
+	AliasedSecret    *variable.NewReferenceParams
+	OverriddenSecret *variable.NewReferenceParams
 }
 
 // NewSecret returns a new instance of a Secret domain model.
@@ -91,14 +118,49 @@ func NewSecret(params NewSecretParams) (*Secret, error) {
 		return nil, errors.Wrap(err, ErrInvalidScopeParam.Error())
 	}
 
+	secretType := variable.TypeValue
+	if params.Type != "" {
+		t, err := variable.NewTypeFromString(params.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidTypeParam.Error())
+		}
+		secretType = *t
+	}
+
 	if params.Key == "" {
 		return nil, ErrInvalidKeyParam
 	}
 
+	if params.MountPath != nil {
+		if err := variable.ValidateMountPath(*params.MountPath); err != nil {
+			return nil, err
+		}
+	}
+
+	var aliasedSecret *variable.Reference
+	if params.AliasedSecret != nil {
+		aliasedSecret, err = variable.NewReference(*params.AliasedSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var overriddenSecret *variable.Reference
+	if params.OverriddenSecret != nil {
+		overriddenSecret, err = variable.NewReference(*params.OverriddenSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	v := &Secret{
-		ID:    secretsUUID,
-		Key:   params.Key,
-		Scope: *scope,
+		ID:               secretsUUID,
+		Key:              params.Key,
+		Scope:            *scope,
+		Type:             secretType,
+		MountPath:        params.MountPath,
+		AliasedSecret:    aliasedSecret,
+		OverriddenSecret: overriddenSecret,
 	}
 
 	if err := v.Validate(); err != nil {
@@ -109,9 +171,11 @@ func NewSecret(params NewSecretParams) (*Secret, error) {
 }
 
 // UpsertRequest represents the parameters needed to create & update a Secret.
+// The secret is a file mounted at MountPath if it is set, the mount path can only be set on creation.
 type UpsertRequest struct {
-	Key   string `validate:"required"`
-	Value string `validate:"required"`
+	Key       string `validate:"required"`
+	Value     string `validate:"required"`
+	MountPath *string
 }
 
 // Validate returns an error to tell whether the UpsertRequest is valid or not.
@@ -120,6 +184,12 @@ func (r UpsertRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	if r.MountPath != nil {
+		if err := variable.ValidateMountPath(*r.MountPath); err != nil {
+			return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+		}
+	}
+
 	return nil
 }
 
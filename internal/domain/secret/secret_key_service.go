@@ -0,0 +1,36 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+//go:generate mockery --testonly --with-expecter --name=KeyService --structname=SecretKeyService --filename=secret_key_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateSecret = errors.New("failed to create secret")
+	ErrFailedToGetSecret    = errors.New("failed to get secret")
+	ErrFailedToUpdateSecret = errors.New("failed to update secret")
+	ErrFailedToDeleteSecret = errors.New("failed to delete secret")
+	// ErrFailedToResolveScope is returned if the scope of the scope resource id can't be resolved.
+	ErrFailedToResolveScope = errors.New("failed to resolve scope")
+	// ErrSecretNotFound is returned if no secret with the given key is defined in the scope.
+	ErrSecretNotFound = errors.New("secret not found")
+	// ErrScopeResourceNotFound is returned if the scope resource id is not the id of a project, an environment or a service.
+	ErrScopeResourceNotFound = errors.New("no project, environment, application, container or job found for the scope resource id")
+)
+
+// KeyService represents the interface to implement to handle the domain logic of a single Secret identified by its key.
+// scopeResourceID can be the id of a project, an environment, an application, a container or a job of the given scope.
+// The scope is resolved once from the scopeResourceID with ResolveScope, then given to every other call.
+// Only the secrets defined in the scope are handled, the ones inherited from a parent scope are ignored.
+type KeyService interface {
+	ResolveScope(ctx context.Context, scopeResourceID string) (variable.Scope, error)
+	Create(ctx context.Context, scope variable.Scope, scopeResourceID string, request UpsertRequest) (*Secret, error)
+	Get(ctx context.Context, scope variable.Scope, scopeResourceID string, key string) (*Secret, error)
+	Update(ctx context.Context, scope variable.Scope, scopeResourceID string, secretID string, request UpsertRequest) (*Secret, error)
+	Delete(ctx context.Context, scope variable.Scope, scopeResourceID string, secretID string) error
+}
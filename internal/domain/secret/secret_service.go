@@ -12,6 +12,8 @@ import (
 var (
 	ErrFailedToListSecrets   = errors.New("failed to list secrets")
 	ErrFailedToUpdateSecrets = errors.New("failed to update secrets")
+	// ErrReferencedSecretNotFound is returned if the secret targeted by an alias or an override doesn't exist.
+	ErrReferencedSecretNotFound = errors.New("referenced secret not found")
 )
 
 // Service represents the interface to implement to handle the domain logic of a Secret.
@@ -22,9 +24,11 @@ type Service interface {
 
 // DiffRequest represents the parameters needed to create & update a Secret.
 type DiffRequest struct {
-	Create []DiffCreateRequest
-	Update []DiffUpdateRequest
-	Delete []DiffDeleteRequest
+	Create         []DiffCreateRequest
+	CreateAlias    []DiffCreateAliasRequest
+	CreateOverride []DiffCreateOverrideRequest
+	Update         []DiffUpdateRequest
+	Delete         []DiffDeleteRequest
 }
 
 // Validate returns an error to tell whether the UpsertServiceRequest is valid or not.
@@ -35,6 +39,18 @@ func (r DiffRequest) Validate() error {
 		}
 	}
 
+	for _, a := range r.CreateAlias {
+		if err := validator.New().Struct(a); err != nil {
+			return errors.Wrap(err, ErrInvalidDiffRequest.Error())
+		}
+	}
+
+	for _, o := range r.CreateOverride {
+		if err := validator.New().Struct(o); err != nil {
+			return errors.Wrap(err, ErrInvalidDiffRequest.Error())
+		}
+	}
+
 	for _, u := range r.Update {
 		if err := validator.New().Struct(u); err != nil {
 			return errors.Wrap(err, ErrInvalidDiffRequest.Error())
@@ -62,14 +78,40 @@ func (r DiffRequest) IsValid() bool {
 // IsEmpty returns a bool to tell whether the DiffRequest is empty or not.
 func (r DiffRequest) IsEmpty() bool {
 	return len(r.Create) == 0 &&
+		len(r.CreateAlias) == 0 &&
+		len(r.CreateOverride) == 0 &&
 		len(r.Update) == 0 &&
 		len(r.Delete) == 0
 }
 
+// MountPaths returns the mount paths of the file secrets created by the DiffRequest.
+func (r DiffRequest) MountPaths() []string {
+	mountPaths := make([]string, 0, len(r.Create))
+	for _, c := range r.Create {
+		if c.MountPath != nil {
+			mountPaths = append(mountPaths, *c.MountPath)
+		}
+	}
+
+	return mountPaths
+}
+
 type DiffCreateRequest struct {
 	UpsertRequest
 }
 
+// DiffCreateAliasRequest represents the parameters needed to create an alias named Key of the secret with the key AliasedSecretKey.
+type DiffCreateAliasRequest struct {
+	Key              string `validate:"required"`
+	AliasedSecretKey string `validate:"required"`
+}
+
+// DiffCreateOverrideRequest represents the parameters needed to override the value of the secret with the key OverriddenSecretKey.
+type DiffCreateOverrideRequest struct {
+	OverriddenSecretKey string `validate:"required"`
+	Value               string `validate:"required"`
+}
+
 type DiffUpdateRequest struct {
 	UpsertRequest
 	SecretID string `validate:"required"`
@@ -1,7 +1,6 @@
 package status
 
 import (
-	"strings"
 	"time"
 
 	"github.com/go-playground/validator/v10"
@@ -46,29 +45,12 @@ func (s Status) IsValid() bool {
 
 // IsFinalState returns a bool to tell whether the Status is a final state or not.
 func (s Status) IsFinalState() bool {
-	return !s.IsProcessingState() &&
-		!s.IsWaitingState() &&
-		!s.IsQueuedState()
+	return s.State.IsTerminal()
 }
 
 // IsErrorState returns a bool to tell whether the Status is an error state or not.
 func (s Status) IsErrorState() bool {
-	return strings.HasSuffix(s.State.String(), "_ERROR")
-}
-
-// IsWaitingState returns a bool to tell whether the Status is a waiting state or not.
-func (s Status) IsWaitingState() bool {
-	return strings.HasSuffix(s.State.String(), "_WAITING")
-}
-
-// IsQueuedState returns a bool to tell whether the Status is a queued state or not.
-func (s Status) IsQueuedState() bool {
-	return strings.HasSuffix(s.State.String(), "_QUEUED")
-}
-
-// IsProcessingState returns a bool to tell whether the Status is a processing state or not.
-func (s Status) IsProcessingState() bool {
-	return strings.HasSuffix(s.State.String(), "ING")
+	return s.State.IsError()
 }
 
 // NewStatusParams represents the arguments needed to create a Status.
@@ -60,6 +60,30 @@ var AllowedStateValues = []State{
 	StateRestartQueued,
 }
 
+// inProgressStateValues contains the states of a resource that is still being processed by Qovery.
+var inProgressStateValues = []State{
+	StateBuilding,
+	StateCanceling,
+	StateDeleteQueued,
+	StateDeleting,
+	StateDeploying,
+	StateDeploymentQueued,
+	StateQueued,
+	StateStopping,
+	StateStopQueued,
+	StateRestarting,
+	StateRestartQueued,
+}
+
+// errorStateValues contains the states of a resource whose last action failed.
+var errorStateValues = []State{
+	StateBuildError,
+	StateDeleteError,
+	StateDeploymentError,
+	StateStopError,
+	StateRestartError,
+}
+
 var AllowedDesiredStateValues = []State{
 	StateDeployed,
 	StateStopped,
@@ -85,6 +109,22 @@ func (v State) IsValid() bool {
 	return v.Validate() == nil
 }
 
+// IsInProgress returns a bool to tell whether the State is transient (queued or being processed) or not.
+func (v State) IsInProgress() bool {
+	return slices.Contains(inProgressStateValues, v)
+}
+
+// IsError returns a bool to tell whether the State is the result of a failed action or not.
+func (v State) IsError() bool {
+	return slices.Contains(errorStateValues, v)
+}
+
+// IsTerminal returns a bool to tell whether the State is final (successful or not) or not.
+// An unknown State is never considered as terminal.
+func (v State) IsTerminal() bool {
+	return v.IsValid() && !v.IsInProgress()
+}
+
 // NewStateFromString tries to turn a string into a State.
 // It returns an error if the string is not a valid value.
 func NewStateFromString(v string) (*State, error) {
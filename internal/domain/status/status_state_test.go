@@ -24,3 +24,58 @@ func TestNewStateFromString(t *testing.T) {
 		})
 	}
 }
+
+// TestStateClassification validate that every status.State is classified either as in progress or as terminal.
+func TestStateClassification(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		state      status.State
+		inProgress bool
+		isError    bool
+	}{
+		{state: status.StateBuilding, inProgress: true},
+		{state: status.StateBuildError, isError: true},
+		{state: status.StateCanceled},
+		{state: status.StateCanceling, inProgress: true},
+		{state: status.StateDeleted},
+		{state: status.StateDeleteError, isError: true},
+		{state: status.StateDeleteQueued, inProgress: true},
+		{state: status.StateDeleting, inProgress: true},
+		{state: status.StateDeployed},
+		{state: status.StateDeploying, inProgress: true},
+		{state: status.StateDeploymentError, isError: true},
+		{state: status.StateDeploymentQueued, inProgress: true},
+		{state: status.StateQueued, inProgress: true},
+		{state: status.StateReady},
+		{state: status.StateStopped},
+		{state: status.StateStopping, inProgress: true},
+		{state: status.StateStopError, isError: true},
+		{state: status.StateStopQueued, inProgress: true},
+		{state: status.StateRestarted},
+		{state: status.StateRestarting, inProgress: true},
+		{state: status.StateRestartError, isError: true},
+		{state: status.StateRestartQueued, inProgress: true},
+	}
+
+	assert.Len(t, testCases, len(status.AllowedStateValues))
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.state.String(), func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.inProgress, tc.state.IsInProgress())
+			assert.Equal(t, !tc.inProgress, tc.state.IsTerminal())
+			assert.Equal(t, tc.isError, tc.state.IsError())
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+
+		unknown := status.State("UNKNOWN")
+		assert.False(t, unknown.IsInProgress())
+		assert.False(t, unknown.IsTerminal())
+		assert.False(t, unknown.IsError())
+	})
+}
@@ -23,6 +23,10 @@ var (
 	ErrInvalidValueParam = errors.New("invalid value param")
 	// ErrInvalidScopeParam is returned if the scope param is invalid.
 	ErrInvalidScopeParam = errors.New("invalid scope param")
+	// ErrInvalidTypeParam is returned if the type param is invalid.
+	ErrInvalidTypeParam = errors.New("invalid type param")
+	// ErrInvalidReferenceParam is returned if the reference param of an alias or an override is invalid.
+	ErrInvalidReferenceParam = errors.New("invalid reference param")
 	// ErrInvalidUpsertRequest is returned if the upsert request is invalid.
 	ErrInvalidUpsertRequest = errors.New("invalid variable upsert request")
 	// ErrInvalidDiffRequest is returned if the diff request is invalid.
@@ -47,11 +51,32 @@ func (vv Variables) IsValid() bool {
 	return vv.Validate() == nil
 }
 
+// FindReferenceable returns the variable with the given key that can be targeted by an alias or an override.
+// Aliases and overrides can't be referenced, and the variable of the most specific scope is returned if the key is defined in several scopes.
+func (vv Variables) FindReferenceable(key string) *Variable {
+	var found *Variable
+	for i, v := range vv {
+		if v.Key != key || v.Type.IsReference() {
+			continue
+		}
+
+		if found == nil || v.Scope.Precedence() < found.Scope.Precedence() {
+			found = &vv[i]
+		}
+	}
+
+	return found
+}
+
 type Variable struct {
-	ID    uuid.UUID `validate:"required"`
-	Scope Scope     `validate:"required"`
-	Key   string    `validate:"required"`
-	Value string
+	ID                 uuid.UUID `validate:"required"`
+	Scope              Scope     `validate:"required"`
+	Key                string    `validate:"required"`
+	Value              string
+	Type               Type
+	MountPath          *string
+	AliasedVariable    *Reference
+	OverriddenVariable *Reference
 }
 
 // Validate returns an error to tell whether the Variable domain model is valid or not.
@@ -67,10 +92,14 @@ func (v Variable) IsValid() bool {
 // NewVariableParams represents the arguments needed to create a Variable.
 type NewVariablesParams = []NewVariableParams
 type NewVariableParams struct {
-	VariableID string
-	Scope      string
-	Key        string
-	Value      string
+	VariableID         string
+	Scope              string
+	Type               string
+	Key                string
+	Value              string
+	MountPath          *string
+	AliasedVariable    *NewReferenceParams
+	OverriddenVariable *NewReferenceParams
 }
 
 // NewVariable returns a new instance of a Variable domain model.
@@ -85,15 +114,44 @@ func NewVariable(params NewVariableParams) (*Variable, error) {
 		return nil, errors.Wrap(err, ErrInvalidScopeParam.Error())
 	}
 
+	variableType := TypeValue
+	if params.Type != "" {
+		t, err := NewTypeFromString(params.Type)
+		if err != nil {
+			return nil, errors.Wrap(err, ErrInvalidTypeParam.Error())
+		}
+		variableType = *t
+	}
+
 	if params.Key == "" {
 		return nil, ErrInvalidKeyParam
 	}
 
+	if params.MountPath != nil {
+		if err := ValidateMountPath(*params.MountPath); err != nil {
+			return nil, err
+		}
+	}
+
+	aliasedVariable, err := newOptionalReference(params.AliasedVariable)
+	if err != nil {
+		return nil, err
+	}
+
+	overriddenVariable, err := newOptionalReference(params.OverriddenVariable)
+	if err != nil {
+		return nil, err
+	}
+
 	v := &Variable{
-		ID:    variableUUID,
-		Key:   params.Key,
-		Value: params.Value,
-		Scope: *scope,
+		ID:                 variableUUID,
+		Key:                params.Key,
+		Value:              params.Value,
+		Scope:              *scope,
+		Type:               variableType,
+		MountPath:          params.MountPath,
+		AliasedVariable:    aliasedVariable,
+		OverriddenVariable: overriddenVariable,
 	}
 
 	if err := v.Validate(); err != nil {
@@ -104,9 +162,11 @@ func NewVariable(params NewVariableParams) (*Variable, error) {
 }
 
 // UpsertRequest represents the parameters needed to create & update a Variable.
+// The variable is a file mounted at MountPath if it is set, the mount path can only be set on creation.
 type UpsertRequest struct {
-	Key   string `validate:"required"`
-	Value string `validate:"required"`
+	Key       string `validate:"required"`
+	Value     string `validate:"required"`
+	MountPath *string
 }
 
 // Validate returns an error to tell whether the UpsertRequest is valid or not.
@@ -115,6 +175,12 @@ func (r UpsertRequest) Validate() error {
 		return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
 	}
 
+	if r.MountPath != nil {
+		if err := ValidateMountPath(*r.MountPath); err != nil {
+			return errors.Wrap(err, ErrInvalidUpsertRequest.Error())
+		}
+	}
+
 	return nil
 }
 
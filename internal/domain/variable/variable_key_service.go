@@ -0,0 +1,34 @@
+package variable
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --testonly --with-expecter --name=KeyService --structname=VariableKeyService --filename=variable_key_service_mock.go --output=../../application/services/mocks_test/ --outpkg=mocks_test
+
+var (
+	ErrFailedToCreateVariable = errors.New("failed to create variable")
+	ErrFailedToGetVariable    = errors.New("failed to get variable")
+	ErrFailedToUpdateVariable = errors.New("failed to update variable")
+	ErrFailedToDeleteVariable = errors.New("failed to delete variable")
+	// ErrFailedToResolveScope is returned if the scope of the scope resource id can't be resolved.
+	ErrFailedToResolveScope = errors.New("failed to resolve scope")
+	// ErrVariableNotFound is returned if no variable with the given key is defined in the scope.
+	ErrVariableNotFound = errors.New("variable not found")
+	// ErrScopeResourceNotFound is returned if the scope resource id is not the id of a project, an environment or a service.
+	ErrScopeResourceNotFound = errors.New("no project, environment, application, container or job found for the scope resource id")
+)
+
+// KeyService represents the interface to implement to handle the domain logic of a single Variable identified by its key.
+// scopeResourceID can be the id of a project, an environment, an application, a container or a job of the given scope.
+// The scope is resolved once from the scopeResourceID with ResolveScope, then given to every other call.
+// Only the variables defined in the scope are handled, the ones inherited from a parent scope are ignored.
+type KeyService interface {
+	ResolveScope(ctx context.Context, scopeResourceID string) (Scope, error)
+	Create(ctx context.Context, scope Scope, scopeResourceID string, request UpsertRequest) (*Variable, error)
+	Get(ctx context.Context, scope Scope, scopeResourceID string, key string) (*Variable, error)
+	Update(ctx context.Context, scope Scope, scopeResourceID string, variableID string, request UpsertRequest) (*Variable, error)
+	Delete(ctx context.Context, scope Scope, scopeResourceID string, variableID string) error
+}
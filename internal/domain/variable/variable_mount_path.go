@@ -0,0 +1,45 @@
+package variable
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrInvalidMountPathParam is returned if the mount path of a file variable is not an absolute path.
+	ErrInvalidMountPathParam = errors.New("invalid mount path param: must be an absolute path")
+	// ErrConflictingMountPath is returned if the mount path of a file variable conflicts with the mount point of a storage.
+	ErrConflictingMountPath = errors.New("mount path conflicts with a storage mount point")
+)
+
+// ValidateMountPath returns an error to tell whether the given mount path of a file variable is valid or not.
+func ValidateMountPath(mountPath string) error {
+	if !path.IsAbs(mountPath) || path.Clean(mountPath) == "/" {
+		return errors.Wrap(ErrInvalidMountPathParam, mountPath)
+	}
+
+	return nil
+}
+
+// CheckMountPathConflicts returns an error if one of the given mount paths of file variables is the same as one of the given storage mount points,
+// or if one of them is located inside the other.
+func CheckMountPathConflicts(mountPaths []string, storageMountPoints []string) error {
+	for _, mountPath := range mountPaths {
+		for _, mountPoint := range storageMountPoints {
+			if isSameOrNestedPath(mountPath, mountPoint) || isSameOrNestedPath(mountPoint, mountPath) {
+				return errors.Wrapf(ErrConflictingMountPath, "%s and %s", mountPath, mountPoint)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isSameOrNestedPath returns true if the path p is the same as the path parent or is located inside it.
+func isSameOrNestedPath(p string, parent string) bool {
+	p, parent = path.Clean(p), path.Clean(parent)
+
+	return p == parent || strings.HasPrefix(p, strings.TrimSuffix(parent, "/")+"/")
+}
@@ -0,0 +1,55 @@
+package variable
+
+import (
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Reference represents the variable targeted by an alias or an override.
+type Reference struct {
+	ID  uuid.UUID `validate:"required"`
+	Key string    `validate:"required"`
+}
+
+// Validate returns an error to tell whether the Reference domain model is valid or not.
+func (r Reference) Validate() error {
+	return validator.New().Struct(r)
+}
+
+// IsValid returns a bool to tell whether the Reference domain model is valid or not.
+func (r Reference) IsValid() bool {
+	return r.Validate() == nil
+}
+
+// NewReferenceParams represents the arguments needed to create a Reference.
+type NewReferenceParams struct {
+	VariableID string
+	Key        string
+}
+
+// NewReference returns a new instance of a Reference domain model.
+func NewReference(params NewReferenceParams) (*Reference, error) {
+	variableUUID, err := uuid.Parse(params.VariableID)
+	if err != nil {
+		return nil, errors.Wrap(err, ErrInvalidReferenceParam.Error())
+	}
+
+	if params.Key == "" {
+		return nil, errors.Wrap(ErrInvalidKeyParam, ErrInvalidReferenceParam.Error())
+	}
+
+	return &Reference{
+		ID:  variableUUID,
+		Key: params.Key,
+	}, nil
+}
+
+// newOptionalReference returns a new instance of a Reference domain model, or nil if no params are given.
+func newOptionalReference(params *NewReferenceParams) (*Reference, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	return NewReference(*params)
+}
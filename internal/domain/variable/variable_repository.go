@@ -10,6 +10,8 @@ import (
 // scopeResourceID can be either a projectID, environmentID, application or containerID
 type Repository interface {
 	Create(ctx context.Context, scopeResourceID string, request UpsertRequest) (*Variable, error)
+	CreateAlias(ctx context.Context, scopeResourceID string, aliasedVariableID string, key string) (*Variable, error)
+	CreateOverride(ctx context.Context, scopeResourceID string, overriddenVariableID string, value string) (*Variable, error)
 	List(ctx context.Context, scopeResourceID string) (Variables, error)
 	Update(ctx context.Context, scopeResourceID string, variableID string, request UpsertRequest) (*Variable, error)
 	Delete(ctx context.Context, scopeResourceID string, variableID string) error
@@ -28,6 +28,15 @@ var AllowedScopeValues = []Scope{
 	ScopeJob,
 }
 
+// KeyScopes contains the scopes a single variable or secret can be defined in, in the order they are tried when resolving the scope of a resource id.
+var KeyScopes = []Scope{
+	ScopeApplication,
+	ScopeContainer,
+	ScopeJob,
+	ScopeEnvironment,
+	ScopeProject,
+}
+
 // String returns the string value of a Scope.
 func (v Scope) String() string {
 	return string(v)
@@ -47,6 +56,20 @@ func (v Scope) IsValid() bool {
 	return v.Validate() == nil
 }
 
+// Precedence returns the rank of the Scope, from the most specific scope to the least specific one.
+func (v Scope) Precedence() int {
+	switch v {
+	case ScopeApplication, ScopeContainer, ScopeJob:
+		return 0
+	case ScopeEnvironment:
+		return 1
+	case ScopeProject:
+		return 2
+	default:
+		return 3
+	}
+}
+
 // NewScopeFromString tries to turn a string into a Scope.
 // It returns an error if the string is not a valid value.
 func NewScopeFromString(v string) (*Scope, error) {
@@ -44,6 +44,27 @@ func TestNewVariable(t *testing.T) {
 			},
 			ExpectedError: variable.ErrInvalidScopeParam,
 		},
+		{
+			TestName: "fail_with_invalid_type",
+			Params: variable.NewVariableParams{
+				VariableID: gofakeit.UUID(),
+				Scope:      variable.ScopeApplication.String(),
+				Type:       "INVALID",
+				Key:        gofakeit.Name(),
+			},
+			ExpectedError: variable.ErrInvalidTypeParam,
+		},
+		{
+			TestName: "fail_with_invalid_reference",
+			Params: variable.NewVariableParams{
+				VariableID:      gofakeit.UUID(),
+				Scope:           variable.ScopeApplication.String(),
+				Type:            variable.TypeAlias.String(),
+				Key:             gofakeit.Name(),
+				AliasedVariable: &variable.NewReferenceParams{VariableID: gofakeit.UUID()},
+			},
+			ExpectedError: variable.ErrInvalidReferenceParam,
+		},
 		{
 			TestName: "success_with_empty_value",
 			Params: variable.NewVariableParams{
@@ -61,6 +82,17 @@ func TestNewVariable(t *testing.T) {
 				Value:      gofakeit.Name(),
 			},
 		},
+		{
+			TestName: "success_with_override",
+			Params: variable.NewVariableParams{
+				VariableID:         gofakeit.UUID(),
+				Scope:              variable.ScopeApplication.String(),
+				Type:               variable.TypeOverride.String(),
+				Key:                gofakeit.Name(),
+				Value:              gofakeit.Name(),
+				OverriddenVariable: &variable.NewReferenceParams{VariableID: gofakeit.UUID(), Key: gofakeit.Name()},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -79,6 +111,10 @@ func TestNewVariable(t *testing.T) {
 			assert.Equal(t, tc.Params.VariableID, v.ID.String())
 			assert.Equal(t, tc.Params.Key, v.Key)
 			assert.Equal(t, tc.Params.Value, v.Value)
+			if tc.Params.OverriddenVariable != nil {
+				assert.Equal(t, tc.Params.OverriddenVariable.VariableID, v.OverriddenVariable.ID.String())
+				assert.Equal(t, tc.Params.OverriddenVariable.Key, v.OverriddenVariable.Key)
+			}
 		})
 	}
 }
@@ -0,0 +1,63 @@
+package variable
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/slices"
+)
+
+// Type is an enum that contains all the valid values of a variable type.
+type Type string
+
+const (
+	TypeValue    Type = "VALUE"
+	TypeAlias    Type = "ALIAS"
+	TypeOverride Type = "OVERRIDE"
+	TypeBuiltIn  Type = "BUILT_IN"
+	TypeFile     Type = "FILE"
+)
+
+// AllowedTypeValues contains all the valid values of a Type.
+var AllowedTypeValues = []Type{
+	TypeValue,
+	TypeAlias,
+	TypeOverride,
+	TypeBuiltIn,
+	TypeFile,
+}
+
+// String returns the string value of a Type.
+func (v Type) String() string {
+	return string(v)
+}
+
+// Validate returns an error to tell whether the Type is valid or not.
+func (v Type) Validate() error {
+	if slices.Contains(AllowedTypeValues, v) {
+		return nil
+	}
+
+	return fmt.Errorf("invalid value '%v' for Type: valid values are %v", v, AllowedTypeValues)
+}
+
+// IsValid returns a bool to tell whether the Type is valid or not.
+func (v Type) IsValid() bool {
+	return v.Validate() == nil
+}
+
+// IsReference returns a bool to tell whether the Type is referencing another variable, like an alias or an override.
+func (v Type) IsReference() bool {
+	return v == TypeAlias || v == TypeOverride
+}
+
+// NewTypeFromString tries to turn a string into a Type.
+// It returns an error if the string is not a valid value.
+func NewTypeFromString(v string) (*Type, error) {
+	ev := Type(v)
+
+	if err := ev.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ev, nil
+}
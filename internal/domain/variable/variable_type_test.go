@@ -0,0 +1,26 @@
+package variable_test
+
+import (
+	"testing"
+
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// TestNewTypeFromString validate that the types qovery.APIVariableTypeEnum defined in Qovery's API Client are valid.
+// This is useful to make sure the variable.Type stays up to date.
+func TestNewTypeFromString(t *testing.T) {
+	t.Parallel()
+
+	assert.Len(t, variable.AllowedTypeValues, len(qovery.AllowedAPIVariableTypeEnumEnumValues))
+	for _, variableType := range qovery.AllowedAPIVariableTypeEnumEnumValues {
+		variableTypeStr := string(variableType)
+		t.Run(variableTypeStr, func(t *testing.T) {
+			vt, err := variable.NewTypeFromString(variableTypeStr)
+			assert.NoError(t, err)
+			assert.Equal(t, vt.String(), variableTypeStr)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// Package envlock provides a way to serialize the actions run on a Qovery environment.
+// The Qovery API rejects a deployment, a redeploy or a stop while another one is in progress on the same environment,
+// so the resources of an environment applied in parallel have to wait for each other.
+package envlock
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidEnvironmentID is returned when trying to lock an environment without its id.
+var ErrInvalidEnvironmentID = errors.New("invalid environment id")
+
+// Locker holds a lock per environment.
+// The lock of an environment is given to the waiting callers one at a time, and a caller can stop waiting by cancelling its context.
+// The zero value is not usable, use New to get a Locker.
+type Locker struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+// New returns a new Locker without any lock held.
+func New() *Locker {
+	return &Locker{
+		locks: make(map[string]chan struct{}),
+	}
+}
+
+// Lock waits until the lock of the given environment is free and takes it.
+// It returns a function that must be called to release the lock, or an error if the context is done before the lock is free.
+func (l *Locker) Lock(ctx context.Context, environmentID string) (func(), error) {
+	if environmentID == "" {
+		return nil, ErrInvalidEnvironmentID
+	}
+
+	lock := l.get(environmentID)
+
+	select {
+	case lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, errors.Wrapf(ctx.Err(), "failed to lock environment '%s'", environmentID)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-lock
+		})
+	}, nil
+}
+
+// get returns the lock of the given environment and creates it if needed.
+func (l *Locker) get(environmentID string) chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lock, ok := l.locks[environmentID]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		l.locks[environmentID] = lock
+	}
+
+	return lock
+}
@@ -0,0 +1,99 @@
+package envlock_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/envlock"
+)
+
+func TestLocker_Lock(t *testing.T) {
+	t.Parallel()
+
+	locker := envlock.New()
+	environmentID := gofakeit.UUID()
+
+	var running int32
+	var maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			unlock, err := locker.Lock(context.Background(), environmentID)
+			require.NoError(t, err)
+			defer unlock()
+
+			current := atomic.AddInt32(&running, 1)
+			for {
+				previous := atomic.LoadInt32(&maxRunning)
+				if current <= previous || atomic.CompareAndSwapInt32(&maxRunning, previous, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestLocker_LockDistinctEnvironments(t *testing.T) {
+	t.Parallel()
+
+	locker := envlock.New()
+
+	unlock, err := locker.Lock(context.Background(), gofakeit.UUID())
+	require.NoError(t, err)
+	defer unlock()
+
+	// The lock of another environment is free.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	otherUnlock, err := locker.Lock(ctx, gofakeit.UUID())
+	require.NoError(t, err)
+	otherUnlock()
+}
+
+func TestLocker_LockContextDone(t *testing.T) {
+	t.Parallel()
+
+	locker := envlock.New()
+	environmentID := gofakeit.UUID()
+
+	unlock, err := locker.Lock(context.Background(), environmentID)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = locker.Lock(ctx, environmentID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// Releasing the lock twice doesn't release the lock taken by another caller.
+	unlock()
+	unlock()
+	secondUnlock, err := locker.Lock(context.Background(), environmentID)
+	require.NoError(t, err)
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = locker.Lock(ctx, environmentID)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	secondUnlock()
+}
+
+func TestLocker_LockInvalidEnvironmentID(t *testing.T) {
+	t.Parallel()
+
+	_, err := envlock.New().Lock(context.Background(), "")
+	assert.ErrorIs(t, err, envlock.ErrInvalidEnvironmentID)
+}
@@ -0,0 +1,281 @@
+// Package httpretry provides an http.RoundTripper retrying the requests that failed because of a transient error.
+// It waits between two attempts using an exponential backoff with jitter, or the delay asked by the server
+// using the `Retry-After` header, and only retries the requests that are safe to send again.
+package httpretry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxAttempts is the maximum number of times a request is sent, including the first attempt.
+	DefaultMaxAttempts = 5
+	// DefaultInitialInterval is the time to wait between the first two attempts.
+	DefaultInitialInterval = 1 * time.Second
+	// DefaultMaxInterval is the maximum time to wait between two attempts.
+	DefaultMaxInterval = 30 * time.Second
+	// DefaultMaxRetryAfter is the maximum delay asked by the server using the `Retry-After` header that is honoured.
+	// The response is returned as is when the server asks to wait longer.
+	DefaultMaxRetryAfter = 5 * time.Minute
+	// DefaultMultiplier is the factor applied to the interval after each attempt.
+	DefaultMultiplier = 2
+	// DefaultJitter is the randomization factor applied to each interval.
+	DefaultJitter = 0.2
+)
+
+// errNotRewindable is returned when the body of a request cannot be read again to retry it.
+var errNotRewindable = errors.New("request body cannot be rewound")
+
+// idempotentMethods contains the http methods of the requests that can be sent several times without side effects.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+// transientStatusCodes contains the status codes of the responses sent when the server failed to handle a request
+// because of a temporary failure.
+var transientStatusCodes = map[int]bool{
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+type config struct {
+	maxAttempts     int
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxRetryAfter   time.Duration
+	multiplier      float64
+	jitter          float64
+}
+
+// Option allows to customize the behaviour of the Transport.
+type Option func(*config)
+
+// WithMaxAttempts sets the maximum number of times a request is sent, including the first attempt.
+// Retries are disabled when set to 1.
+func WithMaxAttempts(maxAttempts int) Option {
+	return func(c *config) {
+		if maxAttempts > 0 {
+			c.maxAttempts = maxAttempts
+		}
+	}
+}
+
+// WithInterval sets the initial and the maximum time to wait between two attempts.
+func WithInterval(initial time.Duration, max time.Duration) Option {
+	return func(c *config) {
+		if initial > 0 {
+			c.initialInterval = initial
+		}
+		if max >= c.initialInterval {
+			c.maxInterval = max
+		}
+	}
+}
+
+// WithJitter sets the randomization factor applied to each interval, between 0 and 1.
+func WithJitter(jitter float64) Option {
+	return func(c *config) {
+		if jitter >= 0 && jitter <= 1 {
+			c.jitter = jitter
+		}
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		maxAttempts:     DefaultMaxAttempts,
+		initialInterval: DefaultInitialInterval,
+		maxInterval:     DefaultMaxInterval,
+		maxRetryAfter:   DefaultMaxRetryAfter,
+		multiplier:      DefaultMultiplier,
+		jitter:          DefaultJitter,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Transport is an http.RoundTripper that retries the requests that failed because of a transient error.
+//
+// A request is retried when:
+//   - the server answered with a 429 Too Many Requests: the request has not been handled so it is always safe to retry.
+//   - the server answered with a 502, 503 or 504, or the request failed because of a network error,
+//     and the request is idempotent (GET, HEAD, OPTIONS, PUT, DELETE).
+type Transport struct {
+	next http.RoundTripper
+	cfg  *config
+}
+
+// NewTransport returns a new Transport sending the requests using the given http.RoundTripper.
+// The http.DefaultTransport is used if next is nil.
+func NewTransport(next http.RoundTripper, opts ...Option) *Transport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &Transport{
+		next: next,
+		cfg:  newConfig(opts),
+	}
+}
+
+// NewClient returns a new http.Client using a Transport configured with the given options.
+func NewClient(opts ...Option) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(nil, opts...),
+	}
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	interval := t.cfg.initialInterval
+	for attempt := 1; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if attempt >= t.cfg.maxAttempts || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := withJitter(interval, t.cfg.jitter)
+		if retryAfter, ok := parseRetryAfter(resp); ok {
+			if retryAfter > t.cfg.maxRetryAfter {
+				return resp, err
+			}
+			wait = retryAfter
+		}
+
+		// The body of the previous request has been consumed, it needs to be rewound before sending the request again.
+		nextReq, rewindErr := rewindRequest(req)
+		if rewindErr != nil {
+			return resp, err
+		}
+
+		drainBody(resp)
+		if sleepErr := sleep(req.Context(), wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+
+		req = nextReq
+		interval = nextInterval(interval, t.cfg)
+	}
+}
+
+// shouldRetry tells whether the request can be sent again given the response or the error of the last attempt.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotentMethods[req.Method]
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	return transientStatusCodes[resp.StatusCode] && idempotentMethods[req.Method]
+}
+
+// parseRetryAfter returns the delay asked by the server using the `Retry-After` header.
+// The header value is either a number of seconds or an http date.
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// rewindRequest returns a copy of the request with a fresh body so it can be sent again.
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	if req.GetBody == nil {
+		return nil, errNotRewindable
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	nextReq := req.Clone(req.Context())
+	nextReq.Body = body
+	return nextReq, nil
+}
+
+// drainBody reads and closes the body of a response that won't be returned so the connection can be reused.
+func drainBody(resp *http.Response) {
+	if resp == nil || resp.Body == nil {
+		return
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	_ = resp.Body.Close()
+}
+
+func nextInterval(interval time.Duration, cfg *config) time.Duration {
+	next := time.Duration(float64(interval) * cfg.multiplier)
+	if next > cfg.maxInterval {
+		return cfg.maxInterval
+	}
+
+	return next
+}
+
+func withJitter(interval time.Duration, jitter float64) time.Duration {
+	if jitter == 0 {
+		return interval
+	}
+
+	delta := jitter * float64(interval)
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// sleep pauses the current goroutine for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
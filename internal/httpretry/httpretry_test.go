@@ -0,0 +1,231 @@
+package httpretry_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/httpretry"
+)
+
+var fastOptions = []httpretry.Option{
+	httpretry.WithInterval(time.Millisecond, 2*time.Millisecond),
+	httpretry.WithJitter(0),
+}
+
+// newServer returns a test server answering with the given status codes one after the other.
+// The last status code is returned forever once the sequence is exhausted.
+func newServer(t *testing.T, headers http.Header, statusCodes ...int) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idx := int(atomic.AddInt32(&calls, 1)) - 1
+		if idx >= len(statusCodes) {
+			idx = len(statusCodes) - 1
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		for k, v := range headers {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(statusCodes[idx])
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName           string
+		Method             string
+		StatusCodes        []int
+		Options            []httpretry.Option
+		ExpectedStatusCode int
+		ExpectedCalls      int32
+	}{
+		{
+			TestName:           "success_without_retry",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusOK},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedCalls:      1,
+		},
+		{
+			TestName:           "retry_get_on_bad_gateway",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedCalls:      3,
+		},
+		{
+			TestName:           "retry_put_with_body_on_gateway_timeout",
+			Method:             http.MethodPut,
+			StatusCodes:        []int{http.StatusGatewayTimeout, http.StatusOK},
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedCalls:      2,
+		},
+		{
+			TestName:           "retry_post_on_too_many_requests",
+			Method:             http.MethodPost,
+			StatusCodes:        []int{http.StatusTooManyRequests, http.StatusCreated},
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedCalls:      2,
+		},
+		{
+			TestName:           "do_not_retry_post_on_bad_gateway",
+			Method:             http.MethodPost,
+			StatusCodes:        []int{http.StatusBadGateway, http.StatusCreated},
+			ExpectedStatusCode: http.StatusBadGateway,
+			ExpectedCalls:      1,
+		},
+		{
+			TestName:           "do_not_retry_on_internal_server_error",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusInternalServerError, http.StatusOK},
+			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedCalls:      1,
+		},
+		{
+			TestName:           "do_not_retry_on_client_error",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusNotFound, http.StatusOK},
+			ExpectedStatusCode: http.StatusNotFound,
+			ExpectedCalls:      1,
+		},
+		{
+			TestName:           "stop_after_max_attempts",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusServiceUnavailable},
+			Options:            []httpretry.Option{httpretry.WithMaxAttempts(3)},
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedCalls:      3,
+		},
+		{
+			TestName:           "retries_disabled_with_one_attempt",
+			Method:             http.MethodGet,
+			StatusCodes:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			Options:            []httpretry.Option{httpretry.WithMaxAttempts(1)},
+			ExpectedStatusCode: http.StatusServiceUnavailable,
+			ExpectedCalls:      1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newServer(t, nil, tc.StatusCodes...)
+			client := &http.Client{Transport: httpretry.NewTransport(nil, append(fastOptions, tc.Options...)...)}
+
+			req, err := http.NewRequest(tc.Method, server.URL, strings.NewReader(`{"name": "test"}`))
+			require.NoError(t, err)
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.ExpectedStatusCode, resp.StatusCode)
+			assert.Equal(t, tc.ExpectedCalls, atomic.LoadInt32(calls))
+			// The request body is sent again on each attempt.
+			assert.Equal(t, `{"name": "test"}`, string(body))
+		})
+	}
+}
+
+func TestTransport_RetryAfter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName           string
+		RetryAfter         string
+		ExpectedStatusCode int
+		ExpectedCalls      int32
+		ExpectedMinElapsed time.Duration
+	}{
+		{
+			TestName:           "wait_the_given_seconds",
+			RetryAfter:         "1",
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedCalls:      2,
+			ExpectedMinElapsed: time.Second,
+		},
+		{
+			TestName:           "wait_until_the_given_date",
+			RetryAfter:         time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat),
+			ExpectedStatusCode: http.StatusOK,
+			ExpectedCalls:      2,
+		},
+		{
+			TestName:           "give_up_when_asked_to_wait_too_long",
+			RetryAfter:         "3600",
+			ExpectedStatusCode: http.StatusTooManyRequests,
+			ExpectedCalls:      1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newServer(t, http.Header{"Retry-After": []string{tc.RetryAfter}}, http.StatusTooManyRequests, http.StatusOK)
+			client := &http.Client{Transport: httpretry.NewTransport(nil, fastOptions...)}
+
+			start := time.Now()
+			resp, err := client.Post(server.URL, "application/json", nil)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.ExpectedStatusCode, resp.StatusCode)
+			assert.Equal(t, tc.ExpectedCalls, atomic.LoadInt32(calls))
+			assert.GreaterOrEqual(t, time.Since(start), tc.ExpectedMinElapsed)
+		})
+	}
+}
+
+func TestTransport_RetryNetworkErrors(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newServer(t, nil, http.StatusOK)
+	server.Close()
+
+	client := &http.Client{Transport: httpretry.NewTransport(nil, append(fastOptions, httpretry.WithMaxAttempts(3))...)}
+
+	_, err := client.Get(server.URL)
+	assert.Error(t, err)
+	assert.Equal(t, int32(0), atomic.LoadInt32(calls))
+}
+
+func TestTransport_ContextCanceled(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newServer(t, nil, http.StatusServiceUnavailable)
+	client := &http.Client{Transport: httpretry.NewTransport(nil, httpretry.WithInterval(time.Hour, time.Hour))}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(calls))
+}
@@ -79,6 +79,61 @@ func (_c *DeploymentRepository_Deploy_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// GetEnvironmentID provides a mock function with given fields: ctx, resourceID
+func (_m *DeploymentRepository) GetEnvironmentID(ctx context.Context, resourceID string) (string, error) {
+	ret := _m.Called(ctx, resourceID)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, resourceID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, resourceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, resourceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeploymentRepository_GetEnvironmentID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEnvironmentID'
+type DeploymentRepository_GetEnvironmentID_Call struct {
+	*mock.Call
+}
+
+// GetEnvironmentID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resourceID string
+func (_e *DeploymentRepository_Expecter) GetEnvironmentID(ctx interface{}, resourceID interface{}) *DeploymentRepository_GetEnvironmentID_Call {
+	return &DeploymentRepository_GetEnvironmentID_Call{Call: _e.mock.On("GetEnvironmentID", ctx, resourceID)}
+}
+
+func (_c *DeploymentRepository_GetEnvironmentID_Call) Run(run func(ctx context.Context, resourceID string)) *DeploymentRepository_GetEnvironmentID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DeploymentRepository_GetEnvironmentID_Call) Return(_a0 string, _a1 error) *DeploymentRepository_GetEnvironmentID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DeploymentRepository_GetEnvironmentID_Call) RunAndReturn(run func(context.Context, string) (string, error)) *DeploymentRepository_GetEnvironmentID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetStatus provides a mock function with given fields: ctx, resourceID
 func (_m *DeploymentRepository) GetStatus(ctx context.Context, resourceID string) (*status.Status, error) {
 	ret := _m.Called(ctx, resourceID)
@@ -22,6 +22,50 @@ func (_m *RegistryRepository) EXPECT() *RegistryRepository_Expecter {
 	return &RegistryRepository_Expecter{mock: &_m.Mock}
 }
 
+// CheckCredentials provides a mock function with given fields: ctx, organizationID, registryID
+func (_m *RegistryRepository) CheckCredentials(ctx context.Context, organizationID string, registryID string) error {
+	ret := _m.Called(ctx, organizationID, registryID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, organizationID, registryID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RegistryRepository_CheckCredentials_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckCredentials'
+type RegistryRepository_CheckCredentials_Call struct {
+	*mock.Call
+}
+
+// CheckCredentials is a helper method to define mock.On call
+//   - ctx context.Context
+//   - organizationID string
+//   - registryID string
+func (_e *RegistryRepository_Expecter) CheckCredentials(ctx interface{}, organizationID interface{}, registryID interface{}) *RegistryRepository_CheckCredentials_Call {
+	return &RegistryRepository_CheckCredentials_Call{Call: _e.mock.On("CheckCredentials", ctx, organizationID, registryID)}
+}
+
+func (_c *RegistryRepository_CheckCredentials_Call) Run(run func(ctx context.Context, organizationID string, registryID string)) *RegistryRepository_CheckCredentials_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *RegistryRepository_CheckCredentials_Call) Return(_a0 error) *RegistryRepository_CheckCredentials_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RegistryRepository_CheckCredentials_Call) RunAndReturn(run func(context.Context, string, string) error) *RegistryRepository_CheckCredentials_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, organizationID, request
 func (_m *RegistryRepository) Create(ctx context.Context, organizationID string, request registry.UpsertRequest) (*registry.Registry, error) {
 	ret := _m.Called(ctx, organizationID, request)
@@ -78,6 +78,120 @@ func (_c *SecretRepository_Create_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
+// CreateAlias provides a mock function with given fields: ctx, scopeResourceID, aliasedSecretID, key
+func (_m *SecretRepository) CreateAlias(ctx context.Context, scopeResourceID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	ret := _m.Called(ctx, scopeResourceID, aliasedSecretID, key)
+
+	var r0 *secret.Secret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*secret.Secret, error)); ok {
+		return rf(ctx, scopeResourceID, aliasedSecretID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *secret.Secret); ok {
+		r0 = rf(ctx, scopeResourceID, aliasedSecretID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secret.Secret)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, scopeResourceID, aliasedSecretID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretRepository_CreateAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlias'
+type SecretRepository_CreateAlias_Call struct {
+	*mock.Call
+}
+
+// CreateAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scopeResourceID string
+//   - aliasedSecretID string
+//   - key string
+func (_e *SecretRepository_Expecter) CreateAlias(ctx interface{}, scopeResourceID interface{}, aliasedSecretID interface{}, key interface{}) *SecretRepository_CreateAlias_Call {
+	return &SecretRepository_CreateAlias_Call{Call: _e.mock.On("CreateAlias", ctx, scopeResourceID, aliasedSecretID, key)}
+}
+
+func (_c *SecretRepository_CreateAlias_Call) Run(run func(ctx context.Context, scopeResourceID string, aliasedSecretID string, key string)) *SecretRepository_CreateAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_CreateAlias_Call) Return(_a0 *secret.Secret, _a1 error) *SecretRepository_CreateAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretRepository_CreateAlias_Call) RunAndReturn(run func(context.Context, string, string, string) (*secret.Secret, error)) *SecretRepository_CreateAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOverride provides a mock function with given fields: ctx, scopeResourceID, overriddenSecretID, value
+func (_m *SecretRepository) CreateOverride(ctx context.Context, scopeResourceID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	ret := _m.Called(ctx, scopeResourceID, overriddenSecretID, value)
+
+	var r0 *secret.Secret
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*secret.Secret, error)); ok {
+		return rf(ctx, scopeResourceID, overriddenSecretID, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *secret.Secret); ok {
+		r0 = rf(ctx, scopeResourceID, overriddenSecretID, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*secret.Secret)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, scopeResourceID, overriddenSecretID, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SecretRepository_CreateOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOverride'
+type SecretRepository_CreateOverride_Call struct {
+	*mock.Call
+}
+
+// CreateOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scopeResourceID string
+//   - overriddenSecretID string
+//   - value string
+func (_e *SecretRepository_Expecter) CreateOverride(ctx interface{}, scopeResourceID interface{}, overriddenSecretID interface{}, value interface{}) *SecretRepository_CreateOverride_Call {
+	return &SecretRepository_CreateOverride_Call{Call: _e.mock.On("CreateOverride", ctx, scopeResourceID, overriddenSecretID, value)}
+}
+
+func (_c *SecretRepository_CreateOverride_Call) Run(run func(ctx context.Context, scopeResourceID string, overriddenSecretID string, value string)) *SecretRepository_CreateOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *SecretRepository_CreateOverride_Call) Return(_a0 *secret.Secret, _a1 error) *SecretRepository_CreateOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SecretRepository_CreateOverride_Call) RunAndReturn(run func(context.Context, string, string, string) (*secret.Secret, error)) *SecretRepository_CreateOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, scopeResourceID, secretID
 func (_m *SecretRepository) Delete(ctx context.Context, scopeResourceID string, secretID string) error {
 	ret := _m.Called(ctx, scopeResourceID, secretID)
@@ -78,6 +78,120 @@ func (_c *VariableRepository_Create_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// CreateAlias provides a mock function with given fields: ctx, scopeResourceID, aliasedVariableID, key
+func (_m *VariableRepository) CreateAlias(ctx context.Context, scopeResourceID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	ret := _m.Called(ctx, scopeResourceID, aliasedVariableID, key)
+
+	var r0 *variable.Variable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*variable.Variable, error)); ok {
+		return rf(ctx, scopeResourceID, aliasedVariableID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *variable.Variable); ok {
+		r0 = rf(ctx, scopeResourceID, aliasedVariableID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*variable.Variable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, scopeResourceID, aliasedVariableID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VariableRepository_CreateAlias_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAlias'
+type VariableRepository_CreateAlias_Call struct {
+	*mock.Call
+}
+
+// CreateAlias is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scopeResourceID string
+//   - aliasedVariableID string
+//   - key string
+func (_e *VariableRepository_Expecter) CreateAlias(ctx interface{}, scopeResourceID interface{}, aliasedVariableID interface{}, key interface{}) *VariableRepository_CreateAlias_Call {
+	return &VariableRepository_CreateAlias_Call{Call: _e.mock.On("CreateAlias", ctx, scopeResourceID, aliasedVariableID, key)}
+}
+
+func (_c *VariableRepository_CreateAlias_Call) Run(run func(ctx context.Context, scopeResourceID string, aliasedVariableID string, key string)) *VariableRepository_CreateAlias_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *VariableRepository_CreateAlias_Call) Return(_a0 *variable.Variable, _a1 error) *VariableRepository_CreateAlias_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VariableRepository_CreateAlias_Call) RunAndReturn(run func(context.Context, string, string, string) (*variable.Variable, error)) *VariableRepository_CreateAlias_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateOverride provides a mock function with given fields: ctx, scopeResourceID, overriddenVariableID, value
+func (_m *VariableRepository) CreateOverride(ctx context.Context, scopeResourceID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	ret := _m.Called(ctx, scopeResourceID, overriddenVariableID, value)
+
+	var r0 *variable.Variable
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*variable.Variable, error)); ok {
+		return rf(ctx, scopeResourceID, overriddenVariableID, value)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *variable.Variable); ok {
+		r0 = rf(ctx, scopeResourceID, overriddenVariableID, value)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*variable.Variable)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, scopeResourceID, overriddenVariableID, value)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VariableRepository_CreateOverride_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOverride'
+type VariableRepository_CreateOverride_Call struct {
+	*mock.Call
+}
+
+// CreateOverride is a helper method to define mock.On call
+//   - ctx context.Context
+//   - scopeResourceID string
+//   - overriddenVariableID string
+//   - value string
+func (_e *VariableRepository_Expecter) CreateOverride(ctx interface{}, scopeResourceID interface{}, overriddenVariableID interface{}, value interface{}) *VariableRepository_CreateOverride_Call {
+	return &VariableRepository_CreateOverride_Call{Call: _e.mock.On("CreateOverride", ctx, scopeResourceID, overriddenVariableID, value)}
+}
+
+func (_c *VariableRepository_CreateOverride_Call) Run(run func(ctx context.Context, scopeResourceID string, overriddenVariableID string, value string)) *VariableRepository_CreateOverride_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *VariableRepository_CreateOverride_Call) Return(_a0 *variable.Variable, _a1 error) *VariableRepository_CreateOverride_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VariableRepository_CreateOverride_Call) RunAndReturn(run func(context.Context, string, string, string) (*variable.Variable, error)) *VariableRepository_CreateOverride_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Delete provides a mock function with given fields: ctx, scopeResourceID, variableID
 func (_m *VariableRepository) Delete(ctx context.Context, scopeResourceID string, variableID string) error {
 	ret := _m.Called(ctx, scopeResourceID, variableID)
@@ -0,0 +1,50 @@
+package qoveryapi
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+// newDomainAdvancedSettingsFromQovery takes the advanced settings of a service returned by the API client (i.e: qovery.ApplicationAdvancedSettings)
+// and turns it into the domain model advancedsettings.AdvancedSettings.
+func newDomainAdvancedSettingsFromQovery[T any](advancedSettings *T) (advancedsettings.AdvancedSettings, error) {
+	if advancedSettings == nil {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(advancedSettings)
+	if err != nil {
+		return nil, errors.Wrap(err, advancedsettings.ErrInvalidAdvancedSettings.Error())
+	}
+
+	var settings advancedsettings.AdvancedSettings
+	if err := json.Unmarshal(raw, &settings); err != nil {
+		return nil, errors.Wrap(err, advancedsettings.ErrInvalidAdvancedSettings.Error())
+	}
+
+	return settings, nil
+}
+
+// newQoveryAdvancedSettingsFromDomain takes the domain model advancedsettings.AdvancedSettings and turns it into the advanced settings of a service
+// of the API client (i.e: qovery.ApplicationAdvancedSettings) to make the api call.
+// The settings that are unknown to the model of the API client are rejected instead of being silently dropped.
+func newQoveryAdvancedSettingsFromDomain[T any](advancedSettings advancedsettings.AdvancedSettings) (*T, error) {
+	raw, err := json.Marshal(advancedSettings)
+	if err != nil {
+		return nil, errors.Wrap(err, advancedsettings.ErrInvalidAdvancedSettings.Error())
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+
+	var settings T
+	if err := decoder.Decode(&settings); err != nil {
+		return nil, errors.Wrap(err, advancedsettings.ErrInvalidAdvancedSettings.Error())
+	}
+
+	return &settings, nil
+}
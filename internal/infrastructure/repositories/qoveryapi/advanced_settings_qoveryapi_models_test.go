@@ -0,0 +1,85 @@
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+func TestNewDomainAdvancedSettingsFromQovery(t *testing.T) {
+	t.Parallel()
+
+	settings, err := newDomainAdvancedSettingsFromQovery[qovery.ContainerAdvancedSettings](nil)
+	assert.NoError(t, err)
+	assert.Nil(t, settings)
+
+	settings, err = newDomainAdvancedSettingsFromQovery(&qovery.ContainerAdvancedSettings{
+		DeploymentTerminationGracePeriodSeconds: pointer.ToInt32(60),
+		NetworkIngressEnableCors:                pointer.ToBool(true),
+		NetworkIngressCorsAllowOrigin:           pointer.ToString("*"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": float64(60),
+		"network.ingress.enable_cors":                 true,
+		"network.ingress.cors_allow_origin":           "*",
+	}, settings)
+}
+
+func TestNewQoveryAdvancedSettingsFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName         string
+		AdvancedSettings advancedsettings.AdvancedSettings
+		ExpectedSettings *qovery.JobAdvancedSettings
+		ExpectedError    error
+	}{
+		{
+			TestName:         "success_with_empty_settings",
+			AdvancedSettings: advancedsettings.AdvancedSettings{},
+			ExpectedSettings: &qovery.JobAdvancedSettings{},
+		},
+		{
+			TestName: "success_with_settings",
+			AdvancedSettings: advancedsettings.AdvancedSettings{
+				"deployment.termination_grace_period_seconds": int64(120),
+				"cronjob.concurrency_policy":                  "Forbid",
+			},
+			ExpectedSettings: &qovery.JobAdvancedSettings{
+				DeploymentTerminationGracePeriodSeconds: pointer.ToInt32(120),
+				CronjobConcurrencyPolicy:                pointer.ToString("Forbid"),
+			},
+		},
+		{
+			TestName:         "fail_with_unknown_setting",
+			AdvancedSettings: advancedsettings.AdvancedSettings{"network.ingress.enable_cors": true},
+			ExpectedError:    advancedsettings.ErrInvalidAdvancedSettings,
+		},
+		{
+			TestName:         "fail_with_invalid_setting_type",
+			AdvancedSettings: advancedsettings.AdvancedSettings{"deployment.termination_grace_period_seconds": "60"},
+			ExpectedError:    advancedsettings.ErrInvalidAdvancedSettings,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			settings, err := newQoveryAdvancedSettingsFromDomain[qovery.JobAdvancedSettings](tc.AdvancedSettings)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, settings)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedSettings, settings)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+// Ensure applicationCustomDomainsQoveryAPI defined types fully satisfy the customdomain.Repository interface.
+var _ customdomain.Repository = applicationCustomDomainsQoveryAPI{}
+
+// applicationCustomDomainsQoveryAPI implements the interface customdomain.Repository.
+type applicationCustomDomainsQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationCustomDomainsQoveryAPI return a new instance of a customdomain.Repository that uses Qovery's API.
+func newApplicationCustomDomainsQoveryAPI(client *qovery.APIClient) (customdomain.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationCustomDomainsQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a custom domain for an application using the given applicationID and request.
+func (p applicationCustomDomainsQoveryAPI) Create(ctx context.Context, applicationID string, request customdomain.UpsertRequest) (*customdomain.CustomDomain, error) {
+	d, resp, err := p.client.CustomDomainApi.
+		CreateApplicationCustomDomain(ctx, applicationID).
+		CustomDomainRequest(newQoveryCustomDomainRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationCustomDomain, request.Domain, resp, err)
+	}
+
+	return newDomainCustomDomainFromQovery(d)
+}
+
+// Get calls Qovery's API to retrieve a custom domain of an application using the given applicationID and customDomainID.
+func (p applicationCustomDomainsQoveryAPI) Get(ctx context.Context, applicationID string, customDomainID string) (*customdomain.CustomDomain, error) {
+	d, resp, err := p.client.CustomDomainApi.
+		GetCustomDomainStatus(ctx, applicationID, customDomainID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationCustomDomain, customDomainID, resp, err)
+	}
+
+	return newDomainCustomDomainFromQovery(d)
+}
+
+// List calls Qovery's API to retrieve the custom domains of an application using the given applicationID.
+func (p applicationCustomDomainsQoveryAPI) List(ctx context.Context, applicationID string) (customdomain.CustomDomains, error) {
+	list, resp, err := p.client.CustomDomainApi.
+		ListApplicationCustomDomain(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationCustomDomain, applicationID, resp, err)
+	}
+
+	return newDomainCustomDomainsFromQovery(list)
+}
+
+// Delete calls Qovery's API to delete a custom domain from an application using the given applicationID and customDomainID.
+func (p applicationCustomDomainsQoveryAPI) Delete(ctx context.Context, applicationID string, customDomainID string) error {
+	resp, err := p.client.CustomDomainApi.
+		DeleteCustomDomain(ctx, applicationID, customDomainID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceApplicationCustomDomain, customDomainID, resp, err)
+	}
+
+	return nil
+}
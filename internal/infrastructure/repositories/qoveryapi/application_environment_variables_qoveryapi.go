@@ -0,0 +1,105 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// Ensure applicationEnvironmentVariablesQoveryAPI defined types fully satisfy the variable.Repository interface.
+var _ variable.Repository = applicationEnvironmentVariablesQoveryAPI{}
+
+// applicationEnvironmentVariablesQoveryAPI implements the interface variable.Repository.
+type applicationEnvironmentVariablesQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationEnvironmentVariablesQoveryAPI return a new instance of a variable.Repository that uses Qovery's API.
+func newApplicationEnvironmentVariablesQoveryAPI(client *qovery.APIClient) (variable.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationEnvironmentVariablesQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create an environment variable for an application using the given applicationID and request.
+func (p applicationEnvironmentVariablesQoveryAPI) Create(ctx context.Context, applicationID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableApi.
+		CreateApplicationEnvironmentVariable(ctx, applicationID).
+		EnvironmentVariableRequest(newQoveryEnvironmentVariableRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationEnvironmentVariable, request.Key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateAlias calls Qovery's API to create an alias named key of the environment variable with the given aliasedVariableID for an application using the given applicationID.
+func (p applicationEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, applicationID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableApi.
+		CreateApplicationEnvironmentVariableAlias(ctx, applicationID, aliasedVariableID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationEnvironmentVariable, key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the environment variable with the given overriddenVariableID for an application using the given applicationID.
+func (p applicationEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, applicationID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableApi.
+		CreateApplicationEnvironmentVariableOverride(ctx, applicationID, overriddenVariableID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationEnvironmentVariable, overriddenVariableID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// List calls Qovery's API to retrieve an environment variables from an application using the given applicationID and variableID.
+func (p applicationEnvironmentVariablesQoveryAPI) List(ctx context.Context, applicationID string) (variable.Variables, error) {
+	vars, resp, err := p.client.ApplicationEnvironmentVariableApi.
+		ListApplicationEnvironmentVariable(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationEnvironmentVariable, applicationID, resp, err)
+	}
+
+	return newDomainVariablesFromQovery(vars)
+}
+
+// Update calls Qovery's API to update an environment variable from an application using the given applicationID, credentialsID and request.
+func (p applicationEnvironmentVariablesQoveryAPI) Update(ctx context.Context, applicationID string, credentialsID string, request variable.UpsertRequest) (*variable.Variable, error) {
+	v, resp, err := p.client.ApplicationEnvironmentVariableApi.
+		EditApplicationEnvironmentVariable(ctx, applicationID, credentialsID).
+		EnvironmentVariableEditRequest(newQoveryEnvironmentVariableEditRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplicationEnvironmentVariable, credentialsID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// Delete calls Qovery's API to delete an environment variable from an application using the given applicationID and credentialsID.
+func (p applicationEnvironmentVariablesQoveryAPI) Delete(ctx context.Context, applicationID string, credentialsID string) error {
+	resp, err := p.client.ApplicationEnvironmentVariableApi.
+		DeleteApplicationEnvironmentVariable(ctx, applicationID, credentialsID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceApplicationEnvironmentVariable, credentialsID, resp, err)
+	}
+
+	return nil
+}
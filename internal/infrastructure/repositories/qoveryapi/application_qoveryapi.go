@@ -0,0 +1,191 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+)
+
+// Ensure applicationQoveryAPI defined types fully satisfy the application.Repository interface.
+var _ application.Repository = applicationQoveryAPI{}
+
+// applicationQoveryAPI implements the interface application.Repository.
+type applicationQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationQoveryAPI return a new instance of a application.Repository that uses Qovery's API.
+func newApplicationQoveryAPI(client *qovery.APIClient) (application.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create an application in an environment using the given environmentID and request.
+func (c applicationQoveryAPI) Create(ctx context.Context, environmentID string, request application.UpsertRepositoryRequest) (*application.Application, error) {
+	req, err := newQoveryApplicationRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	newApplication, resp, err := c.client.ApplicationsApi.
+		CreateApplication(ctx, environmentID).
+		ApplicationRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplication, request.Name, resp, err)
+	}
+
+	// Attach application to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		_, response, err := c.client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(ctx, request.DeploymentStageID, newApplication.Id).Execute()
+		if err != nil || response.StatusCode >= 400 {
+			return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplication, request.Name, response, err)
+		}
+	}
+
+	// Get application deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsApi.GetServiceDeploymentStage(ctx, newApplication.Id).Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplication, newApplication.Id, resp, err)
+	}
+
+	advancedSettings, err := c.editAdvancedSettings(ctx, newApplication.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainApplicationFromQovery(newApplication, deploymentStage.Id, advancedSettings)
+}
+
+// Get calls Qovery's API to retrieve an application using the given applicationID.
+func (c applicationQoveryAPI) Get(ctx context.Context, applicationID string) (*application.Application, error) {
+	app, resp, err := c.client.ApplicationMainCallsApi.
+		GetApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplication, applicationID, resp, err)
+	}
+
+	// Get application deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsApi.GetServiceDeploymentStage(ctx, app.Id).Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplication, app.Id, resp, err)
+	}
+
+	advancedSettings, err := c.getAdvancedSettings(ctx, app.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainApplicationFromQovery(app, deploymentStage.Id, advancedSettings)
+}
+
+// Update calls Qovery's API to update an application using the given applicationID and request.
+func (c applicationQoveryAPI) Update(ctx context.Context, applicationID string, request application.UpsertRepositoryRequest) (*application.Application, error) {
+	req, err := newQoveryApplicationEditRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	app, resp, err := c.client.ApplicationMainCallsApi.
+		EditApplication(ctx, applicationID).
+		ApplicationEditRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplication, applicationID, resp, err)
+	}
+
+	// Attach application to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		_, response, err := c.client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(ctx, request.DeploymentStageID, app.Id).Execute()
+		if err != nil || response.StatusCode >= 400 {
+			return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplication, request.Name, response, err)
+		}
+	}
+
+	// Get application deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsApi.GetServiceDeploymentStage(ctx, app.Id).Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplication, app.Id, resp, err)
+	}
+
+	advancedSettings, err := c.editAdvancedSettings(ctx, app.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainApplicationFromQovery(app, deploymentStage.Id, advancedSettings)
+}
+
+// Delete calls Qovery's API to deletes an application using the given applicationID.
+func (c applicationQoveryAPI) Delete(ctx context.Context, applicationID string) error {
+	_, resp, err := c.client.ApplicationMainCallsApi.
+		GetApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		if resp != nil && resp.StatusCode == 404 {
+			// if the application is not found, then it has already been deleted
+			return nil
+		}
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceApplication, applicationID, resp, err)
+	}
+
+	resp, err = c.client.ApplicationMainCallsApi.
+		DeleteApplication(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceApplication, applicationID, resp, err)
+	}
+
+	return nil
+}
+
+// getAdvancedSettings calls Qovery's API to retrieve the advanced settings of an application using the given applicationID.
+func (c applicationQoveryAPI) getAdvancedSettings(ctx context.Context, applicationID string) (advancedsettings.AdvancedSettings, error) {
+	advancedSettings, resp, err := c.client.ApplicationConfigurationApi.
+		GetAdvancedSettings(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationAdvancedSettings, applicationID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(advancedSettings)
+}
+
+// editAdvancedSettings calls Qovery's API to update the advanced settings of an application using the given applicationID and advancedSettings.
+// The api is only called if the advancedSettings differ from the current ones, the settings that are not part of advancedSettings are kept.
+func (c applicationQoveryAPI) editAdvancedSettings(ctx context.Context, applicationID string, advancedSettings advancedsettings.AdvancedSettings) (advancedsettings.AdvancedSettings, error) {
+	currentAdvancedSettings, err := c.getAdvancedSettings(ctx, applicationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !advancedSettings.HasChanges(currentAdvancedSettings) {
+		return currentAdvancedSettings, nil
+	}
+
+	req, err := newQoveryAdvancedSettingsFromDomain[qovery.ApplicationAdvancedSettings](advancedSettings.Merge(currentAdvancedSettings))
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplicationAdvancedSettings, applicationID, nil, err)
+	}
+
+	updatedAdvancedSettings, resp, err := c.client.ApplicationConfigurationApi.
+		EditAdvancedSettings(ctx, applicationID).
+		ApplicationAdvancedSettings(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplicationAdvancedSettings, applicationID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(updatedAdvancedSettings)
+}
@@ -0,0 +1,190 @@
+package qoveryapi
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+)
+
+// newDomainApplicationFromQovery takes a qovery.Application returned by the API client and turns it into the domain model application.Application.
+func newDomainApplicationFromQovery(a *qovery.Application, deploymentStageID string, advancedSettings advancedsettings.AdvancedSettings) (*application.Application, error) {
+	if a == nil {
+		return nil, application.ErrNilApplication
+	}
+
+	ports, err := newDomainPortsFromQovery(a.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, port.ErrInvalidPorts.Error())
+	}
+
+	storages, err := newDomainStoragesFromQovery(a.Storage)
+	if err != nil {
+		return nil, errors.Wrap(err, storage.ErrInvalidStorages.Error())
+	}
+
+	var environmentID string
+	if a.Environment != nil {
+		environmentID = a.Environment.Id
+	}
+
+	var gitRepository git_repository.NewGitRepositoryParams
+	if a.GitRepository != nil {
+		gitRepository = git_repository.NewGitRepositoryParams{
+			Url:        pointer.GetString(a.GitRepository.Url),
+			Branch:     a.GitRepository.Branch,
+			CommitID:   a.GitRepository.DeployedCommitId,
+			RootPath:   a.GitRepository.RootPath,
+			GitTokenID: a.GitRepository.GitTokenId.Get(),
+		}
+	}
+
+	buildMode := application.DefaultBuildMode.String()
+	if a.BuildMode != nil {
+		buildMode = string(*a.BuildMode)
+	}
+
+	var buildpackLanguage *string
+	if lang := a.BuildpackLanguage.Get(); lang != nil {
+		buildpackLanguage = pointer.ToString(string(*lang))
+	}
+
+	return application.NewApplication(application.NewApplicationParams{
+		ApplicationID:       a.Id,
+		EnvironmentID:       environmentID,
+		Name:                pointer.GetString(a.Name),
+		GitRepository:       gitRepository,
+		BuildMode:           buildMode,
+		DockerfilePath:      a.DockerfilePath.Get(),
+		BuildpackLanguage:   buildpackLanguage,
+		AutoPreview:         pointer.GetBool(a.AutoPreview),
+		CPU:                 pointer.GetInt32(a.Cpu),
+		Memory:              pointer.GetInt32(a.Memory),
+		MinRunningInstances: pointer.GetInt32(a.MinRunningInstances),
+		MaxRunningInstances: pointer.GetInt32(a.MaxRunningInstances),
+		Entrypoint:          a.Entrypoint,
+		Arguments:           a.Arguments,
+		Ports:               ports,
+		Storages:            storages,
+		Healthchecks:        newDomainHealthchecksFromQovery(a.Healthchecks),
+		AdvancedSettings:    advancedSettings,
+		DeploymentStageID:   deploymentStageID,
+	})
+}
+
+// newQoveryApplicationRequestFromDomain takes the domain request application.UpsertRepositoryRequest and turns it into a qovery.ApplicationRequest to make the api call.
+func newQoveryApplicationRequestFromDomain(request application.UpsertRepositoryRequest) (*qovery.ApplicationRequest, error) {
+	ports, err := newQoveryPortsRequestFromDomain(request.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	storages, err := newQoveryStoragesRequestFromDomain(request.Storages)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	buildMode, err := newQoveryBuildModeFromDomain(request.BuildMode)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	buildpackLanguage, err := newQoveryBuildpackLanguageFromDomain(request.BuildpackLanguage)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.ApplicationRequest{
+		Name:                request.Name,
+		GitRepository:       newQoveryApplicationGitRepositoryRequestFromDomain(request.GitRepository),
+		BuildMode:           buildMode,
+		DockerfilePath:      *qovery.NewNullableString(request.DockerfilePath),
+		BuildpackLanguage:   *qovery.NewNullableBuildPackLanguageEnum(buildpackLanguage),
+		Entrypoint:          request.Entrypoint,
+		AutoPreview:         request.AutoPreview,
+		Cpu:                 request.CPU,
+		Memory:              request.Memory,
+		MinRunningInstances: request.MinRunningInstances,
+		MaxRunningInstances: request.MaxRunningInstances,
+		Arguments:           request.Arguments,
+		Storage:             storages,
+		Ports:               ports,
+		Healthchecks:        newQoveryHealthchecksFromDomain(request.Healthchecks),
+	}, nil
+}
+
+// newQoveryApplicationEditRequestFromDomain takes the domain request application.UpsertRepositoryRequest and turns it into a qovery.ApplicationEditRequest to make the api call.
+func newQoveryApplicationEditRequestFromDomain(request application.UpsertRepositoryRequest) (*qovery.ApplicationEditRequest, error) {
+	ports, err := newQoveryServicePortsFromDomain(request.Ports)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	storages, err := newQoveryStoragesRequestFromDomain(request.Storages)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	buildMode, err := newQoveryBuildModeFromDomain(request.BuildMode)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	buildpackLanguage, err := newQoveryBuildpackLanguageFromDomain(request.BuildpackLanguage)
+	if err != nil {
+		return nil, errors.Wrap(err, application.ErrInvalidUpsertRequest.Error())
+	}
+
+	gitRepository := newQoveryApplicationGitRepositoryRequestFromDomain(request.GitRepository)
+
+	return &qovery.ApplicationEditRequest{
+		Name:                pointer.ToString(request.Name),
+		GitRepository:       &gitRepository,
+		BuildMode:           buildMode,
+		DockerfilePath:      request.DockerfilePath,
+		BuildpackLanguage:   *qovery.NewNullableBuildPackLanguageEnum(buildpackLanguage),
+		Entrypoint:          request.Entrypoint,
+		AutoPreview:         request.AutoPreview,
+		Cpu:                 request.CPU,
+		Memory:              request.Memory,
+		MinRunningInstances: request.MinRunningInstances,
+		MaxRunningInstances: request.MaxRunningInstances,
+		Arguments:           request.Arguments,
+		Storage:             storages,
+		Ports:               ports,
+		Healthchecks:        newQoveryHealthchecksFromDomain(request.Healthchecks),
+	}, nil
+}
+
+// newQoveryApplicationGitRepositoryRequestFromDomain takes the domain request application.GitRepositoryUpsertRequest and turns it into a qovery.ApplicationGitRepositoryRequest to make the api call.
+func newQoveryApplicationGitRepositoryRequestFromDomain(request application.GitRepositoryUpsertRequest) qovery.ApplicationGitRepositoryRequest {
+	return qovery.ApplicationGitRepositoryRequest{
+		Url:        request.URL,
+		Branch:     request.Branch,
+		RootPath:   request.RootPath,
+		GitTokenId: *qovery.NewNullableString(request.GitTokenID),
+	}
+}
+
+// newQoveryBuildModeFromDomain turns an optional build mode into a qovery.BuildModeEnum.
+func newQoveryBuildModeFromDomain(buildMode *string) (*qovery.BuildModeEnum, error) {
+	if buildMode == nil {
+		return nil, nil
+	}
+
+	return qovery.NewBuildModeEnumFromValue(*buildMode)
+}
+
+// newQoveryBuildpackLanguageFromDomain turns an optional buildpack language into a qovery.BuildPackLanguageEnum.
+func newQoveryBuildpackLanguageFromDomain(buildpackLanguage *string) (*qovery.BuildPackLanguageEnum, error) {
+	if buildpackLanguage == nil {
+		return nil, nil
+	}
+
+	return qovery.NewBuildPackLanguageEnumFromValue(*buildpackLanguage)
+}
@@ -0,0 +1,241 @@
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+)
+
+const (
+	minApplicationInt32Range = 1
+	maxApplicationInt32Range = 100.000
+)
+
+func TestNewDomainApplicationFromQovery(t *testing.T) {
+	t.Parallel()
+
+	dockerBuildMode := qovery.BUILDMODEENUM_DOCKER
+
+	testCases := []struct {
+		TestName      string
+		Application   *qovery.Application
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_nil_application",
+			Application:   nil,
+			ExpectedError: application.ErrNilApplication,
+		},
+		{
+			TestName: "success",
+			Application: &qovery.Application{
+				Id: gofakeit.UUID(),
+				Environment: &qovery.ReferenceObject{
+					Id: gofakeit.UUID(),
+				},
+				GitRepository: &qovery.ApplicationGitRepository{
+					Url:              pointer.ToString(gofakeit.URL()),
+					Branch:           pointer.ToString(gofakeit.Word()),
+					RootPath:         pointer.ToString("/"),
+					DeployedCommitId: pointer.ToString(gofakeit.Regex("[0-9a-f]{40}")),
+				},
+				Arguments: []string{
+					gofakeit.Word(),
+				},
+				Name:                pointer.ToString(gofakeit.Name()),
+				BuildMode:           &dockerBuildMode,
+				DockerfilePath:      *qovery.NewNullableString(pointer.ToString("Dockerfile")),
+				Entrypoint:          pointer.ToString(gofakeit.Word()),
+				AutoPreview:         pointer.ToBool(gofakeit.Bool()),
+				Cpu:                 pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				Memory:              pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				MinRunningInstances: pointer.ToInt32(0),
+				MaxRunningInstances: pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				Ports: []qovery.ServicePort{
+					{
+						Id:                 gofakeit.UUID(),
+						InternalPort:       5000,
+						Protocol:           qovery.PORTPROTOCOLENUM_HTTP,
+						PubliclyAccessible: gofakeit.Bool(),
+					},
+				},
+				Storage: []qovery.ServiceStorageStorageInner{
+					{
+						Id:         gofakeit.UUID(),
+						Type:       qovery.STORAGETYPEENUM_FAST_SSD,
+						Size:       10,
+						MountPoint: "/data",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			fakeDeploymentStageId := uuid.NewString()
+			app, err := newDomainApplicationFromQovery(tc.Application, fakeDeploymentStageId, nil)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, app)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, app)
+			assert.True(t, app.IsValid())
+			assert.Equal(t, tc.Application.Id, app.ID.String())
+			assert.Equal(t, tc.Application.Environment.Id, app.EnvironmentID.String())
+			assert.Equal(t, *tc.Application.Name, app.Name)
+			assert.Equal(t, *tc.Application.GitRepository.Url, app.GitRepository.Url)
+			assert.Equal(t, tc.Application.GitRepository.Branch, app.GitRepository.Branch)
+			assert.Equal(t, tc.Application.GitRepository.RootPath, app.GitRepository.RootPath)
+			assert.Equal(t, tc.Application.GitRepository.DeployedCommitId, app.GitRepository.CommitID)
+			assert.Equal(t, string(*tc.Application.BuildMode), app.BuildMode.String())
+			assert.Equal(t, tc.Application.DockerfilePath.Get(), app.DockerfilePath)
+			assert.Equal(t, *tc.Application.Cpu, app.CPU)
+			assert.Equal(t, *tc.Application.Memory, app.Memory)
+			assert.Equal(t, *tc.Application.MinRunningInstances, app.MinRunningInstances)
+			assert.Equal(t, *tc.Application.MaxRunningInstances, app.MaxRunningInstances)
+			assert.Equal(t, *tc.Application.AutoPreview, app.AutoPreview)
+			assert.Equal(t, tc.Application.Entrypoint, app.Entrypoint)
+			assert.Equal(t, fakeDeploymentStageId, app.DeploymentStageID)
+
+			assert.Len(t, tc.Application.Ports, len(app.Ports))
+			for idx, p := range app.Ports {
+				assert.Equal(t, tc.Application.Ports[idx].Id, p.ID.String())
+				assert.Equal(t, tc.Application.Ports[idx].InternalPort, p.InternalPort)
+				assert.Equal(t, string(tc.Application.Ports[idx].Protocol), p.Protocol.String())
+				assert.Equal(t, tc.Application.Ports[idx].PubliclyAccessible, p.PubliclyAccessible)
+			}
+
+			assert.Len(t, tc.Application.Storage, len(app.Storages))
+			for idx, s := range app.Storages {
+				assert.Equal(t, tc.Application.Storage[idx].Id, s.ID.String())
+				assert.Equal(t, string(tc.Application.Storage[idx].Type), s.Type.String())
+				assert.Equal(t, tc.Application.Storage[idx].Size, s.Size)
+				assert.Equal(t, tc.Application.Storage[idx].MountPoint, s.MountPoint)
+			}
+
+			assert.Len(t, tc.Application.Arguments, len(app.Arguments))
+			for _, arg := range app.Arguments {
+				assert.Contains(t, tc.Application.Arguments, arg)
+			}
+		})
+	}
+}
+
+func TestNewQoveryApplicationRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Request  application.UpsertRepositoryRequest
+	}{
+		{
+			TestName: "success_required_fields_only",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{
+					URL: gofakeit.URL(),
+				},
+			},
+		},
+		{
+			TestName: "success",
+			Request: application.UpsertRepositoryRequest{
+				Name: gofakeit.Name(),
+				GitRepository: application.GitRepositoryUpsertRequest{
+					URL:      gofakeit.URL(),
+					Branch:   pointer.ToString(gofakeit.Word()),
+					RootPath: pointer.ToString("/"),
+				},
+				BuildMode:           pointer.ToString(application.BuildModeDocker.String()),
+				DockerfilePath:      pointer.ToString("Dockerfile"),
+				Entrypoint:          pointer.ToString(gofakeit.Word()),
+				CPU:                 pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				Memory:              pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				MinRunningInstances: pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				MaxRunningInstances: pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+				AutoPreview:         pointer.ToBool(gofakeit.Bool()),
+				Arguments: []string{
+					gofakeit.Word(),
+				},
+				Ports: []port.UpsertRequest{
+					{
+						ID:                 pointer.ToString(gofakeit.UUID()),
+						Name:               pointer.ToString(gofakeit.Name()),
+						InternalPort:       int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range)),
+						ExternalPort:       pointer.ToInt32(int32(gofakeit.IntRange(minApplicationInt32Range, maxApplicationInt32Range))),
+						Protocol:           pointer.ToString(port.ProtocolHTTP.String()),
+						PubliclyAccessible: gofakeit.Bool(),
+					},
+				},
+				Storages: []storage.UpsertRequest{
+					{
+						ID:         pointer.ToString(gofakeit.UUID()),
+						Type:       storage.TypeFastSSD.String(),
+						Size:       10,
+						MountPoint: "/data",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req, err := newQoveryApplicationRequestFromDomain(tc.Request)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.GitRepository.URL, req.GitRepository.Url)
+			assert.Equal(t, tc.Request.GitRepository.Branch, req.GitRepository.Branch)
+			assert.Equal(t, tc.Request.GitRepository.RootPath, req.GitRepository.RootPath)
+			assert.Equal(t, tc.Request.DockerfilePath, req.DockerfilePath.Get())
+			assert.Equal(t, tc.Request.Entrypoint, req.Entrypoint)
+			assert.Equal(t, tc.Request.CPU, req.Cpu)
+			assert.Equal(t, tc.Request.Memory, req.Memory)
+			assert.Equal(t, tc.Request.MinRunningInstances, req.MinRunningInstances)
+			assert.Equal(t, tc.Request.MaxRunningInstances, req.MaxRunningInstances)
+			if tc.Request.BuildMode != nil {
+				assert.Equal(t, *tc.Request.BuildMode, string(*req.BuildMode))
+			} else {
+				assert.Nil(t, req.BuildMode)
+			}
+
+			assert.Len(t, tc.Request.Ports, len(req.Ports))
+			assert.Len(t, tc.Request.Storages, len(req.Storage))
+			assert.Len(t, tc.Request.Arguments, len(req.Arguments))
+
+			editReq, err := newQoveryApplicationEditRequestFromDomain(tc.Request)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tc.Request.Name, *editReq.Name)
+			assert.Equal(t, tc.Request.GitRepository.URL, editReq.GitRepository.Url)
+			assert.Equal(t, tc.Request.DockerfilePath, editReq.DockerfilePath)
+
+			assert.Len(t, tc.Request.Ports, len(editReq.Ports))
+			for idx, p := range editReq.Ports {
+				assert.Equal(t, pointer.GetString(tc.Request.Ports[idx].ID), p.Id)
+				assert.Equal(t, tc.Request.Ports[idx].InternalPort, p.InternalPort)
+			}
+
+			assert.Len(t, tc.Request.Storages, len(editReq.Storage))
+			for idx, s := range editReq.Storage {
+				assert.Equal(t, tc.Request.Storages[idx].ID, s.Id)
+				assert.Equal(t, tc.Request.Storages[idx].MountPoint, s.MountPoint)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+)
+
+// Ensure applicationSecretsQoveryAPI defined types fully satisfy the secret.Repository interface.
+var _ secret.Repository = applicationSecretsQoveryAPI{}
+
+// applicationSecretsQoveryAPI implements the interface secret.Repository.
+type applicationSecretsQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newApplicationSecretsQoveryAPI return a new instance of a secret.Repository that uses Qovery's API.
+func newApplicationSecretsQoveryAPI(client *qovery.APIClient) (secret.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &applicationSecretsQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create an environment secret for an application using the given applicationID and request.
+func (p applicationSecretsQoveryAPI) Create(ctx context.Context, applicationID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretApi.
+		CreateApplicationSecret(ctx, applicationID).
+		SecretRequest(newQoverySecretRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationSecret, request.Key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateAlias calls Qovery's API to create an alias named key of the secret with the given aliasedSecretID for an application using the given applicationID.
+func (p applicationSecretsQoveryAPI) CreateAlias(ctx context.Context, applicationID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretApi.
+		CreateApplicationSecretAlias(ctx, applicationID, aliasedSecretID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationSecret, key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the secret with the given overriddenSecretID for an application using the given applicationID.
+func (p applicationSecretsQoveryAPI) CreateOverride(ctx context.Context, applicationID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretApi.
+		CreateApplicationSecretOverride(ctx, applicationID, overriddenSecretID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceApplicationSecret, overriddenSecretID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// List calls Qovery's API to retrieve an environment secrets from an application using the given applicationID and secretID.
+func (p applicationSecretsQoveryAPI) List(ctx context.Context, applicationID string) (secret.Secrets, error) {
+	vars, resp, err := p.client.ApplicationSecretApi.
+		ListApplicationSecrets(ctx, applicationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceApplicationSecret, applicationID, resp, err)
+	}
+
+	return newDomainSecretsFromQovery(vars)
+}
+
+// Update calls Qovery's API to update an environment secret from an application using the given applicationID, credentialsID and request.
+func (p applicationSecretsQoveryAPI) Update(ctx context.Context, applicationID string, credentialsID string, request secret.UpsertRequest) (*secret.Secret, error) {
+	v, resp, err := p.client.ApplicationSecretApi.
+		EditApplicationSecret(ctx, applicationID, credentialsID).
+		SecretEditRequest(newQoverySecretEditRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceApplicationSecret, credentialsID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// Delete calls Qovery's API to delete an environment secret from an application using the given applicationID and credentialsID.
+func (p applicationSecretsQoveryAPI) Delete(ctx context.Context, applicationID string, credentialsID string) error {
+	resp, err := p.client.ApplicationSecretApi.
+		DeleteApplicationSecret(ctx, applicationID, credentialsID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceApplicationSecret, credentialsID, resp, err)
+	}
+
+	return nil
+}
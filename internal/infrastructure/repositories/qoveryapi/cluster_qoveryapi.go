@@ -0,0 +1,214 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+// Ensure clusterQoveryAPI defined types fully satisfy the cluster.Repository interface.
+var _ cluster.Repository = clusterQoveryAPI{}
+
+// clusterQoveryAPI implements the interface cluster.Repository.
+type clusterQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newClusterQoveryAPI return a new instance of a cluster.Repository that uses Qovery's API.
+func newClusterQoveryAPI(client *qovery.APIClient) (cluster.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &clusterQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a cluster on an organization using the given organizationID and request.
+func (c clusterQoveryAPI) Create(ctx context.Context, organizationID string, request cluster.UpsertRepositoryRequest) (*cluster.Cluster, error) {
+	req, err := newQoveryClusterRequestFromDomain(request)
+	if err != nil {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceCluster, request.Name, nil, err)
+	}
+
+	newCluster, resp, err := c.client.ClustersApi.
+		CreateCluster(ctx, organizationID).
+		ClusterRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceCluster, request.Name, resp, err)
+	}
+
+	return newDomainClusterFromQovery(organizationID, newCluster, nil, nil, nil)
+}
+
+// Get calls Qovery's API to retrieve a cluster from an organization using the given organizationID and clusterID.
+// The returned cluster contains its credentials, routing table and advanced settings.
+func (c clusterQoveryAPI) Get(ctx context.Context, organizationID string, clusterID string) (*cluster.Cluster, error) {
+	qoveryCluster, err := c.getClusterByID(ctx, organizationID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	info, resp, err := c.client.ClustersApi.
+		GetOrganizationCloudProviderInfo(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceClusterCloudProvider, clusterID, resp, err)
+	}
+
+	routingTable, resp, err := c.client.ClustersApi.
+		GetRoutingTable(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceClusterRoutingTable, clusterID, resp, err)
+	}
+
+	advancedSettings, resp, err := c.client.ClustersApi.
+		GetClusterAdvancedSettings(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceClusterAdvancedSettings, clusterID, resp, err)
+	}
+
+	return newDomainClusterFromQovery(organizationID, qoveryCluster, info, routingTable, advancedSettings)
+}
+
+// Update calls Qovery's API to update a cluster from an organization using the given organizationID, clusterID and request.
+func (c clusterQoveryAPI) Update(ctx context.Context, organizationID string, clusterID string, request cluster.UpsertRepositoryRequest) (*cluster.Cluster, error) {
+	req, err := newQoveryClusterRequestFromDomain(request)
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceCluster, clusterID, nil, err)
+	}
+
+	updatedCluster, resp, err := c.client.ClustersApi.
+		EditCluster(ctx, organizationID, clusterID).
+		ClusterRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceCluster, clusterID, resp, err)
+	}
+
+	return newDomainClusterFromQovery(organizationID, updatedCluster, nil, nil, nil)
+}
+
+// Delete calls Qovery's API to delete a cluster from an organization using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Delete(ctx context.Context, organizationID string, clusterID string) error {
+	resp, err := c.client.ClustersApi.
+		DeleteCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceCluster, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// EditCloudProviderInfo calls Qovery's API to link a cluster to its cloud provider credentials using the given organizationID, clusterID and request.
+func (c clusterQoveryAPI) EditCloudProviderInfo(ctx context.Context, organizationID string, clusterID string, request cluster.CloudProviderInfoRequest) error {
+	req, err := newQoveryClusterCloudProviderInfoRequestFromDomain(request)
+	if err != nil {
+		return apierrors.NewUpdateApiError(apierrors.ApiResourceClusterCloudProvider, clusterID, nil, err)
+	}
+
+	_, resp, err := c.client.ClustersApi.
+		SpecifyClusterCloudProviderInfo(ctx, organizationID, clusterID).
+		ClusterCloudProviderInfoRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return apierrors.NewUpdateApiError(apierrors.ApiResourceClusterCloudProvider, clusterID, resp, err)
+	}
+
+	return nil
+}
+
+// EditRoutingTable calls Qovery's API to replace the routing table of a cluster using the given organizationID, clusterID and routingTable.
+func (c clusterQoveryAPI) EditRoutingTable(ctx context.Context, organizationID string, clusterID string, routingTable cluster.RoutingTable) (cluster.RoutingTable, error) {
+	updatedRoutingTable, resp, err := c.client.ClustersApi.
+		EditRoutingTable(ctx, organizationID, clusterID).
+		ClusterRoutingTableRequest(newQoveryClusterRoutingTableRequestFromDomain(routingTable)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceClusterRoutingTable, clusterID, resp, err)
+	}
+
+	return newDomainClusterRoutingTableFromQovery(updatedRoutingTable), nil
+}
+
+// EditAdvancedSettings calls Qovery's API to update the advanced settings of a cluster using the given organizationID, clusterID and advancedSettings.
+func (c clusterQoveryAPI) EditAdvancedSettings(ctx context.Context, organizationID string, clusterID string, advancedSettings cluster.AdvancedSettings) (cluster.AdvancedSettings, error) {
+	req, err := newQoveryClusterAdvancedSettingsFromDomain(advancedSettings)
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceClusterAdvancedSettings, clusterID, nil, err)
+	}
+
+	updatedAdvancedSettings, resp, err := c.client.ClustersApi.
+		EditClusterAdvancedSettings(ctx, organizationID, clusterID).
+		ClusterAdvancedSettings(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceClusterAdvancedSettings, clusterID, resp, err)
+	}
+
+	return newDomainClusterAdvancedSettingsFromQovery(updatedAdvancedSettings)
+}
+
+// GetStatus calls Qovery's API to retrieve the status of a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) GetStatus(ctx context.Context, organizationID string, clusterID string) (*status.Status, error) {
+	clusterStatus, resp, err := c.client.ClustersApi.
+		GetClusterStatus(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceClusterStatus, clusterID, resp, err)
+	}
+
+	return newDomainClusterStatusFromQovery(clusterID, clusterStatus.Status)
+}
+
+// Deploy calls Qovery's API to deploy a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Deploy(ctx context.Context, organizationID string, clusterID string) (*status.Status, error) {
+	clusterStatus, resp, err := c.client.ClustersApi.
+		DeployCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewDeployApiError(apierrors.ApiResourceCluster, clusterID, resp, err)
+	}
+
+	return newDomainClusterStatusFromQovery(clusterID, clusterStatus.Status)
+}
+
+// Stop calls Qovery's API to stop a cluster using the given organizationID and clusterID.
+func (c clusterQoveryAPI) Stop(ctx context.Context, organizationID string, clusterID string) (*status.Status, error) {
+	clusterStatus, resp, err := c.client.ClustersApi.
+		StopCluster(ctx, organizationID, clusterID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewStopApiError(apierrors.ApiResourceCluster, clusterID, resp, err)
+	}
+
+	return newDomainClusterStatusFromQovery(clusterID, clusterStatus.Status)
+}
+
+// getClusterByID lists the clusters of the organization to find the one with the given clusterID.
+// NOTE: the api doesn't expose a route to get a single cluster.
+func (c clusterQoveryAPI) getClusterByID(ctx context.Context, organizationID string, clusterID string) (*qovery.Cluster, error) {
+	clusters, resp, err := c.client.ClustersApi.
+		ListOrganizationCluster(ctx, organizationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceCluster, clusterID, resp, err)
+	}
+
+	for _, qoveryCluster := range clusters.Results {
+		if qoveryCluster.Id == clusterID {
+			return &qoveryCluster, nil
+		}
+	}
+
+	return nil, apierrors.NewNotFoundApiError(apierrors.ApiResourceCluster, clusterID)
+}
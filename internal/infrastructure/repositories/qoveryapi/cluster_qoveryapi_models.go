@@ -0,0 +1,225 @@
+package qoveryapi
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+// newDomainClusterFromQovery takes the types returned by the API client and turns them into the domain model cluster.Cluster.
+// The cloud provider info, routing table & advanced settings are optional since they are retrieved using distinct api calls.
+func newDomainClusterFromQovery(organizationID string, c *qovery.Cluster, info *qovery.ClusterCloudProviderInfo, routingTable *qovery.ClusterRoutingTable, advancedSettings *qovery.ClusterAdvancedSettings) (*cluster.Cluster, error) {
+	if c == nil {
+		return nil, cluster.ErrNilCluster
+	}
+
+	var credentialsID *string
+	if info != nil && info.Credentials != nil {
+		credentialsID = info.Credentials.Id
+	}
+
+	kubernetesMode := cluster.DefaultKubernetesMode.String()
+	if c.Kubernetes != nil {
+		kubernetesMode = string(*c.Kubernetes)
+	}
+
+	var state *string
+	if c.Status != nil {
+		state = pointer.ToString(string(*c.Status))
+	}
+
+	settings, err := newDomainClusterAdvancedSettingsFromQovery(advancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return cluster.NewCluster(cluster.NewClusterParams{
+		ClusterID:        c.Id,
+		OrganizationID:   organizationID,
+		CredentialsID:    credentialsID,
+		Name:             c.Name,
+		CloudProvider:    string(c.CloudProvider),
+		Region:           c.Region,
+		Description:      pointer.GetString(c.Description),
+		KubernetesMode:   kubernetesMode,
+		InstanceType:     pointer.GetString(c.InstanceType),
+		MinRunningNodes:  pointer.GetInt32(c.MinRunningNodes),
+		MaxRunningNodes:  pointer.GetInt32(c.MaxRunningNodes),
+		Features:         newDomainClusterFeaturesFromQovery(c.Features),
+		RoutingTable:     newDomainClusterRoutingTableFromQovery(routingTable),
+		AdvancedSettings: settings,
+		State:            state,
+	})
+}
+
+// newDomainClusterFeaturesFromQovery takes the qovery.ClusterFeature returned by the API client and turns them into the domain model cluster.Features.
+// The features unknown by the provider or without value are ignored.
+func newDomainClusterFeaturesFromQovery(features []qovery.ClusterFeature) cluster.Features {
+	if features == nil {
+		return nil
+	}
+
+	domainFeatures := make(cluster.Features, 0, len(features))
+	for _, f := range features {
+		featureID, err := cluster.NewFeatureIDFromString(f.GetId())
+		if err != nil {
+			continue
+		}
+
+		value := f.GetValue()
+		switch *featureID {
+		case cluster.FeatureIDVpcSubnet:
+			if value.String != nil {
+				domainFeatures = append(domainFeatures, cluster.NewVpcSubnetFeature(*value.String))
+			}
+		case cluster.FeatureIDStaticIP:
+			if value.Bool != nil {
+				domainFeatures = append(domainFeatures, cluster.NewStaticIPFeature(*value.Bool))
+			}
+		}
+	}
+
+	return domainFeatures
+}
+
+// newDomainClusterRoutingTableFromQovery takes a qovery.ClusterRoutingTable returned by the API client and turns it into the domain model cluster.RoutingTable.
+func newDomainClusterRoutingTableFromQovery(routingTable *qovery.ClusterRoutingTable) cluster.RoutingTable {
+	if routingTable == nil {
+		return nil
+	}
+
+	routes := make(cluster.RoutingTable, 0, len(routingTable.GetResults()))
+	for _, r := range routingTable.GetResults() {
+		routes = append(routes, cluster.Route{
+			Description: r.GetDescription(),
+			Destination: r.GetDestination(),
+			Target:      r.GetTarget(),
+		})
+	}
+
+	return routes
+}
+
+// newDomainClusterAdvancedSettingsFromQovery takes a qovery.ClusterAdvancedSettings returned by the API client and turns it into the domain model cluster.AdvancedSettings.
+func newDomainClusterAdvancedSettingsFromQovery(advancedSettings *qovery.ClusterAdvancedSettings) (cluster.AdvancedSettings, error) {
+	if advancedSettings == nil {
+		return nil, nil
+	}
+
+	// NOTE: the api omits the registry tags when there are none.
+	if advancedSettings.CloudProviderContainerRegistryTags == nil {
+		advancedSettings.CloudProviderContainerRegistryTags = &map[string]string{}
+	}
+
+	settings, err := newDomainAdvancedSettingsFromQovery(advancedSettings)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidAdvancedSettings.Error())
+	}
+
+	return cluster.AdvancedSettings(settings), nil
+}
+
+// newDomainClusterStatusFromQovery takes the state returned by the API client for the given cluster and turns it into the domain model status.Status.
+func newDomainClusterStatusFromQovery(clusterID string, state *qovery.StateEnum) (*status.Status, error) {
+	if state == nil {
+		return nil, status.ErrNilStatus
+	}
+
+	return status.NewStatus(status.NewStatusParams{
+		StatusID: clusterID,
+		State:    string(*state),
+	})
+}
+
+// newQoveryClusterRequestFromDomain takes the domain request cluster.UpsertRepositoryRequest and turns it into a qovery.ClusterRequest to make the api call.
+func newQoveryClusterRequestFromDomain(request cluster.UpsertRepositoryRequest) (*qovery.ClusterRequest, error) {
+	cloudProvider, err := cluster.NewCloudProviderFromString(request.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	kubernetesMode, err := qovery.NewKubernetesEnumFromValue(request.KubernetesMode)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.ClusterRequest{
+		Name:            request.Name,
+		CloudProvider:   qovery.CloudProviderEnum(cloudProvider.String()),
+		Region:          request.Region,
+		Description:     request.Description,
+		Kubernetes:      kubernetesMode,
+		InstanceType:    request.InstanceType,
+		MinRunningNodes: request.MinRunningNodes,
+		MaxRunningNodes: request.MaxRunningNodes,
+		Features:        newQoveryClusterFeaturesFromDomain(request.EnabledFeatures()),
+	}, nil
+}
+
+// newQoveryClusterFeaturesFromDomain takes the domain model cluster.Features and turns it into the features of a qovery.ClusterRequest.
+func newQoveryClusterFeaturesFromDomain(features cluster.Features) []qovery.ClusterRequestFeaturesInner {
+	if len(features) == 0 {
+		return nil
+	}
+
+	qoveryFeatures := make([]qovery.ClusterRequestFeaturesInner, 0, len(features))
+	for _, f := range features {
+		qoveryFeatures = append(qoveryFeatures, qovery.ClusterRequestFeaturesInner{
+			Id: pointer.ToString(f.ID.String()),
+			Value: *qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{
+				String: f.StringValue,
+				Bool:   f.BoolValue,
+			}),
+		})
+	}
+
+	return qoveryFeatures
+}
+
+// newQoveryClusterCloudProviderInfoRequestFromDomain takes the domain request cluster.CloudProviderInfoRequest and turns it into a qovery.ClusterCloudProviderInfoRequest to make the api call.
+func newQoveryClusterCloudProviderInfoRequestFromDomain(request cluster.CloudProviderInfoRequest) (*qovery.ClusterCloudProviderInfoRequest, error) {
+	cloudProvider, err := cluster.NewCloudProviderFromString(request.CloudProvider)
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.ClusterCloudProviderInfoRequest{
+		CloudProvider: qovery.CloudProviderEnum(cloudProvider.String()).Ptr(),
+		Region:        pointer.ToString(request.Region),
+		Credentials: &qovery.ClusterCloudProviderInfoCredentials{
+			Id:   pointer.ToString(request.CredentialsID),
+			Name: pointer.ToString(request.CredentialsName),
+		},
+	}, nil
+}
+
+// newQoveryClusterRoutingTableRequestFromDomain takes the domain model cluster.RoutingTable and turns it into a qovery.ClusterRoutingTableRequest to make the api call.
+func newQoveryClusterRoutingTableRequestFromDomain(routingTable cluster.RoutingTable) qovery.ClusterRoutingTableRequest {
+	routes := make([]qovery.ClusterRoutingTableRequestRoutesInner, 0, len(routingTable))
+	for _, r := range routingTable {
+		routes = append(routes, qovery.ClusterRoutingTableRequestRoutesInner{
+			Description: r.Description,
+			Destination: r.Destination,
+			Target:      r.Target,
+		})
+	}
+
+	return qovery.ClusterRoutingTableRequest{
+		Routes: routes,
+	}
+}
+
+// newQoveryClusterAdvancedSettingsFromDomain takes the domain model cluster.AdvancedSettings and turns it into a qovery.ClusterAdvancedSettings to make the api call.
+// The settings that are unknown to qovery.ClusterAdvancedSettings are rejected instead of being silently dropped.
+func newQoveryClusterAdvancedSettingsFromDomain(advancedSettings cluster.AdvancedSettings) (*qovery.ClusterAdvancedSettings, error) {
+	settings, err := newQoveryAdvancedSettingsFromDomain[qovery.ClusterAdvancedSettings](advancedsettings.AdvancedSettings(advancedSettings))
+	if err != nil {
+		return nil, errors.Wrap(err, cluster.ErrInvalidAdvancedSettings.Error())
+	}
+
+	return settings, nil
+}
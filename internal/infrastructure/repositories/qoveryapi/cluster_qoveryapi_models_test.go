@@ -0,0 +1,246 @@
+package qoveryapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+)
+
+func TestNewDomainClusterFromQovery(t *testing.T) {
+	t.Parallel()
+
+	k3s := qovery.KUBERNETESENUM_K3_S
+	deployed := qovery.STATEENUM_DEPLOYED
+
+	testCases := []struct {
+		TestName         string
+		Cluster          *qovery.Cluster
+		Info             *qovery.ClusterCloudProviderInfo
+		RoutingTable     *qovery.ClusterRoutingTable
+		AdvancedSettings *qovery.ClusterAdvancedSettings
+		ExpectedError    error
+	}{
+		{
+			TestName:      "fail_with_nil_cluster",
+			ExpectedError: cluster.ErrNilCluster,
+		},
+		{
+			TestName: "success_without_extras",
+			Cluster: &qovery.Cluster{
+				Id:            gofakeit.UUID(),
+				Name:          gofakeit.Name(),
+				CloudProvider: qovery.CLOUDPROVIDERENUM_SCW,
+				Region:        "fr-par",
+			},
+		},
+		{
+			TestName: "success_with_extras",
+			Cluster: &qovery.Cluster{
+				Id:              gofakeit.UUID(),
+				Name:            gofakeit.Name(),
+				Description:     pointer.ToString(gofakeit.Word()),
+				CloudProvider:   qovery.CLOUDPROVIDERENUM_AWS,
+				Region:          "eu-west-3",
+				Kubernetes:      &k3s,
+				InstanceType:    pointer.ToString("t3a.large"),
+				MinRunningNodes: pointer.ToInt32(1),
+				MaxRunningNodes: pointer.ToInt32(1),
+				Status:          &deployed,
+				Features: []qovery.ClusterFeature{
+					{Id: pointer.ToString("VPC_SUBNET"), Value: *qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{String: pointer.ToString("10.0.0.0/16")})},
+					{Id: pointer.ToString("STATIC_IP"), Value: *qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{Bool: pointer.ToBool(true)})},
+					{Id: pointer.ToString("UNKNOWN"), Value: *qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{Bool: pointer.ToBool(true)})},
+				},
+			},
+			Info: &qovery.ClusterCloudProviderInfo{
+				Credentials: &qovery.ClusterCloudProviderInfoCredentials{
+					Id:   pointer.ToString(gofakeit.UUID()),
+					Name: pointer.ToString(gofakeit.Name()),
+				},
+			},
+			RoutingTable: &qovery.ClusterRoutingTable{
+				Results: []qovery.ClusterRoutingTableResultsInner{
+					{Description: pointer.ToString("peering"), Destination: pointer.ToString("10.0.0.0/20"), Target: pointer.ToString("pcx-1")},
+				},
+			},
+			AdvancedSettings: &qovery.ClusterAdvancedSettings{
+				LokiLogRetentionInWeek: pointer.ToInt32(12),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			c, err := newDomainClusterFromQovery(gofakeit.UUID(), tc.Cluster, tc.Info, tc.RoutingTable, tc.AdvancedSettings)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, c)
+			assert.True(t, c.IsValid())
+			assert.Equal(t, tc.Cluster.Id, c.ID.String())
+			assert.Equal(t, tc.Cluster.Name, c.Name)
+			assert.Equal(t, string(tc.Cluster.CloudProvider), c.CloudProvider.String())
+			assert.Equal(t, tc.Cluster.Region, c.Region)
+			assert.Equal(t, pointer.GetString(tc.Cluster.Description), c.Description)
+			assert.Equal(t, pointer.GetString(tc.Cluster.InstanceType), c.InstanceType)
+			assert.Equal(t, pointer.GetInt32(tc.Cluster.MinRunningNodes), c.MinRunningNodes)
+			assert.Equal(t, pointer.GetInt32(tc.Cluster.MaxRunningNodes), c.MaxRunningNodes)
+
+			if tc.Cluster.Kubernetes == nil {
+				assert.Equal(t, cluster.DefaultKubernetesMode, c.KubernetesMode)
+			} else {
+				assert.Equal(t, string(*tc.Cluster.Kubernetes), c.KubernetesMode.String())
+			}
+
+			if tc.Cluster.Status != nil {
+				assert.Equal(t, string(*tc.Cluster.Status), c.State.String())
+			}
+
+			if tc.Info != nil {
+				assert.Equal(t, *tc.Info.Credentials.Id, c.CredentialsID.String())
+			} else {
+				assert.Equal(t, uuid.Nil, c.CredentialsID)
+			}
+
+			if tc.Cluster.Features != nil {
+				assert.Len(t, c.Features, 2)
+				assert.Equal(t, pointer.ToString("10.0.0.0/16"), c.Features.VpcSubnet())
+				assert.Equal(t, pointer.ToBool(true), c.Features.StaticIP())
+			}
+
+			if tc.RoutingTable != nil {
+				assert.Equal(t, cluster.RoutingTable{{Description: "peering", Destination: "10.0.0.0/20", Target: "pcx-1"}}, c.RoutingTable)
+			}
+
+			if tc.AdvancedSettings != nil {
+				assert.Equal(t, float64(12), c.AdvancedSettings["loki.log_retention_in_week"])
+				assert.Equal(t, map[string]interface{}{}, c.AdvancedSettings["cloud_provider.container_registry.tags"])
+			}
+		})
+	}
+}
+
+// TestClusterResponseList_GCP ensures the clusters running on GCP are decoded by the api client.
+func TestClusterResponseList_GCP(t *testing.T) {
+	t.Parallel()
+
+	clusterID := gofakeit.UUID()
+	body := fmt.Sprintf(`{"results": [{"id": %q, "name": "gcp", "cloud_provider": "GCP", "region": "europe-west9", "kubernetes": "MANAGED"}]}`, clusterID)
+
+	var clusters qovery.ClusterResponseList
+	require.NoError(t, json.Unmarshal([]byte(body), &clusters))
+	require.Len(t, clusters.Results, 1)
+
+	c, err := newDomainClusterFromQovery(gofakeit.UUID(), &clusters.Results[0], nil, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, clusterID, c.ID.String())
+	assert.Equal(t, cluster.CloudProviderGCP, c.CloudProvider)
+	assert.Equal(t, "europe-west9", c.Region)
+	assert.Equal(t, cluster.KubernetesModeManaged, c.KubernetesMode)
+}
+
+func TestNewQoveryClusterRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName         string
+		Request          cluster.UpsertRepositoryRequest
+		ExpectedFeatures int
+	}{
+		{
+			TestName: "managed_cluster_with_features",
+			Request: cluster.UpsertRepositoryRequest{
+				Name:            gofakeit.Name(),
+				CloudProvider:   cluster.CloudProviderAWS.String(),
+				Region:          "eu-west-3",
+				KubernetesMode:  cluster.KubernetesModeManaged.String(),
+				InstanceType:    pointer.ToString("t3a.large"),
+				MinRunningNodes: pointer.ToInt32(3),
+				MaxRunningNodes: pointer.ToInt32(10),
+				Features: cluster.Features{
+					cluster.NewVpcSubnetFeature("10.0.0.0/16"),
+					cluster.NewStaticIPFeature(true),
+				},
+			},
+			ExpectedFeatures: 2,
+		},
+		{
+			TestName: "k3s_cluster_ignores_features",
+			Request: cluster.UpsertRepositoryRequest{
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderAWS.String(),
+				Region:         "eu-west-3",
+				KubernetesMode: cluster.KubernetesModeK3S.String(),
+				Features:       cluster.Features{cluster.NewStaticIPFeature(true)},
+			},
+			ExpectedFeatures: 0,
+		},
+		{
+			TestName: "gcp_cluster",
+			Request: cluster.UpsertRepositoryRequest{
+				Name:           gofakeit.Name(),
+				CloudProvider:  cluster.CloudProviderGCP.String(),
+				Region:         "europe-west9",
+				KubernetesMode: cluster.KubernetesModeManaged.String(),
+			},
+			ExpectedFeatures: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req, err := newQoveryClusterRequestFromDomain(tc.Request)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.CloudProvider, string(req.CloudProvider))
+			assert.Equal(t, tc.Request.Region, req.Region)
+			assert.Equal(t, tc.Request.KubernetesMode, string(req.GetKubernetes()))
+			assert.Equal(t, tc.Request.InstanceType, req.InstanceType)
+			assert.Equal(t, tc.Request.MinRunningNodes, req.MinRunningNodes)
+			assert.Equal(t, tc.Request.MaxRunningNodes, req.MaxRunningNodes)
+			assert.Len(t, req.Features, tc.ExpectedFeatures)
+			for _, f := range req.Features {
+				expected := tc.Request.Features.Get(cluster.FeatureID(f.GetId()))
+				require.NotNil(t, expected)
+				assert.Equal(t, expected.StringValue, f.Value.Get().String)
+				assert.Equal(t, expected.BoolValue, f.Value.Get().Bool)
+			}
+		})
+	}
+}
+
+func TestNewQoveryClusterAdvancedSettingsFromDomain(t *testing.T) {
+	t.Parallel()
+
+	settings, err := newQoveryClusterAdvancedSettingsFromDomain(cluster.AdvancedSettings{
+		"loki.log_retention_in_week":             12,
+		"aws.vpc.enable_s3_flow_logs":            true,
+		"cloud_provider.container_registry.tags": map[string]string{"team": "core"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, pointer.ToInt32(12), settings.LokiLogRetentionInWeek)
+	assert.Equal(t, pointer.ToBool(true), settings.AwsVpcEnableS3FlowLogs)
+	assert.Equal(t, &map[string]string{"team": "core"}, settings.CloudProviderContainerRegistryTags)
+
+	_, err = newQoveryClusterAdvancedSettingsFromDomain(cluster.AdvancedSettings{"loki.log_retention_in_week": "invalid"})
+	assert.ErrorContains(t, err, cluster.ErrInvalidAdvancedSettings.Error())
+
+	_, err = newQoveryClusterAdvancedSettingsFromDomain(cluster.AdvancedSettings{"loki.log_retention_in_weeks": 12})
+	assert.ErrorContains(t, err, cluster.ErrInvalidAdvancedSettings.Error())
+}
@@ -0,0 +1,78 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+// Ensure containerCustomDomainsQoveryAPI defined types fully satisfy the customdomain.Repository interface.
+var _ customdomain.Repository = containerCustomDomainsQoveryAPI{}
+
+// containerCustomDomainsQoveryAPI implements the interface customdomain.Repository.
+type containerCustomDomainsQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newContainerCustomDomainsQoveryAPI return a new instance of a customdomain.Repository that uses Qovery's API.
+func newContainerCustomDomainsQoveryAPI(client *qovery.APIClient) (customdomain.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &containerCustomDomainsQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a custom domain for a container using the given containerID and request.
+func (p containerCustomDomainsQoveryAPI) Create(ctx context.Context, containerID string, request customdomain.UpsertRequest) (*customdomain.CustomDomain, error) {
+	d, resp, err := p.client.ContainerCustomDomainApi.
+		CreateContainerCustomDomain(ctx, containerID).
+		CustomDomainRequest(newQoveryCustomDomainRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainerCustomDomain, request.Domain, resp, err)
+	}
+
+	return newDomainCustomDomainFromQovery(d)
+}
+
+// Get calls Qovery's API to retrieve a custom domain of a container using the given containerID and customDomainID.
+func (p containerCustomDomainsQoveryAPI) Get(ctx context.Context, containerID string, customDomainID string) (*customdomain.CustomDomain, error) {
+	d, resp, err := p.client.ContainerCustomDomainApi.
+		GetContainerCustomDomainStatus(ctx, containerID, customDomainID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceContainerCustomDomain, customDomainID, resp, err)
+	}
+
+	return newDomainCustomDomainFromQovery(d)
+}
+
+// List calls Qovery's API to retrieve the custom domains of a container using the given containerID.
+func (p containerCustomDomainsQoveryAPI) List(ctx context.Context, containerID string) (customdomain.CustomDomains, error) {
+	list, resp, err := p.client.ContainerCustomDomainApi.
+		ListContainerCustomDomain(ctx, containerID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceContainerCustomDomain, containerID, resp, err)
+	}
+
+	return newDomainCustomDomainsFromQovery(list)
+}
+
+// Delete calls Qovery's API to delete a custom domain from a container using the given containerID and customDomainID.
+func (p containerCustomDomainsQoveryAPI) Delete(ctx context.Context, containerID string, customDomainID string) error {
+	resp, err := p.client.ContainerCustomDomainApi.
+		DeleteContainerCustomDomain(ctx, containerID, customDomainID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceContainerCustomDomain, customDomainID, resp, err)
+	}
+
+	return nil
+}
@@ -41,6 +41,18 @@ func (c containerDeploymentQoveryAPI) GetStatus(ctx context.Context, containerID
 	return newDomainStatusFromQovery(containerStatus)
 }
 
+// GetEnvironmentID calls Qovery's API to get the id of the environment of a container using the given containerID.
+func (c containerDeploymentQoveryAPI) GetEnvironmentID(ctx context.Context, containerID string) (string, error) {
+	qoveryContainer, resp, err := c.client.ContainerMainCallsApi.
+		GetContainer(ctx, containerID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return "", apierrors.NewReadApiError(apierrors.ApiResourceContainer, containerID, resp, err)
+	}
+
+	return qoveryContainer.Environment.Id, nil
+}
+
 // Deploy calls Qovery's API to deploy a container using the given containerID.
 func (c containerDeploymentQoveryAPI) Deploy(ctx context.Context, containerID string, imageTag string) (*status.Status, error) {
 	containerStatus, resp, err := c.client.ContainerActionsApi.
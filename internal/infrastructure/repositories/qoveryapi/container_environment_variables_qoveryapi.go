@@ -41,6 +41,32 @@ func (p containerEnvironmentVariablesQoveryAPI) Create(ctx context.Context, cont
 	return newDomainVariableFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the environment variable with the given aliasedVariableID for a container using the given containerID.
+func (p containerEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, containerID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	v, resp, err := p.client.ContainerEnvironmentVariableApi.
+		CreateContainerEnvironmentVariableAlias(ctx, containerID, aliasedVariableID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainerEnvironmentVariable, key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the environment variable with the given overriddenVariableID for a container using the given containerID.
+func (p containerEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, containerID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	v, resp, err := p.client.ContainerEnvironmentVariableApi.
+		CreateContainerEnvironmentVariableOverride(ctx, containerID, overriddenVariableID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainerEnvironmentVariable, overriddenVariableID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment variables from a container using the given containerID and variableID.
 func (p containerEnvironmentVariablesQoveryAPI) List(ctx context.Context, containerID string) (variable.Variables, error) {
 	vars, resp, err := p.client.ContainerEnvironmentVariableApi.
@@ -6,6 +6,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 )
@@ -58,7 +59,12 @@ func (c containerQoveryAPI) Create(ctx context.Context, environmentID string, re
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainer, newContainer.Id, resp, err)
 	}
 
-	return newDomainContainerFromQovery(newContainer, deploymentStage.Id)
+	advancedSettings, err := c.editAdvancedSettings(ctx, newContainer.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainContainerFromQovery(newContainer, deploymentStage.Id, advancedSettings)
 }
 
 // Get calls Qovery's API to retrieve a container using the given containerID.
@@ -76,7 +82,12 @@ func (c containerQoveryAPI) Get(ctx context.Context, containerID string) (*conta
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainer, container.Id, resp, err)
 	}
 
-	return newDomainContainerFromQovery(container, deploymentStage.Id)
+	advancedSettings, err := c.getAdvancedSettings(ctx, container.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainContainerFromQovery(container, deploymentStage.Id, advancedSettings)
 }
 
 // Update calls Qovery's API to update a container using the given containerID and request.
@@ -108,7 +119,12 @@ func (c containerQoveryAPI) Update(ctx context.Context, containerID string, requ
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainer, container.Id, resp, err)
 	}
 
-	return newDomainContainerFromQovery(container, deploymentStage.Id)
+	advancedSettings, err := c.editAdvancedSettings(ctx, container.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainContainerFromQovery(container, deploymentStage.Id, advancedSettings)
 }
 
 // Delete calls Qovery's API to deletes a container using the given containerID.
@@ -117,7 +133,7 @@ func (c containerQoveryAPI) Delete(ctx context.Context, containerID string) erro
 		GetContainer(ctx, containerID).
 		Execute()
 	if err != nil || resp.StatusCode >= 400 {
-		if resp.StatusCode == 404 {
+		if resp != nil && resp.StatusCode == 404 {
 			// if the container is not found, then it has already been deleted
 			return nil
 		}
@@ -133,3 +149,43 @@ func (c containerQoveryAPI) Delete(ctx context.Context, containerID string) erro
 
 	return nil
 }
+
+// getAdvancedSettings calls Qovery's API to retrieve the advanced settings of a container using the given containerID.
+func (c containerQoveryAPI) getAdvancedSettings(ctx context.Context, containerID string) (advancedsettings.AdvancedSettings, error) {
+	advancedSettings, resp, err := c.client.ContainerConfigurationApi.
+		GetContainerAdvancedSettings(ctx, containerID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceContainerAdvancedSettings, containerID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(advancedSettings)
+}
+
+// editAdvancedSettings calls Qovery's API to update the advanced settings of a container using the given containerID and advancedSettings.
+// The api is only called if the advancedSettings differ from the current ones, the settings that are not part of advancedSettings are kept.
+func (c containerQoveryAPI) editAdvancedSettings(ctx context.Context, containerID string, advancedSettings advancedsettings.AdvancedSettings) (advancedsettings.AdvancedSettings, error) {
+	currentAdvancedSettings, err := c.getAdvancedSettings(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !advancedSettings.HasChanges(currentAdvancedSettings) {
+		return currentAdvancedSettings, nil
+	}
+
+	req, err := newQoveryAdvancedSettingsFromDomain[qovery.ContainerAdvancedSettings](advancedSettings.Merge(currentAdvancedSettings))
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceContainerAdvancedSettings, containerID, nil, err)
+	}
+
+	updatedAdvancedSettings, resp, err := c.client.ContainerConfigurationApi.
+		EditContainerAdvancedSettings(ctx, containerID).
+		ContainerAdvancedSettings(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceContainerAdvancedSettings, containerID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(updatedAdvancedSettings)
+}
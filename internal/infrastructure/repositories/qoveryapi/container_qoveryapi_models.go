@@ -4,6 +4,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
@@ -11,7 +12,7 @@ import (
 )
 
 // newDomainCredentialsFromQovery takes a qovery.EnvironmentVariable returned by the API client and turns it into the domain model variable.Variable.
-func newDomainContainerFromQovery(c *qovery.ContainerResponse, deploymentStageID string) (*container.Container, error) {
+func newDomainContainerFromQovery(c *qovery.ContainerResponse, deploymentStageID string, advancedSettings advancedsettings.AdvancedSettings) (*container.Container, error) {
 	if c == nil {
 		return nil, variable.ErrNilVariable
 	}
@@ -42,6 +43,8 @@ func newDomainContainerFromQovery(c *qovery.ContainerResponse, deploymentStageID
 		Arguments:           c.Arguments,
 		Ports:               ports,
 		Storages:            storages,
+		Healthchecks:        newDomainHealthchecksFromQovery(c.Healthchecks),
+		AdvancedSettings:    advancedSettings,
 		DeploymentStageID:   deploymentStageID,
 	})
 }
@@ -72,5 +75,6 @@ func newQoveryContainerRequestFromDomain(request container.UpsertRepositoryReque
 		Arguments:           request.Arguments,
 		Storage:             storages,
 		Ports:               ports,
+		Healthchecks:        newQoveryHealthchecksFromDomain(request.Healthchecks),
 	}, nil
 }
@@ -2,6 +2,9 @@ package qoveryapi
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
 
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
@@ -89,3 +92,16 @@ func (c containerRegistryQoveryAPI) Delete(ctx context.Context, organizationID s
 
 	return nil
 }
+
+// CheckCredentials calls Qovery's API to list the images of a registry using the given registryID.
+// The api has to authenticate to the registry with the credentials of its config to list the images, so the call fails if they are wrong.
+// NOTE: the route listing the images of a registry is not part of the api client yet, so it is called using callRawAPI.
+func (c containerRegistryQoveryAPI) CheckCredentials(ctx context.Context, organizationID string, registryID string) error {
+	path := fmt.Sprintf("/organization/%s/containerRegistry/%s/images", url.PathEscape(organizationID), url.PathEscape(registryID))
+	resp, err := callRawAPI(ctx, c.client, http.MethodGet, path, nil, nil)
+	if err != nil {
+		return apierrors.NewReadApiError(apierrors.ApiResourceContainerRegistry, registryID, resp, err)
+	}
+
+	return nil
+}
@@ -36,13 +36,16 @@ func newQoveryContainerRegistryRequestFromDomain(request registry.UpsertRequest)
 		Url:         pointer.ToString(request.URL),
 		Description: request.Description,
 		Config: qovery.ContainerRegistryRequestConfig{
-			AccessKeyId:       request.Config.AccessKeyID,
-			SecretAccessKey:   request.Config.SecretAccessKey,
-			Region:            request.Config.Region,
-			ScalewayAccessKey: request.Config.ScalewayAccessKey,
-			ScalewaySecretKey: request.Config.ScalewaySecretKey,
-			Username:          request.Config.Username,
-			Password:          request.Config.Password,
+			AccessKeyId:         request.Config.AccessKeyID,
+			SecretAccessKey:     request.Config.SecretAccessKey,
+			Region:              request.Config.Region,
+			ScalewayAccessKey:   request.Config.ScalewayAccessKey,
+			ScalewaySecretKey:   request.Config.ScalewaySecretKey,
+			Username:            request.Config.Username,
+			Password:            request.Config.Password,
+			JsonCredentials:     request.Config.JSONCredentials,
+			AzureTenantId:       request.Config.AzureTenantID,
+			AzureSubscriptionId: request.Config.AzureSubscriptionID,
 		},
 	}, nil
 }
@@ -35,6 +35,15 @@ func TestNewDomainRegistryFromQovery(t *testing.T) {
 				Description: pointer.ToString(gofakeit.Name()),
 			},
 		},
+		{
+			TestName: "success_with_github_cr",
+			Registry: &qovery.ContainerRegistryResponse{
+				Id:   gofakeit.UUID(),
+				Name: pointer.ToString(gofakeit.Name()),
+				Kind: qovery.CONTAINERREGISTRYKINDENUM_GITHUB_CR.Ptr(),
+				Url:  pointer.ToString(gofakeit.URL()),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -85,6 +94,18 @@ func TestNewQoveryRegistryEditRequestFromDomain(t *testing.T) {
 				Description: pointer.ToString(gofakeit.Word()),
 			},
 		},
+		{
+			TestName: "success_with_azure_cr",
+			Request: registry.UpsertRequest{
+				Name: gofakeit.Name(),
+				Kind: registry.KindAzureCR.String(),
+				URL:  gofakeit.URL(),
+				Config: registry.UpsertRequestConfig{
+					AzureTenantID:       pointer.ToString(gofakeit.UUID()),
+					AzureSubscriptionID: pointer.ToString(gofakeit.UUID()),
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -97,6 +118,8 @@ func TestNewQoveryRegistryEditRequestFromDomain(t *testing.T) {
 			assert.Equal(t, tc.Request.Kind, string(req.Kind))
 			assert.Equal(t, tc.Request.URL, *req.Url)
 			assert.Equal(t, tc.Request.Description, req.Description)
+			assert.Equal(t, tc.Request.Config.AzureTenantID, req.Config.AzureTenantId)
+			assert.Equal(t, tc.Request.Config.AzureSubscriptionID, req.Config.AzureSubscriptionId)
 		})
 	}
 }
@@ -41,6 +41,32 @@ func (p containerSecretsQoveryAPI) Create(ctx context.Context, containerID strin
 	return newDomainSecretFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the secret with the given aliasedSecretID for a container using the given containerID.
+func (p containerSecretsQoveryAPI) CreateAlias(ctx context.Context, containerID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	v, resp, err := p.client.ContainerSecretApi.
+		CreateContainerSecretAlias(ctx, containerID, aliasedSecretID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainerSecret, key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the secret with the given overriddenSecretID for a container using the given containerID.
+func (p containerSecretsQoveryAPI) CreateOverride(ctx context.Context, containerID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	v, resp, err := p.client.ContainerSecretApi.
+		CreateContainerSecretOverride(ctx, containerID, overriddenSecretID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceContainerSecret, overriddenSecretID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment secrets from a container using the given containerID and secretID.
 func (p containerSecretsQoveryAPI) List(ctx context.Context, containerID string) (secret.Secrets, error) {
 	vars, resp, err := p.client.ContainerSecretApi.
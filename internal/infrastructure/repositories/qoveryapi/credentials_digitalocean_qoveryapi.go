@@ -0,0 +1,80 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// credentialsDigitalOceanQoveryAPI implements the interface credentials.DigitalOceanRepository.
+type credentialsDigitalOceanQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// NOTE: This forces the implementation of the interface credentials.DigitalOceanRepository  by credentialsDigitalOceanQoveryAPI at compile time.
+var _ credentials.DigitalOceanRepository = credentialsDigitalOceanQoveryAPI{}
+
+// newCredentialsDigitalOceanQoveryAPI return a new instance of a credentials.DigitalOceanRepository that uses Qovery's API.
+func newCredentialsDigitalOceanQoveryAPI(client *qovery.APIClient) (credentials.DigitalOceanRepository, error) {
+	if client == nil {
+		return nil, common.ErrInvalidQoveryClient
+	}
+
+	return &credentialsDigitalOceanQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a digitalocean cluster credentials on an organization using the given organizationID and request.
+func (c credentialsDigitalOceanQoveryAPI) Create(ctx context.Context, organizationID string, request credentials.UpsertDigitalOceanRequest) (*credentials.Credentials, error) {
+	creds, resp, err := c.client.CloudProviderCredentialsApi.
+		CreateDOCredentials(ctx, organizationID).
+		DoCredentialsRequest(newQoveryDigitalOceanCredentialsRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceDigitalOceanCredentials, request.Name, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, creds)
+}
+
+// Get calls Qovery's API to retrieve an digitalocean cluster credentials from an organization using the given organizationID and credentialsID.
+func (c credentialsDigitalOceanQoveryAPI) Get(ctx context.Context, organizationID string, credentialsID string) (*credentials.Credentials, error) {
+	creds, resp, err := c.client.CloudProviderCredentialsApi.
+		GetDOCredentials(ctx, organizationID, credentialsID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceDigitalOceanCredentials, credentialsID, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, creds)
+}
+
+// Update calls Qovery's API to update a digitalocean cluster credentials from an organization using the given organizationID, credentialsID and request.
+func (c credentialsDigitalOceanQoveryAPI) Update(ctx context.Context, organizationID string, credentialsID string, request credentials.UpsertDigitalOceanRequest) (*credentials.Credentials, error) {
+	creds, resp, err := c.client.CloudProviderCredentialsApi.
+		EditDOCredentials(ctx, organizationID, credentialsID).
+		DoCredentialsRequest(newQoveryDigitalOceanCredentialsRequestFromDomain(request)).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceDigitalOceanCredentials, credentialsID, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, creds)
+}
+
+// Delete calls Qovery's API to delete a digitalocean cluster credentials from an organization using the given organizationID and credentialsID.
+func (c credentialsDigitalOceanQoveryAPI) Delete(ctx context.Context, organizationID string, credentialsID string) error {
+	resp, err := c.client.CloudProviderCredentialsApi.
+		DeleteDOCredentials(ctx, credentialsID, organizationID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceDigitalOceanCredentials, credentialsID, resp, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package qoveryapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/common"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// credentialsGcpQoveryAPI implements the interface credentials.GcpRepository.
+// NOTE: the gcp credentials endpoints are not part of the api client yet, so they are called using callRawAPI.
+type credentialsGcpQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// NOTE: This forces the implementation of the interface credentials.GcpRepository by credentialsGcpQoveryAPI at compile time.
+var _ credentials.GcpRepository = credentialsGcpQoveryAPI{}
+
+// newCredentialsGcpQoveryAPI return a new instance of a credentials.GcpRepository that uses Qovery's API.
+func newCredentialsGcpQoveryAPI(client *qovery.APIClient) (credentials.GcpRepository, error) {
+	if client == nil {
+		return nil, common.ErrInvalidQoveryClient
+	}
+
+	return &credentialsGcpQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a gcp cluster credentials on an organization using the given organizationID and request.
+func (c credentialsGcpQoveryAPI) Create(ctx context.Context, organizationID string, request credentials.UpsertGcpRequest) (*credentials.Credentials, error) {
+	var creds qovery.ClusterCredentials
+	resp, err := callRawAPI(ctx, c.client, http.MethodPost, credentialsPath("gcp", organizationID), newQoveryGcpCredentialsRequestFromDomain(request), &creds)
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceGcpCredentials, request.Name, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, &creds)
+}
+
+// Get calls Qovery's API to retrieve a gcp cluster credentials from an organization using the given organizationID and credentialsID.
+func (c credentialsGcpQoveryAPI) Get(ctx context.Context, organizationID string, credentialsID string) (*credentials.Credentials, error) {
+	var creds qovery.ClusterCredentials
+	resp, err := callRawAPI(ctx, c.client, http.MethodGet, credentialsPath("gcp", organizationID, credentialsID), nil, &creds)
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceGcpCredentials, credentialsID, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, &creds)
+}
+
+// Update calls Qovery's API to update a gcp cluster credentials from an organization using the given organizationID, credentialsID and request.
+func (c credentialsGcpQoveryAPI) Update(ctx context.Context, organizationID string, credentialsID string, request credentials.UpsertGcpRequest) (*credentials.Credentials, error) {
+	var creds qovery.ClusterCredentials
+	resp, err := callRawAPI(ctx, c.client, http.MethodPut, credentialsPath("gcp", organizationID, credentialsID), newQoveryGcpCredentialsRequestFromDomain(request), &creds)
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceGcpCredentials, credentialsID, resp, err)
+	}
+
+	return newDomainCredentialsFromQovery(organizationID, &creds)
+}
+
+// Delete calls Qovery's API to delete a gcp cluster credentials from an organization using the given organizationID and credentialsID.
+func (c credentialsGcpQoveryAPI) Delete(ctx context.Context, organizationID string, credentialsID string) error {
+	resp, err := callRawAPI(ctx, c.client, http.MethodDelete, credentialsPath("gcp", organizationID, credentialsID), nil, nil)
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceGcpCredentials, credentialsID, resp, err)
+	}
+
+	return nil
+}
@@ -20,12 +20,21 @@ func newDomainCredentialsFromQovery(organizationID string, creds *qovery.Cluster
 }
 
 // newQoveryAwsCredentialsRequestFromDomain takes the domain request credentials.UpsertAwsRequest and turns it into a qovery.AwsCredentialsRequest to make the api call.
+// The credentials are either static keys or a role to assume.
 func newQoveryAwsCredentialsRequestFromDomain(request credentials.UpsertAwsRequest) qovery.AwsCredentialsRequest {
-	return qovery.AwsCredentialsRequest{
+	if request.IsRoleBased() {
+		return qovery.AwsRoleCredentialsRequestAsAwsCredentialsRequest(&qovery.AwsRoleCredentialsRequest{
+			Name:       request.Name,
+			RoleArn:    request.RoleArn,
+			ExternalId: request.ExternalID,
+		})
+	}
+
+	return qovery.AwsStaticCredentialsRequestAsAwsCredentialsRequest(&qovery.AwsStaticCredentialsRequest{
 		Name:            request.Name,
 		AccessKeyId:     request.AccessKeyID,
 		SecretAccessKey: request.SecretAccessKey,
-	}
+	})
 }
 
 // newQoveryScalewayCredentialsRequestFromDomain takes the domain request credentials.UpsertScalewayRequest and turns it into a qovery.ScalewayCredentialsRequest to make the api call.
@@ -37,3 +46,28 @@ func newQoveryScalewayCredentialsRequestFromDomain(request credentials.UpsertSca
 		ScalewaySecretKey: &request.ScalewaySecretKey,
 	}
 }
+
+// newQoveryDigitalOceanCredentialsRequestFromDomain takes the domain request credentials.UpsertDigitalOceanRequest and turns it into a qovery.DoCredentialsRequest to make the api call.
+func newQoveryDigitalOceanCredentialsRequestFromDomain(request credentials.UpsertDigitalOceanRequest) qovery.DoCredentialsRequest {
+	return qovery.DoCredentialsRequest{
+		Name:            request.Name,
+		Token:           &request.DigitalOceanToken,
+		SpacesAccessId:  &request.DigitalOceanSpacesAccessID,
+		SpacesSecretKey: &request.DigitalOceanSpacesSecretKey,
+	}
+}
+
+// gcpCredentialsRequest is the payload of the api calls creating & updating GCP credentials.
+// NOTE: it is not part of the api client yet, see callRawAPI.
+type gcpCredentialsRequest struct {
+	Name           string `json:"name"`
+	GcpCredentials string `json:"gcp_credentials"`
+}
+
+// newQoveryGcpCredentialsRequestFromDomain takes the domain request credentials.UpsertGcpRequest and turns it into a gcpCredentialsRequest to make the api call.
+func newQoveryGcpCredentialsRequestFromDomain(request credentials.UpsertGcpRequest) gcpCredentialsRequest {
+	return gcpCredentialsRequest{
+		Name:           request.Name,
+		GcpCredentials: request.GcpCredentials,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/qovery/qovery-client-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 )
@@ -72,13 +73,21 @@ func TestNewQoveryAwsCredentialsRequestFromDomain(t *testing.T) {
 		Request  credentials.UpsertAwsRequest
 	}{
 		{
-			TestName: "success",
+			TestName: "success_with_static_credentials",
 			Request: credentials.UpsertAwsRequest{
 				Name:            gofakeit.Name(),
 				AccessKeyID:     gofakeit.Word(),
 				SecretAccessKey: gofakeit.Word(),
 			},
 		},
+		{
+			TestName: "success_with_role",
+			Request: credentials.UpsertAwsRequest{
+				Name:       gofakeit.Name(),
+				RoleArn:    "arn:aws:iam::123456789012:role/qovery",
+				ExternalID: gofakeit.UUID(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -86,9 +95,20 @@ func TestNewQoveryAwsCredentialsRequestFromDomain(t *testing.T) {
 		t.Run(tc.TestName, func(t *testing.T) {
 			req := newQoveryAwsCredentialsRequestFromDomain(tc.Request)
 
-			assert.Equal(t, tc.Request.Name, req.Name)
-			assert.Equal(t, tc.Request.AccessKeyID, req.AccessKeyId)
-			assert.Equal(t, tc.Request.SecretAccessKey, req.SecretAccessKey)
+			if tc.Request.IsRoleBased() {
+				require.NotNil(t, req.AwsRoleCredentialsRequest)
+				assert.Nil(t, req.AwsStaticCredentialsRequest)
+				assert.Equal(t, tc.Request.Name, req.AwsRoleCredentialsRequest.Name)
+				assert.Equal(t, tc.Request.RoleArn, req.AwsRoleCredentialsRequest.RoleArn)
+				assert.Equal(t, tc.Request.ExternalID, req.AwsRoleCredentialsRequest.ExternalId)
+				return
+			}
+
+			require.NotNil(t, req.AwsStaticCredentialsRequest)
+			assert.Nil(t, req.AwsRoleCredentialsRequest)
+			assert.Equal(t, tc.Request.Name, req.AwsStaticCredentialsRequest.Name)
+			assert.Equal(t, tc.Request.AccessKeyID, req.AwsStaticCredentialsRequest.AccessKeyId)
+			assert.Equal(t, tc.Request.SecretAccessKey, req.AwsStaticCredentialsRequest.SecretAccessKey)
 		})
 	}
 }
@@ -123,3 +143,61 @@ func TestNewQoveryScalewayCredentialsRequestFromDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestNewQoveryDigitalOceanCredentialsRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Request  credentials.UpsertDigitalOceanRequest
+	}{
+		{
+			TestName: "success",
+			Request: credentials.UpsertDigitalOceanRequest{
+				Name:                        gofakeit.Name(),
+				DigitalOceanToken:           gofakeit.Word(),
+				DigitalOceanSpacesAccessID:  gofakeit.Word(),
+				DigitalOceanSpacesSecretKey: gofakeit.Word(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req := newQoveryDigitalOceanCredentialsRequestFromDomain(tc.Request)
+
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.DigitalOceanToken, *req.Token)
+			assert.Equal(t, tc.Request.DigitalOceanSpacesAccessID, *req.SpacesAccessId)
+			assert.Equal(t, tc.Request.DigitalOceanSpacesSecretKey, *req.SpacesSecretKey)
+		})
+	}
+}
+
+func TestNewQoveryGcpCredentialsRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Request  credentials.UpsertGcpRequest
+	}{
+		{
+			TestName: "success",
+			Request: credentials.UpsertGcpRequest{
+				Name:           gofakeit.Name(),
+				GcpCredentials: `{"type": "service_account"}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req := newQoveryGcpCredentialsRequestFromDomain(tc.Request)
+
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.GcpCredentials, req.GcpCredentials)
+		})
+	}
+}
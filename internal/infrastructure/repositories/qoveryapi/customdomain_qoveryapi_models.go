@@ -0,0 +1,49 @@
+package qoveryapi
+
+import (
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+// newDomainCustomDomainsFromQovery takes a qovery.CustomDomainResponseList returned by the API client and turns it into the domain model customdomain.CustomDomains.
+func newDomainCustomDomainsFromQovery(list *qovery.CustomDomainResponseList) (customdomain.CustomDomains, error) {
+	customDomains := make(customdomain.CustomDomains, 0, len(list.GetResults()))
+	for _, it := range list.GetResults() {
+		d, err := newDomainCustomDomainFromQovery(&it)
+		if err != nil {
+			return nil, err
+		}
+
+		customDomains = append(customDomains, *d)
+	}
+
+	return customDomains, nil
+}
+
+// newDomainCustomDomainFromQovery takes a qovery.CustomDomain returned by the API client and turns it into the domain model customdomain.CustomDomain.
+func newDomainCustomDomainFromQovery(d *qovery.CustomDomain) (*customdomain.CustomDomain, error) {
+	if d == nil {
+		return nil, customdomain.ErrNilCustomDomain
+	}
+
+	var customDomainStatus *string
+	if d.Status != nil {
+		s := string(*d.Status)
+		customDomainStatus = &s
+	}
+
+	return customdomain.NewCustomDomain(customdomain.NewCustomDomainParams{
+		CustomDomainID:   d.GetId(),
+		Domain:           d.GetDomain(),
+		ValidationDomain: d.ValidationDomain,
+		Status:           customDomainStatus,
+	})
+}
+
+// newQoveryCustomDomainRequestFromDomain takes the domain request customdomain.UpsertRequest and turns it into a qovery.CustomDomainRequest to make the api call.
+func newQoveryCustomDomainRequestFromDomain(request customdomain.UpsertRequest) qovery.CustomDomainRequest {
+	return qovery.CustomDomainRequest{
+		Domain: request.Domain,
+	}
+}
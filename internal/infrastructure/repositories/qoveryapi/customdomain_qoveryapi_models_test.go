@@ -0,0 +1,78 @@
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+func TestNewDomainCustomDomainsFromQovery(t *testing.T) {
+	t.Parallel()
+
+	pendingStatus := qovery.CUSTOMDOMAINSTATUSENUM_VALIDATION_PENDING
+
+	testCases := []struct {
+		TestName      string
+		CustomDomains *qovery.CustomDomainResponseList
+		ExpectedError error
+	}{
+		{
+			TestName: "success_with_nil_custom_domains",
+		},
+		{
+			TestName: "success",
+			CustomDomains: &qovery.CustomDomainResponseList{
+				Results: []qovery.CustomDomain{
+					{
+						Id:     gofakeit.UUID(),
+						Domain: gofakeit.DomainName(),
+					},
+					{
+						Id:               gofakeit.UUID(),
+						Domain:           gofakeit.DomainName(),
+						ValidationDomain: pointer.ToString(gofakeit.DomainName()),
+						Status:           &pendingStatus,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			dd, err := newDomainCustomDomainsFromQovery(tc.CustomDomains)
+			assert.NoError(t, err)
+			assert.Len(t, tc.CustomDomains.GetResults(), len(dd))
+
+			for idx, d := range dd {
+				expected := tc.CustomDomains.GetResults()[idx]
+				assert.True(t, d.IsValid())
+				assert.Equal(t, expected.Id, d.ID.String())
+				assert.Equal(t, expected.Domain, d.Domain)
+				assert.Equal(t, expected.ValidationDomain, d.ValidationDomain)
+				if expected.Status != nil {
+					assert.Equal(t, string(*expected.Status), *d.Status)
+				} else {
+					assert.Nil(t, d.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestNewQoveryCustomDomainRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	request := customdomain.UpsertRequest{
+		Domain: gofakeit.DomainName(),
+	}
+
+	req := newQoveryCustomDomainRequestFromDomain(request)
+	assert.Equal(t, request.Domain, req.Domain)
+}
@@ -0,0 +1,83 @@
+package qoveryapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+// TestCustomDomainsQoveryAPI_Status ensures the custom domains whose certificate has been issued (i.e: VALIDATION_SUCCEEDED) can be decoded.
+func TestCustomDomainsQoveryAPI_Status(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Service       string
+		NewRepository func(*QoveryAPI) customdomain.Repository
+	}{
+		{
+			TestName: "application",
+			Service:  "application",
+			NewRepository: func(api *QoveryAPI) customdomain.Repository {
+				return api.ApplicationCustomDomain
+			},
+		},
+		{
+			TestName: "container",
+			Service:  "container",
+			NewRepository: func(api *QoveryAPI) customdomain.Repository {
+				return api.ContainerCustomDomain
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			serviceID := uuid.NewString()
+			customDomainID := uuid.NewString()
+			domain := gofakeit.DomainName()
+			customDomain := fmt.Sprintf(`{"id": %q, "created_at": "2023-04-25T07:04:39Z", "domain": %q, "validation_domain": "validation.qovery.io", "status": "VALIDATION_SUCCEEDED"}`, customDomainID, domain)
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodGet, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case fmt.Sprintf("/%s/%s/customDomain/%s/status", tc.Service, serviceID, customDomainID):
+					_, _ = w.Write([]byte(customDomain))
+				case fmt.Sprintf("/%s/%s/customDomain", tc.Service, serviceID):
+					_, _ = w.Write([]byte(fmt.Sprintf(`{"results": [%s]}`, customDomain)))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			qoveryAPI, err := New(WithQoveryAPIURL(server.URL), WithQoveryAPIToken("my-token"))
+			require.NoError(t, err)
+			repository := tc.NewRepository(qoveryAPI)
+
+			d, err := repository.Get(context.Background(), serviceID, customDomainID)
+			require.NoError(t, err)
+			assert.Equal(t, domain, d.Domain)
+			assert.Equal(t, "VALIDATION_SUCCEEDED", *d.Status)
+			assert.True(t, d.IsCertificateIssued())
+
+			domains, err := repository.List(context.Background(), serviceID)
+			require.NoError(t, err)
+			require.Len(t, domains, 1)
+			assert.True(t, domains[0].IsCertificateIssued())
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package qoveryapi
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// Ensure databaseQoveryAPI defined types fully satisfy the database.Repository interface.
+var _ database.Repository = databaseQoveryAPI{}
+
+// databaseQoveryAPI implements the interface database.Repository.
+type databaseQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newDatabaseQoveryAPI return a new instance of a database.Repository that uses Qovery's API.
+func newDatabaseQoveryAPI(client *qovery.APIClient) (database.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &databaseQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a database in an environment using the given environmentID and request.
+func (c databaseQoveryAPI) Create(ctx context.Context, environmentID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	req, err := newQoveryDatabaseRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	newDatabase, resp, err := c.client.DatabasesApi.
+		CreateDatabase(ctx, environmentID).
+		DatabaseRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceDatabase, request.Name, resp, err)
+	}
+
+	// Attach database to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		_, response, err := c.client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(ctx, request.DeploymentStageID, newDatabase.Id).Execute()
+		if err != nil || response.StatusCode >= 400 {
+			return nil, apierrors.NewCreateApiError(apierrors.ApiResourceDatabase, request.Name, response, err)
+		}
+	}
+
+	advancedSettings, err := c.editAdvancedSettings(ctx, newDatabase.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getDatabaseDetails(ctx, newDatabase, advancedSettings, apierrors.ApiActionCreate)
+}
+
+// Get calls Qovery's API to retrieve a database using the given databaseID.
+func (c databaseQoveryAPI) Get(ctx context.Context, databaseID string) (*database.Database, error) {
+	db, resp, err := c.client.DatabaseMainCallsApi.
+		GetDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceDatabase, databaseID, resp, err)
+	}
+
+	advancedSettings, err := c.getAdvancedSettings(ctx, db.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getDatabaseDetails(ctx, db, advancedSettings, apierrors.ApiActionRead)
+}
+
+// Update calls Qovery's API to update a database using the given databaseID and request.
+func (c databaseQoveryAPI) Update(ctx context.Context, databaseID string, request database.UpsertRepositoryRequest) (*database.Database, error) {
+	req, err := newQoveryDatabaseEditRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	db, resp, err := c.client.DatabaseMainCallsApi.
+		EditDatabase(ctx, databaseID).
+		DatabaseEditRequest(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceDatabase, databaseID, resp, err)
+	}
+
+	// Attach database to deployment stage
+	if len(request.DeploymentStageID) > 0 {
+		_, response, err := c.client.DeploymentStageMainCallsApi.AttachServiceToDeploymentStage(ctx, request.DeploymentStageID, db.Id).Execute()
+		if err != nil || response.StatusCode >= 400 {
+			return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceDatabase, request.Name, response, err)
+		}
+	}
+
+	advancedSettings, err := c.editAdvancedSettings(ctx, db.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getDatabaseDetails(ctx, db, advancedSettings, apierrors.ApiActionUpdate)
+}
+
+// Delete calls Qovery's API to deletes a database using the given databaseID.
+func (c databaseQoveryAPI) Delete(ctx context.Context, databaseID string) error {
+	_, resp, err := c.client.DatabaseMainCallsApi.
+		GetDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		if resp != nil && resp.StatusCode == 404 {
+			// if the database is not found, then it has already been deleted
+			return nil
+		}
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceDatabase, databaseID, resp, err)
+	}
+
+	resp, err = c.client.DatabaseMainCallsApi.
+		DeleteDatabase(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 300 {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceDatabase, databaseID, resp, err)
+	}
+
+	return nil
+}
+
+// getDatabaseDetails calls Qovery's API to retrieve the master credentials & the deployment stage of the given database, and returns it with the given advancedSettings.
+// The given action is the one of the calling operation and is used to build the returned errors.
+func (c databaseQoveryAPI) getDatabaseDetails(ctx context.Context, db *qovery.Database, advancedSettings advancedsettings.AdvancedSettings, action apierrors.ApiAction) (*database.Database, error) {
+	credentials, resp, err := c.client.DatabaseMainCallsApi.
+		GetDatabaseMasterCredentials(ctx, db.Id).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewApiError(action, apierrors.ApiResourceDatabase, db.Id, resp, err)
+	}
+
+	// Get database deployment stage
+	deploymentStage, resp, err := c.client.DeploymentStageMainCallsApi.GetServiceDeploymentStage(ctx, db.Id).Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewApiError(action, apierrors.ApiResourceDatabase, db.Id, resp, err)
+	}
+
+	return newDomainDatabaseFromQovery(db, credentials, deploymentStage.Id, advancedSettings)
+}
+
+// getAdvancedSettings calls Qovery's API to retrieve the advanced settings of a database using the given databaseID.
+func (c databaseQoveryAPI) getAdvancedSettings(ctx context.Context, databaseID string) (advancedsettings.AdvancedSettings, error) {
+	advancedSettings, resp, err := c.client.DatabaseConfigurationApi.
+		GetDatabaseAdvancedSettings(ctx, databaseID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceDatabaseAdvancedSettings, databaseID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(advancedSettings)
+}
+
+// editAdvancedSettings calls Qovery's API to update the advanced settings of a database using the given databaseID and advancedSettings.
+// The api is only called if the advancedSettings differ from the current ones, the settings that are not part of advancedSettings are kept.
+func (c databaseQoveryAPI) editAdvancedSettings(ctx context.Context, databaseID string, advancedSettings advancedsettings.AdvancedSettings) (advancedsettings.AdvancedSettings, error) {
+	currentAdvancedSettings, err := c.getAdvancedSettings(ctx, databaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !advancedSettings.HasChanges(currentAdvancedSettings) {
+		return currentAdvancedSettings, nil
+	}
+
+	req, err := newQoveryAdvancedSettingsFromDomain[qovery.DatabaseAdvancedSettings](advancedSettings.Merge(currentAdvancedSettings))
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceDatabaseAdvancedSettings, databaseID, nil, err)
+	}
+
+	updatedAdvancedSettings, resp, err := c.client.DatabaseConfigurationApi.
+		EditDatabaseAdvancedSettings(ctx, databaseID).
+		DatabaseAdvancedSettings(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceDatabaseAdvancedSettings, databaseID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(updatedAdvancedSettings)
+}
@@ -0,0 +1,114 @@
+package qoveryapi
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+// newDomainDatabaseFromQovery takes a qovery.Database & its qovery.Credentials returned by the API client and turns them into the domain model database.Database.
+func newDomainDatabaseFromQovery(d *qovery.Database, credentials *qovery.Credentials, deploymentStageID string, advancedSettings advancedsettings.AdvancedSettings) (*database.Database, error) {
+	if d == nil {
+		return nil, database.ErrNilDatabase
+	}
+
+	var environmentID string
+	if d.Environment != nil {
+		environmentID = d.Environment.Id
+	}
+
+	accessibility := database.DefaultAccessibility.String()
+	if d.Accessibility != nil {
+		accessibility = string(*d.Accessibility)
+	}
+
+	return database.NewDatabase(database.NewDatabaseParams{
+		DatabaseID:        d.Id,
+		EnvironmentID:     environmentID,
+		Name:              d.Name,
+		Type:              string(d.Type),
+		Version:           d.Version,
+		Mode:              string(d.Mode),
+		Accessibility:     accessibility,
+		CPU:               pointer.GetInt32(d.Cpu),
+		Memory:            pointer.GetInt32(d.Memory),
+		Storage:           pointer.GetInt32(d.Storage),
+		ExternalHost:      d.Host,
+		Port:              d.Port,
+		Credentials:       newDomainDatabaseCredentialsFromQovery(credentials),
+		AdvancedSettings:  advancedSettings,
+		DeploymentStageID: deploymentStageID,
+	})
+}
+
+// newDomainDatabaseCredentialsFromQovery takes a qovery.Credentials returned by the API client and turns it into the domain model database.Credentials.
+func newDomainDatabaseCredentialsFromQovery(c *qovery.Credentials) *database.Credentials {
+	if c == nil {
+		return nil
+	}
+
+	return &database.Credentials{
+		Login:    c.Login,
+		Password: c.Password,
+		Host:     c.Host,
+		Port:     c.Port,
+	}
+}
+
+// newQoveryDatabaseRequestFromDomain takes the domain request database.UpsertRepositoryRequest and turns it into a qovery.DatabaseRequest to make the api call.
+func newQoveryDatabaseRequestFromDomain(request database.UpsertRepositoryRequest) (*qovery.DatabaseRequest, error) {
+	databaseType, err := qovery.NewDatabaseTypeEnumFromValue(request.Type)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	mode, err := qovery.NewDatabaseModeEnumFromValue(request.Mode)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	accessibility, err := newQoveryDatabaseAccessibilityFromDomain(request.Accessibility)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.DatabaseRequest{
+		Name:          request.Name,
+		Type:          *databaseType,
+		Version:       request.Version,
+		Mode:          *mode,
+		Accessibility: accessibility,
+		Cpu:           request.CPU,
+		Memory:        request.Memory,
+		Storage:       request.Storage,
+	}, nil
+}
+
+// newQoveryDatabaseEditRequestFromDomain takes the domain request database.UpsertRepositoryRequest and turns it into a qovery.DatabaseEditRequest to make the api call.
+func newQoveryDatabaseEditRequestFromDomain(request database.UpsertRepositoryRequest) (*qovery.DatabaseEditRequest, error) {
+	accessibility, err := newQoveryDatabaseAccessibilityFromDomain(request.Accessibility)
+	if err != nil {
+		return nil, errors.Wrap(err, database.ErrInvalidUpsertRequest.Error())
+	}
+
+	return &qovery.DatabaseEditRequest{
+		Name:          pointer.ToString(request.Name),
+		Version:       pointer.ToString(request.Version),
+		Accessibility: accessibility,
+		Cpu:           request.CPU,
+		Memory:        request.Memory,
+		Storage:       request.Storage,
+	}, nil
+}
+
+// newQoveryDatabaseAccessibilityFromDomain turns an optional accessibility into a qovery.DatabaseAccessibilityEnum.
+func newQoveryDatabaseAccessibilityFromDomain(accessibility *string) (*qovery.DatabaseAccessibilityEnum, error) {
+	if accessibility == nil {
+		return nil, nil
+	}
+
+	return qovery.NewDatabaseAccessibilityEnumFromValue(*accessibility)
+}
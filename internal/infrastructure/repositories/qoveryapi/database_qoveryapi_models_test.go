@@ -0,0 +1,197 @@
+package qoveryapi
+
+import (
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
+)
+
+func TestNewDomainDatabaseFromQovery(t *testing.T) {
+	t.Parallel()
+
+	privateAccessibility := qovery.DATABASEACCESSIBILITYENUM_PRIVATE
+
+	testCases := []struct {
+		TestName         string
+		Database         *qovery.Database
+		Credentials      *qovery.Credentials
+		AdvancedSettings advancedsettings.AdvancedSettings
+		ExpectedError    error
+	}{
+		{
+			TestName:      "fail_with_nil_database",
+			Database:      nil,
+			ExpectedError: database.ErrNilDatabase,
+		},
+		{
+			TestName: "success_without_credentials",
+			Database: &qovery.Database{
+				Id: gofakeit.UUID(),
+				Environment: &qovery.ReferenceObject{
+					Id: gofakeit.UUID(),
+				},
+				Name:    gofakeit.Name(),
+				Type:    qovery.DATABASETYPEENUM_POSTGRESQL,
+				Version: "13",
+				Mode:    qovery.DATABASEMODEENUM_CONTAINER,
+				Cpu:     pointer.ToInt32(database.DefaultCPU),
+				Memory:  pointer.ToInt32(database.DefaultMemory),
+				Storage: pointer.ToInt32(database.DefaultStorage),
+			},
+		},
+		{
+			TestName: "success_with_credentials",
+			Database: &qovery.Database{
+				Id: gofakeit.UUID(),
+				Environment: &qovery.ReferenceObject{
+					Id: gofakeit.UUID(),
+				},
+				Name:          gofakeit.Name(),
+				Type:          qovery.DATABASETYPEENUM_MYSQL,
+				Version:       "8.0",
+				Mode:          qovery.DATABASEMODEENUM_MANAGED,
+				Accessibility: &privateAccessibility,
+				Cpu:           pointer.ToInt32(database.DefaultCPU),
+				Memory:        pointer.ToInt32(database.DefaultMemory),
+				Storage:       pointer.ToInt32(database.DefaultStorage),
+				Host:          pointer.ToString(gofakeit.DomainName()),
+				Port:          pointer.ToInt32(3306),
+			},
+			Credentials: &qovery.Credentials{
+				Login:    gofakeit.Username(),
+				Password: gofakeit.Password(true, true, true, false, false, 16),
+				Host:     gofakeit.DomainName(),
+				Port:     3306,
+			},
+			AdvancedSettings: advancedsettings.AdvancedSettings{"network.ingress.whitelist_source_range": "10.0.0.0/24"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			fakeDeploymentStageId := uuid.NewString()
+			db, err := newDomainDatabaseFromQovery(tc.Database, tc.Credentials, fakeDeploymentStageId, tc.AdvancedSettings)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, db)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, db)
+			assert.True(t, db.IsValid())
+			assert.Equal(t, tc.Database.Id, db.ID.String())
+			assert.Equal(t, tc.Database.Environment.Id, db.EnvironmentID.String())
+			assert.Equal(t, tc.Database.Name, db.Name)
+			assert.Equal(t, string(tc.Database.Type), db.Type.String())
+			assert.Equal(t, tc.Database.Version, db.Version)
+			assert.Equal(t, string(tc.Database.Mode), db.Mode.String())
+			assert.Equal(t, *tc.Database.Cpu, db.CPU)
+			assert.Equal(t, *tc.Database.Memory, db.Memory)
+			assert.Equal(t, *tc.Database.Storage, db.Storage)
+			assert.Equal(t, tc.Database.Host, db.ExternalHost)
+			assert.Equal(t, tc.Database.Port, db.Port)
+			assert.Equal(t, fakeDeploymentStageId, db.DeploymentStageID)
+			assert.Equal(t, tc.AdvancedSettings, db.AdvancedSettings)
+
+			if tc.Database.Accessibility != nil {
+				assert.Equal(t, string(*tc.Database.Accessibility), db.Accessibility.String())
+			} else {
+				assert.Equal(t, database.DefaultAccessibility, db.Accessibility)
+			}
+
+			if tc.Credentials == nil {
+				assert.Nil(t, db.Credentials)
+				return
+			}
+			assert.Equal(t, tc.Credentials.Login, db.Credentials.Login)
+			assert.Equal(t, tc.Credentials.Password, db.Credentials.Password)
+			assert.Equal(t, tc.Credentials.Host, db.Credentials.Host)
+			assert.Equal(t, tc.Credentials.Port, db.Credentials.Port)
+		})
+	}
+}
+
+func TestNewQoveryDatabaseRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Request       database.UpsertRepositoryRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "fail_with_invalid_type",
+			Request: database.UpsertRepositoryRequest{
+				Name:    gofakeit.Name(),
+				Type:    "INVALID",
+				Version: "13",
+				Mode:    database.ModeContainer.String(),
+			},
+			ExpectedError: database.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "fail_with_invalid_accessibility",
+			Request: database.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: pointer.ToString("INVALID"),
+			},
+			ExpectedError: database.ErrInvalidUpsertRequest,
+		},
+		{
+			TestName: "success",
+			Request: database.UpsertRepositoryRequest{
+				Name:          gofakeit.Name(),
+				Type:          database.TypePostgreSQL.String(),
+				Version:       "13",
+				Mode:          database.ModeContainer.String(),
+				Accessibility: pointer.ToString(database.AccessibilityPrivate.String()),
+				CPU:           pointer.ToInt32(database.DefaultCPU),
+				Memory:        pointer.ToInt32(database.DefaultMemory),
+				Storage:       pointer.ToInt32(database.DefaultStorage),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req, err := newQoveryDatabaseRequestFromDomain(tc.Request)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, req)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Request.Name, req.Name)
+			assert.Equal(t, tc.Request.Type, string(req.Type))
+			assert.Equal(t, tc.Request.Version, req.Version)
+			assert.Equal(t, tc.Request.Mode, string(req.Mode))
+			assert.Equal(t, *tc.Request.Accessibility, string(*req.Accessibility))
+			assert.Equal(t, tc.Request.CPU, req.Cpu)
+			assert.Equal(t, tc.Request.Memory, req.Memory)
+			assert.Equal(t, tc.Request.Storage, req.Storage)
+
+			editReq, err := newQoveryDatabaseEditRequestFromDomain(tc.Request)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.Request.Name, *editReq.Name)
+			assert.Equal(t, tc.Request.Version, *editReq.Version)
+			assert.Equal(t, *tc.Request.Accessibility, string(*editReq.Accessibility))
+			assert.Equal(t, tc.Request.CPU, editReq.Cpu)
+			assert.Equal(t, tc.Request.Memory, editReq.Memory)
+			assert.Equal(t, tc.Request.Storage, editReq.Storage)
+		})
+	}
+}
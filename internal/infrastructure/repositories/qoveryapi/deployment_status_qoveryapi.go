@@ -2,15 +2,18 @@ package qoveryapi
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
+	"net/http"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
 )
 
 type deploymentStatusQoveryAPI struct {
@@ -28,110 +31,35 @@ func newDeploymentStatusQoveryAPI(client *qovery.APIClient) (newdeployment.Deplo
 }
 
 func (d deploymentStatusQoveryAPI) WaitForTerminatedState(ctx context.Context, environmentID uuid.UUID) error {
-	checkEnvironmentStatus := d.newEnvironmentWaitForTerminalStateBeforeDeploying(environmentID)
-	err := waitWithDefaultTimeout(ctx, checkEnvironmentStatus)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return poller.UntilTerminalState(ctx, d.newEnvironmentStateFunc(environmentID, false))
 }
 
 func (d deploymentStatusQoveryAPI) WaitForExpectedDesiredState(ctx context.Context, newDeployment newdeployment.Deployment) error {
-	checkEnvironmentStatus := d.newEnvironmentWaitForExpectedDesiredState(*newDeployment.EnvironmentID, newDeployment.DesiredState)
-	err := waitWithDefaultTimeout(ctx, checkEnvironmentStatus)
-	if err != nil {
-		return err
+	getState := d.newEnvironmentStateFunc(*newDeployment.EnvironmentID, newDeployment.DesiredState == newdeployment.DELETED)
+	if err := poller.UntilState(ctx, getState, newDeployment.DesiredState.ExpectedStates()); err != nil {
+		return errors.Wrap(err, fmt.Sprintf("environment didn't reach the desired state %s", newDeployment.DesiredState))
 	}
 
 	return nil
 }
 
-type waitFunc func(ctx context.Context) (bool, error)
-
-func waitWithDefaultTimeout(ctx context.Context, f waitFunc) error {
-	defaultWaitTimeout := 4 * time.Hour
-	return wait(ctx, f, &defaultWaitTimeout)
-}
-
-func wait(ctx context.Context, f waitFunc, timeout *time.Duration) error {
-	// Run the function once before waiting
-	ok, err := f(ctx)
-	if err != nil {
-		return err
-	}
-	if ok {
-		return nil
-	}
-
-	ticker := time.NewTicker(10 * time.Second)
-	timeoutTicker := time.NewTicker(*timeout)
-
-	for {
-		select {
-		case <-timeoutTicker.C:
-			return nil
-		case <-ticker.C:
-			ok, apiErr := f(ctx)
-			if apiErr != nil {
-				return apiErr
-			}
-			if ok {
-				return nil
-			}
-		}
-	}
-}
-
-func (d deploymentStatusQoveryAPI) newEnvironmentWaitForTerminalStateBeforeDeploying(environmentID uuid.UUID) waitFunc {
-	return func(ctx context.Context) (bool, error) {
-		status, response, err := d.client.EnvironmentMainCallsApi.GetEnvironmentStatus(ctx, environmentID.String()).Execute()
+// newEnvironmentStateFunc returns a poller.StateFunc fetching the state of the given environment.
+// If notFoundAsDeleted is true, an environment that cannot be found anymore is considered as deleted.
+func (d deploymentStatusQoveryAPI) newEnvironmentStateFunc(environmentID uuid.UUID, notFoundAsDeleted bool) poller.StateFunc {
+	return func(ctx context.Context) (status.State, error) {
+		envStatus, response, err := d.client.EnvironmentMainCallsApi.GetEnvironmentStatus(ctx, environmentID.String()).Execute()
 		if err != nil || response.StatusCode >= 400 {
-			return false, err
-		}
-
-		switch status.State {
-		// In progress
-		case "BUILDING", "CANCELING", "DELETE_QUEUED", "DELETING", "DEPLOYING", "STOPPING",
-			"STOP_QUEUED", "RESTART_QUEUED", "RESTARTING", "DEPLOYMENT_QUEUED", "QUEUED":
-			tflog.Info(ctx, fmt.Sprintf("Environment deployment in progress with current status %s...", status.State))
-			return false, nil
-		// Finished with error
-		case "READY", "DEPLOYMENT_ERROR", "DELETE_ERROR", "STOP_ERROR", "RESTART_ERROR",
-			"STOPPED", "DELETED", "RESTARTED", "CANCELED", "BUILD_ERROR", "DEPLOYED":
-			return true, nil
-		}
-
-		// Unexpected status
-		return false, errors.New(fmt.Sprintf("Unexpected deployment status having status: %s", status.State))
-	}
-}
-
-func (d deploymentStatusQoveryAPI) newEnvironmentWaitForExpectedDesiredState(environmentID uuid.UUID, desiredState newdeployment.DeploymentDesiredState) waitFunc {
-	return func(ctx context.Context) (bool, error) {
-		status, response, err := d.client.EnvironmentMainCallsApi.GetEnvironmentStatus(ctx, environmentID.String()).Execute()
-		if err != nil {
-			if response.StatusCode == 404 && desiredState == newdeployment.DELETED {
-				return true, nil
+			if notFoundAsDeleted && response != nil && response.StatusCode == http.StatusNotFound {
+				return status.StateDeleted, nil
 			}
-			return false, err
+			return "", apierrors.NewReadApiError(apierrors.ApiResourceEnvironmentStatus, environmentID.String(), response, err)
 		}
 
-		switch status.State {
-		// In progress
-		case "BUILDING", "CANCELING", "DELETE_QUEUED", "DELETING", "DEPLOYING", "STOPPING",
-			"STOP_QUEUED", "RESTART_QUEUED", "RESTARTING", "DEPLOYMENT_QUEUED", "QUEUED", "READY":
-			tflog.Info(ctx, fmt.Sprintf("Environment deployment in progress with target status %s...", desiredState))
-			return false, nil
-		// Finished with error
-		case "BUILD_ERROR", "DEPLOYMENT_ERROR", "DELETE_ERROR", "STOP_ERROR", "RESTART_ERROR":
-			return false, errors.New(fmt.Sprintf("Environment deployment failed with final status: %s", status.State))
-		// Finished with success
-		case "STOPPED", "DEPLOYED", "DELETED", "RESTARTED", "CANCELED":
-			return true, nil
+		state := status.State(envStatus.State)
+		if state.IsInProgress() {
+			tflog.Info(ctx, fmt.Sprintf("Environment deployment in progress with current status %s...", state))
 		}
 
-		// Unexpected status
-		return false, errors.New(fmt.Sprintf("Unexpected deployment status having status: %s", status.State))
+		return state, nil
 	}
 }
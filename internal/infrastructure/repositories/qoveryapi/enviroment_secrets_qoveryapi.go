@@ -41,6 +41,32 @@ func (p environmentSecretsQoveryAPI) Create(ctx context.Context, environmentID s
 	return newDomainSecretFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the secret with the given aliasedSecretID for an environment using the given environmentID.
+func (p environmentSecretsQoveryAPI) CreateAlias(ctx context.Context, environmentID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	v, resp, err := p.client.EnvironmentSecretApi.
+		CreateEnvironmentSecretAlias(ctx, environmentID, aliasedSecretID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceEnvironmentSecret, key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the secret with the given overriddenSecretID for an environment using the given environmentID.
+func (p environmentSecretsQoveryAPI) CreateOverride(ctx context.Context, environmentID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	v, resp, err := p.client.EnvironmentSecretApi.
+		CreateEnvironmentSecretOverride(ctx, environmentID, overriddenSecretID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceEnvironmentSecret, overriddenSecretID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment secrets from an environment using the given environmentID and secretID.
 func (p environmentSecretsQoveryAPI) List(ctx context.Context, environmentID string) (secret.Secrets, error) {
 	vars, resp, err := p.client.EnvironmentSecretApi.
@@ -41,6 +41,11 @@ func (c environmentDeploymentQoveryAPI) GetStatus(ctx context.Context, environme
 	return newDomainEnvironmentStatusFromQovery(environmentStatus)
 }
 
+// GetEnvironmentID returns the given environmentID since an environment is its own environment.
+func (c environmentDeploymentQoveryAPI) GetEnvironmentID(_ context.Context, environmentID string) (string, error) {
+	return environmentID, nil
+}
+
 // Deploy calls Qovery's API to deploy an environment using the given environmentID.
 func (c environmentDeploymentQoveryAPI) Deploy(ctx context.Context, environmentID string, imageTag string) (*status.Status, error) {
 	environmentStatus, resp, err := c.client.EnvironmentActionsApi.
@@ -41,6 +41,32 @@ func (p environmentEnvironmentVariablesQoveryAPI) Create(ctx context.Context, en
 	return newDomainVariableFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the environment variable with the given aliasedVariableID for an environment using the given environmentID.
+func (p environmentEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, environmentID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	v, resp, err := p.client.EnvironmentVariableApi.
+		CreateEnvironmentEnvironmentVariableAlias(ctx, environmentID, aliasedVariableID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceEnvironmentEnvironmentVariable, key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the environment variable with the given overriddenVariableID for an environment using the given environmentID.
+func (p environmentEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, environmentID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	v, resp, err := p.client.EnvironmentVariableApi.
+		CreateEnvironmentEnvironmentVariableOverride(ctx, environmentID, overriddenVariableID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceEnvironmentEnvironmentVariable, overriddenVariableID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment variables from an environment using the given environmentID and variableID.
 func (p environmentEnvironmentVariablesQoveryAPI) List(ctx context.Context, environmentID string) (variable.Variables, error) {
 	vars, resp, err := p.client.EnvironmentVariableApi.
@@ -1,6 +1,7 @@
 package qoveryapi
 
 import (
+	"github.com/AlekSi/pointer"
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
@@ -18,6 +19,7 @@ func newDomainEnvironmentFromQovery(e *qovery.Environment) (*environment.Environ
 		EnvironmentID: e.Id,
 		ProjectID:     e.Project.Id,
 		ClusterID:     e.ClusterId,
+		CloudProvider: pointer.GetString(e.CloudProvider.Provider),
 		Name:          e.Name,
 		Mode:          string(e.Mode),
 	})
@@ -32,8 +32,11 @@ func TestNewDomainEnvironmentFromQovery(t *testing.T) {
 					Id: gofakeit.UUID(),
 				},
 				ClusterId: gofakeit.UUID(),
-				Name:      gofakeit.Name(),
-				Mode:      qovery.ENVIRONMENTMODEENUM_DEVELOPMENT,
+				CloudProvider: qovery.EnvironmentAllOfCloudProvider{
+					Provider: pointer.ToString(string(qovery.CLOUDPROVIDERENUM_AWS)),
+				},
+				Name: gofakeit.Name(),
+				Mode: qovery.ENVIRONMENTMODEENUM_DEVELOPMENT,
 			},
 		},
 	}
@@ -54,6 +57,7 @@ func TestNewDomainEnvironmentFromQovery(t *testing.T) {
 			assert.Equal(t, tc.Environment.Id, env.ID.String())
 			assert.Equal(t, tc.Environment.ClusterId, env.ClusterID.String())
 			assert.Equal(t, tc.Environment.Project.Id, env.ProjectID.String())
+			assert.Equal(t, *tc.Environment.CloudProvider.Provider, env.CloudProvider)
 			assert.Equal(t, tc.Environment.Name, env.Name)
 			assert.Equal(t, string(tc.Environment.Mode), env.Mode.String())
 		})
@@ -0,0 +1,85 @@
+package qoveryapi
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+// Ensure gitTokenQoveryAPI defined types fully satisfy the gittoken.Repository interface.
+var _ gittoken.Repository = gitTokenQoveryAPI{}
+
+// gitTokenQoveryAPI implements the interface gittoken.Repository.
+// NOTE: it calls the api with callRawAPI since the api client doesn't expose the git tokens yet.
+type gitTokenQoveryAPI struct {
+	client *qovery.APIClient
+}
+
+// newGitTokenQoveryAPI return a new instance of a gittoken.Repository that uses Qovery's API.
+func newGitTokenQoveryAPI(client *qovery.APIClient) (gittoken.Repository, error) {
+	if client == nil {
+		return nil, ErrInvalidQoveryAPIClient
+	}
+
+	return &gitTokenQoveryAPI{
+		client: client,
+	}, nil
+}
+
+// Create calls Qovery's API to create a git token for an organization using the given organizationID and request.
+func (c gitTokenQoveryAPI) Create(ctx context.Context, organizationID string, request gittoken.UpsertRequest) (*gittoken.GitToken, error) {
+	req, err := newQoveryGitTokenRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrInvalidUpsertRequest.Error())
+	}
+
+	var token gitTokenResponse
+	resp, err := callRawAPI(ctx, c.client, http.MethodPost, gitTokenPath(organizationID), req, &token)
+	if err != nil {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceGitToken, request.Name, resp, err)
+	}
+
+	return newDomainGitTokenFromQovery(&token, organizationID)
+}
+
+// Get calls Qovery's API to retrieve a git token using the given gitTokenID.
+func (c gitTokenQoveryAPI) Get(ctx context.Context, organizationID string, gitTokenID string) (*gittoken.GitToken, error) {
+	var token gitTokenResponse
+	resp, err := callRawAPI(ctx, c.client, http.MethodGet, gitTokenPath(organizationID, gitTokenID), nil, &token)
+	if err != nil {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceGitToken, gitTokenID, resp, err)
+	}
+
+	return newDomainGitTokenFromQovery(&token, organizationID)
+}
+
+// Update calls Qovery's API to update a git token using the given gitTokenID and request.
+func (c gitTokenQoveryAPI) Update(ctx context.Context, organizationID string, gitTokenID string, request gittoken.UpsertRequest) (*gittoken.GitToken, error) {
+	req, err := newQoveryGitTokenRequestFromDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, gittoken.ErrInvalidUpsertRequest.Error())
+	}
+
+	var token gitTokenResponse
+	resp, err := callRawAPI(ctx, c.client, http.MethodPut, gitTokenPath(organizationID, gitTokenID), req, &token)
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceGitToken, gitTokenID, resp, err)
+	}
+
+	return newDomainGitTokenFromQovery(&token, organizationID)
+}
+
+// Delete calls Qovery's API to deletes a git token using the given gitTokenID.
+func (c gitTokenQoveryAPI) Delete(ctx context.Context, organizationID string, gitTokenID string) error {
+	resp, err := callRawAPI(ctx, c.client, http.MethodDelete, gitTokenPath(organizationID, gitTokenID), nil, nil)
+	if err != nil {
+		return apierrors.NewDeleteApiError(apierrors.ApiResourceGitToken, gitTokenID, resp, err)
+	}
+
+	return nil
+}
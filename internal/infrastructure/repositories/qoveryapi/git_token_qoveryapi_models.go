@@ -0,0 +1,71 @@
+package qoveryapi
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+// NOTE: the git tokens are not exposed yet by the version of the api client in use, the models below mirror the ones of Qovery's API.
+
+// gitTokenRequest is the payload sent to Qovery's API to create & update a git token.
+type gitTokenRequest struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Token       string  `json:"token"`
+	Description *string `json:"description,omitempty"`
+	Workspace   *string `json:"workspace,omitempty"`
+}
+
+// gitTokenResponse is the git token returned by Qovery's API.
+type gitTokenResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Description *string `json:"description,omitempty"`
+	Workspace   *string `json:"workspace,omitempty"`
+}
+
+// gitTokenPath returns the api path of the git tokens of an organization,
+// or of one of them if a gitTokenID is given.
+func gitTokenPath(organizationID string, gitTokenID ...string) string {
+	p := fmt.Sprintf("/organization/%s/gitToken", url.PathEscape(organizationID))
+	for _, id := range gitTokenID {
+		p += "/" + url.PathEscape(id)
+	}
+
+	return p
+}
+
+// newDomainGitTokenFromQovery takes a gitTokenResponse returned by the API and turns it into the domain model gittoken.GitToken.
+func newDomainGitTokenFromQovery(v *gitTokenResponse, organizationID string) (*gittoken.GitToken, error) {
+	if v == nil {
+		return nil, gittoken.ErrNilGitToken
+	}
+
+	return gittoken.NewGitToken(gittoken.NewGitTokenParams{
+		GitTokenID:     v.ID,
+		OrganizationID: organizationID,
+		Name:           v.Name,
+		Type:           v.Type,
+		Description:    v.Description,
+		Workspace:      v.Workspace,
+	})
+}
+
+// newQoveryGitTokenRequestFromDomain takes the domain request gittoken.UpsertRequest and turns it into a gitTokenRequest to make the api call.
+func newQoveryGitTokenRequestFromDomain(request gittoken.UpsertRequest) (*gitTokenRequest, error) {
+	tokenType, err := gittoken.NewTypeFromString(request.Type)
+	if err != nil {
+		return nil, gittoken.ErrInvalidTypeParam
+	}
+
+	return &gitTokenRequest{
+		Name:        request.Name,
+		Type:        tokenType.String(),
+		Token:       request.Token,
+		Description: request.Description,
+		Workspace:   request.Workspace,
+	}, nil
+}
@@ -0,0 +1,120 @@
+package qoveryapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+func TestNewDomainGitTokenFromQovery(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		GitToken      *gitTokenResponse
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_nil_git_token",
+			GitToken:      nil,
+			ExpectedError: gittoken.ErrNilGitToken,
+		},
+		{
+			TestName: "success",
+			GitToken: &gitTokenResponse{
+				ID:          gofakeit.UUID(),
+				Name:        gofakeit.Name(),
+				Type:        gittoken.TypeGitLab.String(),
+				Description: pointer.ToString(gofakeit.Word()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			organizationID := gofakeit.UUID()
+			token, err := newDomainGitTokenFromQovery(tc.GitToken, organizationID)
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, token)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, token.IsValid())
+			assert.Equal(t, tc.GitToken.ID, token.ID.String())
+			assert.Equal(t, organizationID, token.OrganizationID.String())
+			assert.Equal(t, tc.GitToken.Name, token.Name)
+			assert.Equal(t, tc.GitToken.Type, token.Type.String())
+			assert.Equal(t, tc.GitToken.Description, token.Description)
+		})
+	}
+}
+
+func TestNewQoveryGitTokenRequestFromDomain(t *testing.T) {
+	t.Parallel()
+
+	request := gittoken.UpsertRequest{
+		Name:      gofakeit.Name(),
+		Type:      gittoken.TypeBitbucket.String(),
+		Token:     gofakeit.Password(true, true, true, false, false, 32),
+		Workspace: pointer.ToString(gofakeit.Word()),
+	}
+
+	req, err := newQoveryGitTokenRequestFromDomain(request)
+	require.NoError(t, err)
+
+	assert.Equal(t, request.Name, req.Name)
+	assert.Equal(t, request.Type, req.Type)
+	assert.Equal(t, request.Token, req.Token)
+	assert.Equal(t, request.Workspace, req.Workspace)
+	assert.Nil(t, req.Description)
+}
+
+func TestQoveryGitTokenID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName   string
+		GitTokenID *string
+	}{
+		{
+			TestName:   "success_with_git_token_id",
+			GitTokenID: pointer.ToString(gofakeit.UUID()),
+		},
+		{
+			TestName: "success_without_git_token_id",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			req := newQoveryApplicationGitRepositoryRequestFromDomain(application.GitRepositoryUpsertRequest{
+				URL:        gofakeit.URL(),
+				GitTokenID: tc.GitTokenID,
+			})
+
+			raw, err := json.Marshal(req)
+			require.NoError(t, err)
+
+			// the git token id is always sent, so it can be removed from the git repository
+			var payload map[string]interface{}
+			require.NoError(t, json.Unmarshal(raw, &payload))
+			assert.Contains(t, payload, "git_token_id")
+
+			var gitRepository qovery.ApplicationGitRepository
+			require.NoError(t, json.Unmarshal(raw, &gitRepository))
+			assert.Equal(t, tc.GitTokenID, gitRepository.GitTokenId.Get())
+		})
+	}
+}
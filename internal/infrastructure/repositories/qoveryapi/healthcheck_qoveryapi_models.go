@@ -0,0 +1,82 @@
+package qoveryapi
+
+import (
+	"github.com/qovery/qovery-client-go"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+)
+
+// newQoveryHealthchecksFromDomain takes the domain model healthcheck.Healthchecks and turns it into a qovery.Healthchecks to make the api call.
+// A nil probe is sent as null to remove it.
+func newQoveryHealthchecksFromDomain(healthchecks healthcheck.Healthchecks) *qovery.Healthchecks {
+	return &qovery.Healthchecks{
+		ReadinessProbe: *qovery.NewNullableProbe(newQoveryProbeFromDomain(healthchecks.ReadinessProbe)),
+		LivenessProbe:  *qovery.NewNullableProbe(newQoveryProbeFromDomain(healthchecks.LivenessProbe)),
+	}
+}
+
+func newQoveryProbeFromDomain(probe *healthcheck.Probe) *qovery.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	var probeType qovery.ProbeType
+	switch {
+	case probe.Type.HTTP != nil:
+		probeType.Http = qovery.NewProbeTypeHttp(probe.Type.HTTP.Path, probe.Type.HTTP.Port, probe.Type.HTTP.Scheme)
+	case probe.Type.TCP != nil:
+		probeType.Tcp = &qovery.ProbeTypeTcp{Port: probe.Type.TCP.Port, Host: probe.Type.TCP.Host}
+	case probe.Type.GRPC != nil:
+		probeType.Grpc = &qovery.ProbeTypeGrpc{Port: probe.Type.GRPC.Port, Service: probe.Type.GRPC.Service}
+	case probe.Type.Exec != nil:
+		probeType.Exec = qovery.NewProbeTypeExec(probe.Type.Exec.Command)
+	}
+
+	return qovery.NewProbe(
+		probeType,
+		probe.InitialDelaySeconds,
+		probe.PeriodSeconds,
+		probe.TimeoutSeconds,
+		probe.SuccessThreshold,
+		probe.FailureThreshold,
+	)
+}
+
+// newDomainHealthchecksFromQovery takes a qovery.Healthchecks returned by the API client and turns it into the domain model healthcheck.Healthchecks.
+func newDomainHealthchecksFromQovery(healthchecks *qovery.Healthchecks) healthcheck.Healthchecks {
+	if healthchecks == nil {
+		return healthcheck.Healthchecks{}
+	}
+
+	return healthcheck.Healthchecks{
+		ReadinessProbe: newDomainProbeFromQovery(healthchecks.ReadinessProbe.Get()),
+		LivenessProbe:  newDomainProbeFromQovery(healthchecks.LivenessProbe.Get()),
+	}
+}
+
+func newDomainProbeFromQovery(probe *qovery.Probe) *healthcheck.Probe {
+	if probe == nil {
+		return nil
+	}
+
+	var probeType healthcheck.ProbeType
+	switch {
+	case probe.Type.Http != nil:
+		probeType.HTTP = &healthcheck.HTTPProbe{Path: probe.Type.Http.Path, Port: probe.Type.Http.Port, Scheme: probe.Type.Http.Scheme}
+	case probe.Type.Tcp != nil:
+		probeType.TCP = &healthcheck.TCPProbe{Port: probe.Type.Tcp.Port, Host: probe.Type.Tcp.Host}
+	case probe.Type.Grpc != nil:
+		probeType.GRPC = &healthcheck.GRPCProbe{Port: probe.Type.Grpc.Port, Service: probe.Type.Grpc.Service}
+	case probe.Type.Exec != nil:
+		probeType.Exec = &healthcheck.ExecProbe{Command: probe.Type.Exec.Command}
+	}
+
+	return &healthcheck.Probe{
+		Type:                probeType,
+		InitialDelaySeconds: probe.InitialDelaySeconds,
+		PeriodSeconds:       probe.PeriodSeconds,
+		TimeoutSeconds:      probe.TimeoutSeconds,
+		SuccessThreshold:    probe.SuccessThreshold,
+		FailureThreshold:    probe.FailureThreshold,
+	}
+}
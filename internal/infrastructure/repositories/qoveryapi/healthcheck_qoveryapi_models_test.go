@@ -0,0 +1,66 @@
+package qoveryapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+)
+
+func TestNewQoveryHealthchecksFromDomain(t *testing.T) {
+	t.Parallel()
+
+	newProbe := func(probeType healthcheck.ProbeType) *healthcheck.Probe {
+		return &healthcheck.Probe{
+			Type:                probeType,
+			InitialDelaySeconds: int32(gofakeit.Number(0, 60)),
+			PeriodSeconds:       int32(gofakeit.Number(1, 60)),
+			TimeoutSeconds:      int32(gofakeit.Number(1, 60)),
+			SuccessThreshold:    1,
+			FailureThreshold:    int32(gofakeit.Number(1, 10)),
+		}
+	}
+
+	testCases := []struct {
+		TestName     string
+		Healthchecks healthcheck.Healthchecks
+	}{
+		{
+			TestName:     "success_without_probes",
+			Healthchecks: healthcheck.Healthchecks{},
+		},
+		{
+			TestName: "success_with_http_and_exec_probes",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newProbe(healthcheck.ProbeType{HTTP: &healthcheck.HTTPProbe{Path: "/health", Port: 8080, Scheme: healthcheck.SchemeHTTPS}}),
+				LivenessProbe:  newProbe(healthcheck.ProbeType{Exec: &healthcheck.ExecProbe{Command: []string{"cat", "/tmp/healthy"}}}),
+			},
+		},
+		{
+			TestName: "success_with_tcp_and_grpc_probes",
+			Healthchecks: healthcheck.Healthchecks{
+				ReadinessProbe: newProbe(healthcheck.ProbeType{TCP: &healthcheck.TCPProbe{Port: 5432, Host: pointer.ToString(gofakeit.DomainName())}}),
+				LivenessProbe:  newProbe(healthcheck.ProbeType{GRPC: &healthcheck.GRPCProbe{Port: 50051, Service: pointer.ToString(gofakeit.Word())}}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			raw, err := json.Marshal(qovery.ContainerRequest{Name: gofakeit.Name(), Healthchecks: newQoveryHealthchecksFromDomain(tc.Healthchecks)})
+			require.NoError(t, err)
+
+			// the healthchecks go through the api as json, the probes that are not set being sent as null
+			var container qovery.ContainerResponse
+			require.NoError(t, json.Unmarshal(raw, &container))
+			assert.Equal(t, tc.Healthchecks, newDomainHealthchecksFromQovery(container.Healthchecks))
+		})
+	}
+}
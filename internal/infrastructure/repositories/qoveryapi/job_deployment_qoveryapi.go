@@ -41,14 +41,40 @@ func (c jobDeploymentQoveryAPI) GetStatus(ctx context.Context, jobID string) (*s
 	return newDomainStatusFromQovery(jobStatus)
 }
 
+// GetEnvironmentID calls Qovery's API to get the id of the environment of a job using the given jobID.
+func (c jobDeploymentQoveryAPI) GetEnvironmentID(ctx context.Context, jobID string) (string, error) {
+	qoveryJob, resp, err := c.client.JobMainCallsApi.
+		GetJob(ctx, jobID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return "", apierrors.NewReadApiError(apierrors.ApiResourceJob, jobID, resp, err)
+	}
+
+	return qoveryJob.Environment.Id, nil
+}
+
 // Deploy calls Qovery's API to deploy a job using the given jobID.
+// The version is the git commit id to deploy if the job is built from a git repository, the image tag otherwise.
 func (c jobDeploymentQoveryAPI) Deploy(ctx context.Context, jobID string, version string) (*status.Status, error) {
-	// TODO(benjaminch): to be checked because we should be able to pass a commit ID
+	qoveryJob, resp, err := c.client.JobMainCallsApi.
+		GetJob(ctx, jobID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewDeployApiError(apierrors.ApiResourceJob, jobID, resp, err)
+	}
+
+	deployRequest := qovery.JobDeployRequest{
+		ImageTag: &version,
+	}
+	if qoveryJob.Source.Docker.Get() != nil {
+		deployRequest = qovery.JobDeployRequest{
+			GitCommitId: &version,
+		}
+	}
+
 	jobStatus, resp, err := c.client.JobActionsApi.
 		DeployJob(ctx, jobID).
-		JobDeployRequest(qovery.JobDeployRequest{
-			ImageTag: &version,
-		}).
+		JobDeployRequest(deployRequest).
 		Execute()
 	if err != nil || resp.StatusCode >= 400 {
 		return nil, apierrors.NewDeployApiError(apierrors.ApiResourceJob, jobID, resp, err)
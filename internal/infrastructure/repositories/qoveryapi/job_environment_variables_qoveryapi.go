@@ -41,6 +41,32 @@ func (p jobEnvironmentVariablesQoveryAPI) Create(ctx context.Context, jobID stri
 	return newDomainVariableFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the environment variable with the given aliasedVariableID for a job using the given jobID.
+func (p jobEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, jobID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	v, resp, err := p.client.JobEnvironmentVariableApi.
+		CreateJobEnvironmentVariableAlias(ctx, jobID, aliasedVariableID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJobEnvironmentVariable, key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the environment variable with the given overriddenVariableID for a job using the given jobID.
+func (p jobEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, jobID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	v, resp, err := p.client.JobEnvironmentVariableApi.
+		CreateJobEnvironmentVariableOverride(ctx, jobID, overriddenVariableID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJobEnvironmentVariable, overriddenVariableID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment variables from a job using the given jobID and variableID.
 func (p jobEnvironmentVariablesQoveryAPI) List(ctx context.Context, jobID string) (variable.Variables, error) {
 	vars, resp, err := p.client.JobEnvironmentVariableApi.
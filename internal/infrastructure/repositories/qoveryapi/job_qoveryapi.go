@@ -2,9 +2,11 @@ package qoveryapi
 
 import (
 	"context"
+
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 )
@@ -57,7 +59,12 @@ func (c jobQoveryAPI) Create(ctx context.Context, environmentID string, request
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJob, newJob.Id, resp, err)
 	}
 
-	return newDomainJobFromQovery(newJob, deploymentStage.Id)
+	advancedSettings, err := c.editAdvancedSettings(ctx, newJob.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainJobFromQovery(newJob, deploymentStage.Id, advancedSettings)
 }
 
 // Get calls Qovery's API to retrieve a job using the given jobID.
@@ -75,7 +82,12 @@ func (c jobQoveryAPI) Get(ctx context.Context, jobID string) (*job.Job, error) {
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJob, job.Id, resp, err)
 	}
 
-	return newDomainJobFromQovery(job, deploymentStage.Id)
+	advancedSettings, err := c.getAdvancedSettings(ctx, job.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainJobFromQovery(job, deploymentStage.Id, advancedSettings)
 }
 
 // Update calls Qovery's API to update a job using the given jobID and request.
@@ -107,7 +119,12 @@ func (c jobQoveryAPI) Update(ctx context.Context, jobID string, request job.Upse
 		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJob, job.Id, resp, err)
 	}
 
-	return newDomainJobFromQovery(job, deploymentStage.Id)
+	advancedSettings, err := c.editAdvancedSettings(ctx, job.Id, request.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDomainJobFromQovery(job, deploymentStage.Id, advancedSettings)
 }
 
 // Delete calls Qovery's API to deletes a job using the given jobID.
@@ -132,3 +149,43 @@ func (c jobQoveryAPI) Delete(ctx context.Context, jobID string) error {
 
 	return nil
 }
+
+// getAdvancedSettings calls Qovery's API to retrieve the advanced settings of a job using the given jobID.
+func (c jobQoveryAPI) getAdvancedSettings(ctx context.Context, jobID string) (advancedsettings.AdvancedSettings, error) {
+	advancedSettings, resp, err := c.client.JobConfigurationApi.
+		GetJobAdvancedSettings(ctx, jobID).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewReadApiError(apierrors.ApiResourceJobAdvancedSettings, jobID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(advancedSettings)
+}
+
+// editAdvancedSettings calls Qovery's API to update the advanced settings of a job using the given jobID and advancedSettings.
+// The api is only called if the advancedSettings differ from the current ones, the settings that are not part of advancedSettings are kept.
+func (c jobQoveryAPI) editAdvancedSettings(ctx context.Context, jobID string, advancedSettings advancedsettings.AdvancedSettings) (advancedsettings.AdvancedSettings, error) {
+	currentAdvancedSettings, err := c.getAdvancedSettings(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !advancedSettings.HasChanges(currentAdvancedSettings) {
+		return currentAdvancedSettings, nil
+	}
+
+	req, err := newQoveryAdvancedSettingsFromDomain[qovery.JobAdvancedSettings](advancedSettings.Merge(currentAdvancedSettings))
+	if err != nil {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceJobAdvancedSettings, jobID, nil, err)
+	}
+
+	updatedAdvancedSettings, resp, err := c.client.JobConfigurationApi.
+		EditJobAdvancedSettings(ctx, jobID).
+		JobAdvancedSettings(*req).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewUpdateApiError(apierrors.ApiResourceJobAdvancedSettings, jobID, resp, err)
+	}
+
+	return newDomainAdvancedSettingsFromQovery(updatedAdvancedSettings)
+}
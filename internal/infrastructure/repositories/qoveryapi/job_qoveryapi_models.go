@@ -3,6 +3,7 @@ package qoveryapi
 import (
 	"github.com/google/uuid"
 	"github.com/qovery/qovery-client-go"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/docker"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/execution_command"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
@@ -14,7 +15,7 @@ import (
 )
 
 // newDomainCredentialsFromQovery takes a qovery.EnvironmentVariable returned by the API client and turns it into the domain model variable.Variable.
-func newDomainJobFromQovery(j *qovery.JobResponse, deploymentStageID string) (*job.Job, error) {
+func newDomainJobFromQovery(j *qovery.JobResponse, deploymentStageID string, advancedSettings advancedsettings.AdvancedSettings) (*job.Job, error) {
 	if j == nil {
 		return nil, variable.ErrNilVariable
 	}
@@ -56,10 +57,11 @@ func newDomainJobFromQovery(j *qovery.JobResponse, deploymentStageID string) (*j
 	var sourceDocker *docker.NewDockerParams
 	if dockerFrom := j.Source.Docker.Get(); dockerFrom != nil {
 		var gitRepository = git_repository.NewGitRepositoryParams{
-			Url:      "",
-			Branch:   nil,
-			CommitID: nil,
-			RootPath: nil,
+			Url:        "",
+			Branch:     nil,
+			CommitID:   nil,
+			RootPath:   nil,
+			GitTokenID: nil,
 		}
 
 		if gitRepositoryFrom := dockerFrom.GitRepository; gitRepositoryFrom != nil {
@@ -75,6 +77,7 @@ func newDomainJobFromQovery(j *qovery.JobResponse, deploymentStageID string) (*j
 			if gitRepositoryFrom.RootPath != nil {
 				gitRepository.RootPath = gitRepositoryFrom.RootPath
 			}
+			gitRepository.GitTokenID = gitRepositoryFrom.GitTokenId.Get()
 		}
 
 		sourceDocker = &docker.NewDockerParams{
@@ -149,6 +152,8 @@ func newDomainJobFromQovery(j *qovery.JobResponse, deploymentStageID string) (*j
 		MaxNbRestart:       &maxNbRestart,
 		MaxDurationSeconds: &maxDurationSeconds,
 		Port:               prt,
+		Healthchecks:       newDomainHealthchecksFromQovery(j.Healthchecks),
+		AdvancedSettings:   advancedSettings,
 		Source:             jobSource,
 		Schedule:           jobSchedule,
 		DeploymentStageID:  deploymentStageID,
@@ -162,9 +167,10 @@ func newQoveryJobRequestFromDomain(request job.UpsertRepositoryRequest) (*qovery
 		docker = &qovery.JobRequestAllOfSourceDocker{
 			DockerfilePath: *qovery.NewNullableString(request.Source.Docker.DockerFilePath),
 			GitRepository: &qovery.ApplicationGitRepositoryRequest{
-				Url:      request.Source.Docker.GitRepository.Url,
-				Branch:   request.Source.Docker.GitRepository.Branch,
-				RootPath: request.Source.Docker.GitRepository.RootPath,
+				Url:        request.Source.Docker.GitRepository.Url,
+				Branch:     request.Source.Docker.GitRepository.Branch,
+				RootPath:   request.Source.Docker.GitRepository.RootPath,
+				GitTokenId: *qovery.NewNullableString(request.Source.Docker.GitRepository.GitTokenID),
 			},
 		}
 	}
@@ -230,5 +236,6 @@ func newQoveryJobRequestFromDomain(request job.UpsertRepositoryRequest) (*qovery
 			OnDelete: scheduleOnDelete,
 			Cronjob:  scheduleCron,
 		},
+		Healthchecks: newQoveryHealthchecksFromDomain(request.Healthchecks),
 	}, nil
 }
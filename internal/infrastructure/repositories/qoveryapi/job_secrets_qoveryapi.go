@@ -41,6 +41,32 @@ func (p jobSecretsQoveryAPI) Create(ctx context.Context, jobID string, request s
 	return newDomainSecretFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the secret with the given aliasedSecretID for a job using the given jobID.
+func (p jobSecretsQoveryAPI) CreateAlias(ctx context.Context, jobID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	v, resp, err := p.client.JobSecretApi.
+		CreateJobSecretAlias(ctx, jobID, aliasedSecretID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJobSecret, key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the secret with the given overriddenSecretID for a job using the given jobID.
+func (p jobSecretsQoveryAPI) CreateOverride(ctx context.Context, jobID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	v, resp, err := p.client.JobSecretApi.
+		CreateJobSecretOverride(ctx, jobID, overriddenSecretID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceJobSecret, overriddenSecretID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment secrets from a job using the given jobID and secretID.
 func (p jobSecretsQoveryAPI) List(ctx context.Context, jobID string) (secret.Secrets, error) {
 	vars, resp, err := p.client.JobSecretApi.
@@ -1,6 +1,7 @@
 package qoveryapi
 
 import (
+	"github.com/AlekSi/pointer"
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
@@ -44,6 +45,33 @@ func newQoveryPortRequestFromDomain(request port.UpsertRequest) (*qovery.Service
 
 }
 
+// newQoveryServicePortsFromDomain takes the domain requests port.UpsertRequest and turns them into qovery.ServicePort to make the api edit calls requiring port ids.
+func newQoveryServicePortsFromDomain(requests []port.UpsertRequest) ([]qovery.ServicePort, error) {
+	ports := make([]qovery.ServicePort, 0, len(requests))
+	for _, r := range requests {
+		newPort, err := newQoveryPortRequestFromDomain(r)
+		if err != nil {
+			return nil, err
+		}
+
+		portProtocol := qovery.PortProtocolEnum(port.DefaultProtocol.String())
+		if newPort.Protocol != nil {
+			portProtocol = *newPort.Protocol
+		}
+
+		ports = append(ports, qovery.ServicePort{
+			Id:                 pointer.GetString(r.ID),
+			Name:               newPort.Name,
+			Protocol:           portProtocol,
+			PubliclyAccessible: newPort.PubliclyAccessible,
+			InternalPort:       newPort.InternalPort,
+			ExternalPort:       newPort.ExternalPort,
+		})
+	}
+
+	return ports, nil
+}
+
 func newDomainPortsFromQovery(list []qovery.ServicePort) (port.Ports, error) {
 	ports := make(port.Ports, 0, len(list))
 	for _, it := range list {
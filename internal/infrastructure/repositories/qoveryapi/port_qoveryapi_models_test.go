@@ -1,6 +1,8 @@
 package qoveryapi
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/AlekSi/pointer"
@@ -12,6 +14,28 @@ import (
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 )
 
+// TestQoveryPortProtocolEnum makes sure the api client can decode the ports using any of the port.AllowedProtocolValues.
+func TestQoveryPortProtocolEnum(t *testing.T) {
+	t.Parallel()
+
+	for _, protocol := range port.AllowedProtocolValues {
+		protocolStr := protocol.String()
+		t.Run(protocolStr, func(t *testing.T) {
+			var servicePort qovery.ServicePort
+			payload := fmt.Sprintf(`{"id": "%s", "internal_port": 1883, "publicly_accessible": false, "protocol": "%s"}`, gofakeit.UUID(), protocolStr)
+			require.NoError(t, json.Unmarshal([]byte(payload), &servicePort))
+
+			domainPort, err := newDomainPortFromQovery(servicePort)
+			require.NoError(t, err)
+			assert.Equal(t, protocol, *domainPort.Protocol)
+
+			portRequest, err := newQoveryPortRequestFromDomain(port.UpsertRequest{InternalPort: 1883, Protocol: &protocolStr})
+			require.NoError(t, err)
+			assert.Equal(t, protocolStr, string(*portRequest.Protocol))
+		})
+	}
+}
+
 func TestNewDomainPortsFromQovery(t *testing.T) {
 	t.Parallel()
 
@@ -112,3 +136,56 @@ func TestNewQoveryPortsRequestFromDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestNewQoveryServicePortsFromDomain(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Ports         []port.UpsertRequest
+		ExpectedError error
+	}{
+		{
+			TestName: "success_with_nil_ports",
+		},
+		{
+			TestName: "success",
+			Ports: []port.UpsertRequest{
+				{
+					PubliclyAccessible: gofakeit.Bool(),
+					InternalPort:       5000,
+				},
+				{
+					ID:                 pointer.ToString(gofakeit.UUID()),
+					Name:               pointer.ToString(gofakeit.Name()),
+					Protocol:           pointer.ToString(port.ProtocolHTTP.String()),
+					PubliclyAccessible: gofakeit.Bool(),
+					InternalPort:       5000,
+					ExternalPort:       pointer.ToInt32(5001),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			ss, err := newQoveryServicePortsFromDomain(tc.Ports)
+			assert.NoError(t, err)
+			assert.Len(t, tc.Ports, len(ss))
+
+			for idx, s := range ss {
+				assert.Equal(t, pointer.GetString(tc.Ports[idx].ID), s.Id)
+				assert.Equal(t, tc.Ports[idx].Name, s.Name)
+				assert.Equal(t, tc.Ports[idx].ExternalPort, s.ExternalPort)
+				assert.Equal(t, tc.Ports[idx].InternalPort, s.InternalPort)
+				assert.Equal(t, tc.Ports[idx].PubliclyAccessible, s.PubliclyAccessible)
+				if tc.Ports[idx].Protocol != nil {
+					assert.Equal(t, *tc.Ports[idx].Protocol, string(s.Protocol))
+				} else {
+					assert.Equal(t, port.DefaultProtocol.String(), string(s.Protocol))
+				}
+			}
+		})
+	}
+}
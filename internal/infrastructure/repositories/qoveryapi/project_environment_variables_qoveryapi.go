@@ -41,6 +41,32 @@ func (p projectEnvironmentVariablesQoveryAPI) Create(ctx context.Context, projec
 	return newDomainVariableFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the environment variable with the given aliasedVariableID for a project using the given projectID.
+func (p projectEnvironmentVariablesQoveryAPI) CreateAlias(ctx context.Context, projectID string, aliasedVariableID string, key string) (*variable.Variable, error) {
+	v, resp, err := p.client.ProjectEnvironmentVariableApi.
+		CreateProjectEnvironmentVariableAlias(ctx, projectID, aliasedVariableID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceProjectEnvironmentVariable, key, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the environment variable with the given overriddenVariableID for a project using the given projectID.
+func (p projectEnvironmentVariablesQoveryAPI) CreateOverride(ctx context.Context, projectID string, overriddenVariableID string, value string) (*variable.Variable, error) {
+	v, resp, err := p.client.ProjectEnvironmentVariableApi.
+		CreateProjectEnvironmentVariableOverride(ctx, projectID, overriddenVariableID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceProjectEnvironmentVariable, overriddenVariableID, resp, err)
+	}
+
+	return newDomainVariableFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment variables from a project using the given projectID and variableID.
 func (p projectEnvironmentVariablesQoveryAPI) List(ctx context.Context, projectID string) (variable.Variables, error) {
 	vars, resp, err := p.client.ProjectEnvironmentVariableApi.
@@ -41,6 +41,32 @@ func (p projectSecretsQoveryAPI) Create(ctx context.Context, projectID string, r
 	return newDomainSecretFromQovery(v)
 }
 
+// CreateAlias calls Qovery's API to create an alias named key of the secret with the given aliasedSecretID for a project using the given projectID.
+func (p projectSecretsQoveryAPI) CreateAlias(ctx context.Context, projectID string, aliasedSecretID string, key string) (*secret.Secret, error) {
+	v, resp, err := p.client.ProjectSecretApi.
+		CreateProjectSecretAlias(ctx, projectID, aliasedSecretID).
+		Key(qovery.Key{Key: key}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceProjectSecret, key, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
+// CreateOverride calls Qovery's API to override the value of the secret with the given overriddenSecretID for a project using the given projectID.
+func (p projectSecretsQoveryAPI) CreateOverride(ctx context.Context, projectID string, overriddenSecretID string, value string) (*secret.Secret, error) {
+	v, resp, err := p.client.ProjectSecretApi.
+		CreateProjectSecretOverride(ctx, projectID, overriddenSecretID).
+		Value(qovery.Value{Value: value}).
+		Execute()
+	if err != nil || resp.StatusCode >= 400 {
+		return nil, apierrors.NewCreateApiError(apierrors.ApiResourceProjectSecret, overriddenSecretID, resp, err)
+	}
+
+	return newDomainSecretFromQovery(v)
+}
+
 // List calls Qovery's API to retrieve an environment secrets from a project using the given projectID and secretID.
 func (p projectSecretsQoveryAPI) List(ctx context.Context, projectID string) (secret.Secrets, error) {
 	vars, resp, err := p.client.ProjectSecretApi.
@@ -2,22 +2,30 @@ package qoveryapi
 
 import (
 	"fmt"
+	"net/url"
+
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 
 	"github.com/pkg/errors"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/organization"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/internal/httpretry"
 )
 
 var (
@@ -27,6 +35,10 @@ var (
 	ErrInvalidQoveryAPIToken = errors.New("invalid qovery api token")
 	// ErrInvalidUserAgent is returned when the user-agent is invalid.
 	ErrInvalidUserAgent = errors.New("invalid user-agent")
+	// ErrInvalidQoveryAPIURL is returned when the qovery api url is invalid.
+	ErrInvalidQoveryAPIURL = errors.New("invalid qovery api url")
+	// ErrInvalidMaxAttempts is returned when the maximum number of attempts of an api call is invalid.
+	ErrInvalidMaxAttempts = errors.New("invalid max attempts: must be at least 1")
 )
 
 // Configuration represents a function that handle the QoveryAPI configuration.
@@ -38,15 +50,27 @@ type QoveryAPI struct {
 
 	CredentialsAws                 credentials.AwsRepository
 	CredentialsScaleway            credentials.ScalewayRepository
+	CredentialsGcp                 credentials.GcpRepository
+	CredentialsDigitalOcean        credentials.DigitalOceanRepository
 	Organization                   organization.Repository
 	Project                        project.Repository
 	ProjectEnvironmentVariable     variable.Repository
 	ProjectSecret                  secret.Repository
+	Cluster                        cluster.Repository
+	Application                    application.Repository
+	ApplicationDeployment          deployment.Repository
+	ApplicationEnvironmentVariable variable.Repository
+	ApplicationSecret              secret.Repository
+	ApplicationCustomDomain        customdomain.Repository
 	Container                      container.Repository
 	ContainerDeployment            deployment.Repository
 	ContainerEnvironmentVariable   variable.Repository
 	ContainerSecret                secret.Repository
+	ContainerCustomDomain          customdomain.Repository
 	ContainerRegistry              registry.Repository
+	GitToken                       gittoken.Repository
+	Database                       database.Repository
+	DatabaseDeployment             deployment.Repository
 	Job                            job.Repository
 	JobDeployment                  deployment.Repository
 	JobEnvironmentVariable         variable.Repository
@@ -65,6 +89,7 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 	// Initialize the qovery api client.
 	cfg := qovery.NewConfiguration()
 	cfg.AddDefaultHeader("content-type", "application/json")
+	cfg.HTTPClient = httpretry.NewClient()
 	apiClient := qovery.NewAPIClient(cfg)
 
 	// Initialize repositories implementations.
@@ -78,6 +103,16 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
+	credentialsGcpAPI, err := newCredentialsGcpQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsDigitalOceanAPI, err := newCredentialsDigitalOceanQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
 	organizationAPI, err := newOrganizationQoveryAPI(apiClient)
 	if err != nil {
 		return nil, err
@@ -98,6 +133,36 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
+	clusterAPI, err := newClusterQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationAPI, err := newApplicationQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationDeploymentAPI, err := newApplicationDeploymentQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationEnvironmentVariableAPI, err := newApplicationEnvironmentVariablesQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationSecretAPI, err := newApplicationSecretsQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	applicationCustomDomainAPI, err := newApplicationCustomDomainsQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
 	containerAPI, err := newContainerQoveryAPI(apiClient)
 	if err != nil {
 		return nil, err
@@ -118,11 +183,31 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		return nil, err
 	}
 
+	containerCustomDomainAPI, err := newContainerCustomDomainsQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
 	containerRegistryAPI, err := newContainerRegistryQoveryAPI(apiClient)
 	if err != nil {
 		return nil, err
 	}
 
+	gitTokenAPI, err := newGitTokenQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseAPI, err := newDatabaseQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	databaseDeploymentAPI, err := newDatabaseDeploymentQoveryAPI(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
 	jobAPI, err := newJobQoveryAPI(apiClient)
 	if err != nil {
 		return nil, err
@@ -183,15 +268,27 @@ func New(configs ...Configuration) (*QoveryAPI, error) {
 		client:                         apiClient,
 		CredentialsAws:                 credentialsAwsAPI,
 		CredentialsScaleway:            credentialsScalewayAPI,
+		CredentialsGcp:                 credentialsGcpAPI,
+		CredentialsDigitalOcean:        credentialsDigitalOceanAPI,
 		Organization:                   organizationAPI,
 		Project:                        projectAPI,
 		ProjectEnvironmentVariable:     projectEnvironmentVariableAPI,
 		ProjectSecret:                  projectSecretAPI,
+		Cluster:                        clusterAPI,
+		Application:                    applicationAPI,
+		ApplicationDeployment:          applicationDeploymentAPI,
+		ApplicationEnvironmentVariable: applicationEnvironmentVariableAPI,
+		ApplicationSecret:              applicationSecretAPI,
+		ApplicationCustomDomain:        applicationCustomDomainAPI,
 		Container:                      containerAPI,
 		ContainerDeployment:            containerDeploymentAPI,
 		ContainerEnvironmentVariable:   containerEnvironmentVariableAPI,
 		ContainerSecret:                containerSecretAPI,
+		ContainerCustomDomain:          containerCustomDomainAPI,
 		ContainerRegistry:              containerRegistryAPI,
+		GitToken:                       gitTokenAPI,
+		Database:                       databaseAPI,
+		DatabaseDeployment:             databaseDeploymentAPI,
 		Job:                            jobAPI,
 		JobDeployment:                  jobDeploymentAPI,
 		JobEnvironmentVariable:         jobEnvironmentVariableAPI,
@@ -240,3 +337,38 @@ func WithUserAgent(userAgent string) Configuration {
 		return nil
 	}
 }
+
+// WithQoveryAPIURL sets the server url of the qovery api client.
+func WithQoveryAPIURL(apiURL string) Configuration {
+	return func(qoveryAPI *QoveryAPI) error {
+		u, err := url.Parse(apiURL)
+		if err != nil {
+			return errors.Wrap(err, ErrInvalidQoveryAPIURL.Error())
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return ErrInvalidQoveryAPIURL
+		}
+
+		qoveryAPI.client.GetConfig().Servers = qovery.ServerConfigurations{
+			{
+				URL: apiURL,
+			},
+		}
+
+		return nil
+	}
+}
+
+// WithMaxAttempts sets the maximum number of attempts of an api call, retries included.
+// Only the calls that are safe to send again are retried, see the httpretry package.
+func WithMaxAttempts(maxAttempts int) Configuration {
+	return func(qoveryAPI *QoveryAPI) error {
+		if maxAttempts < 1 {
+			return ErrInvalidMaxAttempts
+		}
+
+		qoveryAPI.client.GetConfig().HTTPClient = httpretry.NewClient(httpretry.WithMaxAttempts(maxAttempts))
+
+		return nil
+	}
+}
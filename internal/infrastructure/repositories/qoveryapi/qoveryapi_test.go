@@ -37,6 +37,9 @@ func TestNew(t *testing.T) {
 			assert.NotNil(t, qoveryAPI)
 			assert.NotNil(t, qoveryAPI.CredentialsAws)
 			assert.NotNil(t, qoveryAPI.CredentialsScaleway)
+			assert.NotNil(t, qoveryAPI.CredentialsGcp)
+			assert.NotNil(t, qoveryAPI.CredentialsDigitalOcean)
+			assert.NotNil(t, qoveryAPI.GitToken)
 		})
 	}
 }
@@ -108,3 +111,85 @@ func TestWithQoveryAPIToken(t *testing.T) {
 		})
 	}
 }
+
+func TestWithQoveryAPIURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		APIURL        string
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_empty_api_url",
+			ExpectedError: qoveryapi.ErrInvalidQoveryAPIURL,
+		},
+		{
+			TestName:      "fail_with_api_url_without_scheme",
+			APIURL:        "api.qovery.com",
+			ExpectedError: qoveryapi.ErrInvalidQoveryAPIURL,
+		},
+		{
+			TestName: "success_with_valid_api_url",
+			APIURL:   "http://localhost:8080",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			qoveryAPI, err := qoveryapi.New(qoveryapi.WithQoveryAPIURL(tc.APIURL))
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, qoveryAPI)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, qoveryAPI)
+		})
+	}
+}
+
+func TestWithMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		MaxAttempts   int
+		ExpectedError error
+	}{
+		{
+			TestName:      "fail_with_zero_max_attempts",
+			ExpectedError: qoveryapi.ErrInvalidMaxAttempts,
+		},
+		{
+			TestName:      "fail_with_negative_max_attempts",
+			MaxAttempts:   -1,
+			ExpectedError: qoveryapi.ErrInvalidMaxAttempts,
+		},
+		{
+			TestName:    "success_without_retries",
+			MaxAttempts: 1,
+		},
+		{
+			TestName:    "success_with_retries",
+			MaxAttempts: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			qoveryAPI, err := qoveryapi.New(qoveryapi.WithMaxAttempts(tc.MaxAttempts))
+			if tc.ExpectedError != nil {
+				assert.ErrorContains(t, err, tc.ExpectedError.Error())
+				assert.Nil(t, qoveryAPI)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, qoveryAPI)
+		})
+	}
+}
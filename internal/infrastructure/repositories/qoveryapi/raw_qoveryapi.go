@@ -0,0 +1,107 @@
+package qoveryapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/qovery/qovery-client-go"
+)
+
+// rawAPIError is returned by callRawAPI when the api answers with an error status code.
+// Like the errors of the api client, it exposes the response body so the api error payload can be read from it.
+type rawAPIError struct {
+	status string
+	body   []byte
+}
+
+// Error implements the Error interface.
+func (e rawAPIError) Error() string {
+	return e.status
+}
+
+// Body returns the raw body of the api response.
+func (e rawAPIError) Body() []byte {
+	return e.body
+}
+
+// callRawAPI calls an endpoint of Qovery's API that is not exposed yet by the version of the api client in use.
+// TODO: it is only used for the git tokens, the gcp credentials and the images of the container registries, drop it once the api client exposes them.
+// It reuses the configuration of the api client (server url, default headers, user agent and http client) so the call behaves like the generated ones.
+// The request body is sent as json if not nil and the json response is decoded into out if not nil.
+func callRawAPI(ctx context.Context, client *qovery.APIClient, method string, path string, body interface{}, out interface{}) (*http.Response, error) {
+	cfg := client.GetConfig()
+	serverURL, err := cfg.ServerURLWithContext(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(serverURL, "/")+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range cfg.DefaultHeader {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set("User-Agent", cfg.UserAgent)
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return resp, err
+	}
+	// NOTE: restore the body so it can still be read by the caller, like the api client does.
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if resp.StatusCode >= 300 {
+		return resp, rawAPIError{status: resp.Status, body: respBody}
+	}
+
+	if out != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return resp, errors.Wrap(err, fmt.Sprintf("failed to decode response of %s %s", method, path))
+		}
+	}
+
+	return resp, nil
+}
+
+// credentialsPath returns the api path of the credentials of the given cloud provider of an organization,
+// or of one of them if a credentialsID is given.
+func credentialsPath(cloudProvider string, organizationID string, credentialsID ...string) string {
+	p := fmt.Sprintf("/organization/%s/%s/credentials", url.PathEscape(organizationID), cloudProvider)
+	for _, id := range credentialsID {
+		p += "/" + url.PathEscape(id)
+	}
+
+	return p
+}
@@ -0,0 +1,87 @@
+package qoveryapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+func TestCallRawAPI(t *testing.T) {
+	t.Parallel()
+
+	credentialsID := uuid.NewString()
+	userAgent := gofakeit.UserAgent()
+
+	testCases := []struct {
+		TestName            string
+		StatusCode          int
+		ResponseBody        string
+		ExpectedCredentials *qovery.ClusterCredentials
+		ExpectedMessage     string
+	}{
+		{
+			TestName:            "success",
+			StatusCode:          http.StatusCreated,
+			ResponseBody:        `{"id": "` + credentialsID + `", "name": "my-credentials"}`,
+			ExpectedCredentials: &qovery.ClusterCredentials{Id: credentialsID, Name: "my-credentials"},
+		},
+		{
+			TestName:        "fail_with_error_payload",
+			StatusCode:      http.StatusBadRequest,
+			ResponseBody:    `{"status": 400, "message": "invalid gcp credentials"}`,
+			ExpectedMessage: "invalid gcp credentials",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			organizationID := uuid.NewString()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "/organization/"+organizationID+"/gcp/credentials", r.URL.Path)
+				assert.Equal(t, "Token my-token", r.Header.Get("Authorization"))
+				assert.Equal(t, userAgent, r.Header.Get("User-Agent"))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				var body gcpCredentialsRequest
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				assert.Equal(t, "my-credentials", body.Name)
+
+				w.WriteHeader(tc.StatusCode)
+				_, _ = w.Write([]byte(tc.ResponseBody))
+			}))
+			defer server.Close()
+
+			qoveryAPI, err := New(WithQoveryAPIURL(server.URL), WithQoveryAPIToken("my-token"), WithUserAgent(userAgent))
+			require.NoError(t, err)
+
+			var creds qovery.ClusterCredentials
+			resp, err := callRawAPI(context.Background(), qoveryAPI.client, http.MethodPost, credentialsPath("gcp", organizationID), gcpCredentialsRequest{Name: "my-credentials"}, &creds)
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.StatusCode, resp.StatusCode)
+			if tc.ExpectedMessage != "" {
+				apiErr := apierrors.NewCreateApiError(apierrors.ApiResourceGcpCredentials, "my-credentials", resp, err)
+				require.NotNil(t, apiErr.Payload())
+				assert.Equal(t, tc.ExpectedMessage, apiErr.Payload().Message)
+				assert.True(t, apiErr.IsBadRequest())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.ExpectedCredentials, &creds)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/qovery/qovery-client-go"
 
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
 // newDomainCredentialsFromQovery takes a qovery.Secret returned by the API client and turns it into the domain model secret.Secret.
@@ -27,19 +28,44 @@ func newDomainSecretFromQovery(v *qovery.Secret) (*secret.Secret, error) {
 		return nil, secret.ErrNilSecret
 	}
 
+	var aliasedSecret *variable.NewReferenceParams
+	if v.AliasedSecret != nil {
+		aliasedSecret = &variable.NewReferenceParams{
+			VariableID: v.AliasedSecret.Id,
+			Key:        v.AliasedSecret.Key,
+		}
+	}
+
+	var overriddenSecret *variable.NewReferenceParams
+	if v.OverriddenSecret != nil {
+		overriddenSecret = &variable.NewReferenceParams{
+			VariableID: v.OverriddenSecret.Id,
+			Key:        v.OverriddenSecret.Key,
+		}
+	}
+
+	// NOTE: the mount path of a file secret isn't returned by the API.
 	return secret.NewSecret(secret.NewSecretParams{
-		SecretID: v.GetId(),
-		Scope:    string(v.Scope),
-		Key:      v.GetKey(),
+		SecretID:         v.GetId(),
+		Scope:            string(v.Scope),
+		Type:             string(v.GetVariableType()),
+		Key:              v.GetKey(),
+		AliasedSecret:    aliasedSecret,
+		OverriddenSecret: overriddenSecret,
 	})
 }
 
 // newQoverySecretRequestFromDomain takes the domain request secret.UpsertRequest and turns it into a qovery.SecretRequest to make the api call.
 func newQoverySecretRequestFromDomain(request secret.UpsertRequest) qovery.SecretRequest {
-	return qovery.SecretRequest{
+	req := qovery.SecretRequest{
 		Key:   request.Key,
 		Value: request.Value,
 	}
+	if request.MountPath != nil {
+		req.MountPath = *qovery.NewNullableString(request.MountPath)
+	}
+
+	return req
 }
 
 // newQoverySecretEditRequestFromDomain takes the domain request secret.UpsertRequest and turns it into a qovery.SecretEditRequest to make the api call.
@@ -27,20 +27,45 @@ func newDomainVariableFromQovery(v *qovery.EnvironmentVariable) (*variable.Varia
 		return nil, variable.ErrNilVariable
 	}
 
+	var aliasedVariable *variable.NewReferenceParams
+	if v.AliasedVariable != nil {
+		aliasedVariable = &variable.NewReferenceParams{
+			VariableID: v.AliasedVariable.Id,
+			Key:        v.AliasedVariable.Key,
+		}
+	}
+
+	var overriddenVariable *variable.NewReferenceParams
+	if v.OverriddenVariable != nil {
+		overriddenVariable = &variable.NewReferenceParams{
+			VariableID: v.OverriddenVariable.Id,
+			Key:        v.OverriddenVariable.Key,
+		}
+	}
+
 	return variable.NewVariable(variable.NewVariableParams{
-		VariableID: v.GetId(),
-		Scope:      string(v.Scope),
-		Key:        v.Key,
-		Value:      v.Value,
+		VariableID:         v.GetId(),
+		Scope:              string(v.Scope),
+		Type:               string(v.GetVariableType()),
+		Key:                v.Key,
+		Value:              v.Value,
+		MountPath:          v.MountPath.Get(),
+		AliasedVariable:    aliasedVariable,
+		OverriddenVariable: overriddenVariable,
 	})
 }
 
 // newQoveryEnvironmentVariableRequestFromDomain takes the domain request variable.UpsertRequest and turns it into a qovery.EnvironmentVariableRequest to make the api call.
 func newQoveryEnvironmentVariableRequestFromDomain(request variable.UpsertRequest) qovery.EnvironmentVariableRequest {
-	return qovery.EnvironmentVariableRequest{
+	req := qovery.EnvironmentVariableRequest{
 		Key:   request.Key,
 		Value: request.Value,
 	}
+	if request.MountPath != nil {
+		req.MountPath = *qovery.NewNullableString(request.MountPath)
+	}
+
+	return req
 }
 
 // newQoveryEnvironmentVariableEditRequestFromDomain takes the domain request variable.UpsertRequest and turns it into a qovery.EnvironmentVariableEditRequest to make the api call.
@@ -3,6 +3,7 @@ package qoveryapi
 import (
 	"testing"
 
+	"github.com/AlekSi/pointer"
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/qovery/qovery-client-go"
 	"github.com/stretchr/testify/assert"
@@ -40,6 +41,35 @@ func TestNewDomainVariablesFromQovery(t *testing.T) {
 				},
 			},
 		},
+		{
+			TestName: "success_with_alias_and_override",
+			Variables: &qovery.EnvironmentVariableResponseList{
+				Results: []qovery.EnvironmentVariable{
+					{
+						Id:           gofakeit.UUID(),
+						Scope:        qovery.APIVARIABLESCOPEENUM_APPLICATION,
+						VariableType: qovery.APIVARIABLETYPEENUM_ALIAS.Ptr(),
+						Key:          gofakeit.Word(),
+						Value:        gofakeit.Word(),
+						AliasedVariable: &qovery.EnvironmentVariableAlias{
+							Id:  gofakeit.UUID(),
+							Key: gofakeit.Word(),
+						},
+					},
+					{
+						Id:           gofakeit.UUID(),
+						Scope:        qovery.APIVARIABLESCOPEENUM_APPLICATION,
+						VariableType: qovery.APIVARIABLETYPEENUM_OVERRIDE.Ptr(),
+						Key:          gofakeit.Word(),
+						Value:        gofakeit.Word(),
+						OverriddenVariable: &qovery.EnvironmentVariableOverride{
+							Id:  gofakeit.UUID(),
+							Key: gofakeit.Word(),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -55,6 +85,26 @@ func TestNewDomainVariablesFromQovery(t *testing.T) {
 				assert.Equal(t, string(tc.Variables.GetResults()[idx].Scope), v.Scope.String())
 				assert.Equal(t, tc.Variables.GetResults()[idx].Key, v.Key)
 				assert.Equal(t, tc.Variables.GetResults()[idx].Value, v.Value)
+
+				expectedType := variable.TypeValue
+				if variableType := tc.Variables.GetResults()[idx].VariableType; variableType != nil {
+					expectedType = variable.Type(*variableType)
+				}
+				assert.Equal(t, expectedType, v.Type)
+
+				if aliased := tc.Variables.GetResults()[idx].AliasedVariable; aliased != nil {
+					assert.Equal(t, aliased.Id, v.AliasedVariable.ID.String())
+					assert.Equal(t, aliased.Key, v.AliasedVariable.Key)
+				} else {
+					assert.Nil(t, v.AliasedVariable)
+				}
+
+				if overridden := tc.Variables.GetResults()[idx].OverriddenVariable; overridden != nil {
+					assert.Equal(t, overridden.Id, v.OverriddenVariable.ID.String())
+					assert.Equal(t, overridden.Key, v.OverriddenVariable.Key)
+				} else {
+					assert.Nil(t, v.OverriddenVariable)
+				}
 			}
 		})
 	}
@@ -75,6 +125,14 @@ func TestNewQoveryEnvironmentVariableRequestFromDomain(t *testing.T) {
 				Value: gofakeit.Word(),
 			},
 		},
+		{
+			TestName: "success_with_mount_path",
+			Request: variable.UpsertRequest{
+				Key:       gofakeit.Word(),
+				Value:     gofakeit.Word(),
+				MountPath: pointer.ToString("/etc/config.json"),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -83,6 +141,7 @@ func TestNewQoveryEnvironmentVariableRequestFromDomain(t *testing.T) {
 			req := newQoveryEnvironmentVariableRequestFromDomain(tc.Request)
 			assert.Equal(t, tc.Request.Key, req.Key)
 			assert.Equal(t, tc.Request.Value, req.Value)
+			assert.Equal(t, tc.Request.MountPath, req.MountPath.Get())
 		})
 	}
 }
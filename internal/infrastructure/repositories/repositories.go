@@ -6,11 +6,16 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/organization"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
@@ -30,15 +35,27 @@ type Configuration func(repos *Repositories) error
 type Repositories struct {
 	CredentialsAws                 credentials.AwsRepository
 	CredentialsScaleway            credentials.ScalewayRepository
+	CredentialsGcp                 credentials.GcpRepository
+	CredentialsDigitalOcean        credentials.DigitalOceanRepository
 	Organization                   organization.Repository
 	Project                        project.Repository
 	ProjectEnvironmentVariable     variable.Repository
 	ProjectSecret                  secret.Repository
+	Cluster                        cluster.Repository
+	Application                    application.Repository
+	ApplicationDeployment          deployment.Repository
+	ApplicationEnvironmentVariable variable.Repository
+	ApplicationSecret              secret.Repository
+	ApplicationCustomDomain        customdomain.Repository
 	Container                      container.Repository
 	ContainerDeployment            deployment.Repository
 	ContainerEnvironmentVariable   variable.Repository
 	ContainerSecret                secret.Repository
+	ContainerCustomDomain          customdomain.Repository
 	ContainerRegistry              registry.Repository
+	GitToken                       gittoken.Repository
+	Database                       database.Repository
+	DatabaseDeployment             deployment.Repository
 	Job                            job.Repository
 	JobDeployment                  deployment.Repository
 	JobEnvironmentVariable         variable.Repository
@@ -69,22 +86,38 @@ func New(configs ...Configuration) (*Repositories, error) {
 	return repos, nil
 }
 
-func WithQoveryAPI(apiToken string, providerVersion string) Configuration {
+func WithQoveryAPI(apiToken string, providerVersion string, apiURL string, maxAttempts int) Configuration {
 	return func(repos *Repositories) error {
-		qoveryAPI, err := qoveryapi.New(
+		configs := []qoveryapi.Configuration{
 			qoveryapi.WithQoveryAPIToken(apiToken),
 			qoveryapi.WithUserAgent(fmt.Sprintf("terraform-provider-qovery/%s", providerVersion)),
-		)
+		}
+		if apiURL != "" {
+			configs = append(configs, qoveryapi.WithQoveryAPIURL(apiURL))
+		}
+		if maxAttempts != 0 {
+			configs = append(configs, qoveryapi.WithMaxAttempts(maxAttempts))
+		}
+
+		qoveryAPI, err := qoveryapi.New(configs...)
 		if err != nil {
 			return errors.Wrap(err, ErrFailedToInitializeQoveryAPI.Error())
 		}
 
 		repos.CredentialsAws = qoveryAPI.CredentialsAws
 		repos.CredentialsScaleway = qoveryAPI.CredentialsScaleway
+		repos.CredentialsGcp = qoveryAPI.CredentialsGcp
+		repos.CredentialsDigitalOcean = qoveryAPI.CredentialsDigitalOcean
 		repos.Organization = qoveryAPI.Organization
 		repos.Project = qoveryAPI.Project
 		repos.ProjectEnvironmentVariable = qoveryAPI.ProjectEnvironmentVariable
 		repos.ProjectSecret = qoveryAPI.ProjectSecret
+		repos.Cluster = qoveryAPI.Cluster
+		repos.Application = qoveryAPI.Application
+		repos.ApplicationDeployment = qoveryAPI.ApplicationDeployment
+		repos.ApplicationEnvironmentVariable = qoveryAPI.ApplicationEnvironmentVariable
+		repos.ApplicationSecret = qoveryAPI.ApplicationSecret
+		repos.ApplicationCustomDomain = qoveryAPI.ApplicationCustomDomain
 		repos.Container = qoveryAPI.Container
 		repos.Job = qoveryAPI.Job
 		repos.JobDeployment = qoveryAPI.JobDeployment
@@ -93,7 +126,11 @@ func WithQoveryAPI(apiToken string, providerVersion string) Configuration {
 		repos.ContainerDeployment = qoveryAPI.ContainerDeployment
 		repos.ContainerEnvironmentVariable = qoveryAPI.ContainerEnvironmentVariable
 		repos.ContainerSecret = qoveryAPI.ContainerSecret
+		repos.ContainerCustomDomain = qoveryAPI.ContainerCustomDomain
 		repos.ContainerRegistry = qoveryAPI.ContainerRegistry
+		repos.GitToken = qoveryAPI.GitToken
+		repos.Database = qoveryAPI.Database
+		repos.DatabaseDeployment = qoveryAPI.DatabaseDeployment
 		repos.Environment = qoveryAPI.Environment
 		repos.EnvironmentDeployment = qoveryAPI.EnvironmentDeployment
 		repos.EnvironmentEnvironmentVariable = qoveryAPI.EnvironmentEnvironmentVariable
@@ -0,0 +1,264 @@
+// Package poller provides a context aware way to wait for a resource to reach a given state.
+// It polls the resource using an exponential backoff with jitter and classifies the state
+// returned by the API using the status.State domain model.
+package poller
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+)
+
+const (
+	// DefaultTimeout is the maximum time spent polling when no timeout is given.
+	// A shorter deadline set on the context takes precedence.
+	DefaultTimeout = 4 * time.Hour
+	// DefaultInitialInterval is the time to wait between the first two polls.
+	DefaultInitialInterval = 5 * time.Second
+	// DefaultMaxInterval is the maximum time to wait between two polls.
+	DefaultMaxInterval = 30 * time.Second
+	// DefaultMultiplier is the factor applied to the interval after each poll.
+	DefaultMultiplier = 1.5
+	// DefaultJitter is the randomization factor applied to each interval.
+	DefaultJitter = 0.2
+	// DefaultUnexpectedStateTolerance is the number of consecutive unexpected terminal states tolerated before failing.
+	// The Qovery API can report the previous state of a resource right after an action has been requested.
+	DefaultUnexpectedStateTolerance = 5
+)
+
+var (
+	// ErrWaitTimeout is returned when the resource didn't reach the expected state before the timeout or the deadline of the context.
+	ErrWaitTimeout = errors.New("timeout while waiting for the resource to reach the expected state")
+	// ErrUnexpectedState is returned when the resource reached a terminal state that is not the expected one.
+	ErrUnexpectedState = errors.New("unexpected state")
+	// ErrUnknownState is returned when the state of the resource is unknown.
+	ErrUnknownState = errors.New("unknown state")
+)
+
+// ConditionFunc returns true when the polling is done.
+type ConditionFunc func(ctx context.Context) (bool, error)
+
+// StateFunc returns the current state of the polled resource.
+type StateFunc func(ctx context.Context) (status.State, error)
+
+type config struct {
+	timeout                  time.Duration
+	initialInterval          time.Duration
+	maxInterval              time.Duration
+	multiplier               float64
+	jitter                   float64
+	unexpectedStateTolerance int
+}
+
+// Option allows to customize the behaviour of the poller.
+type Option func(*config)
+
+// WithTimeout sets the maximum time spent polling.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// WithInterval sets the initial and the maximum time to wait between two polls.
+func WithInterval(initial time.Duration, max time.Duration) Option {
+	return func(c *config) {
+		if initial > 0 {
+			c.initialInterval = initial
+		}
+		if max >= c.initialInterval {
+			c.maxInterval = max
+		}
+	}
+}
+
+// WithMultiplier sets the factor applied to the interval after each poll.
+func WithMultiplier(multiplier float64) Option {
+	return func(c *config) {
+		if multiplier >= 1 {
+			c.multiplier = multiplier
+		}
+	}
+}
+
+// WithJitter sets the randomization factor applied to each interval, between 0 and 1.
+func WithJitter(jitter float64) Option {
+	return func(c *config) {
+		if jitter >= 0 && jitter <= 1 {
+			c.jitter = jitter
+		}
+	}
+}
+
+// WithUnexpectedStateTolerance sets the number of consecutive unexpected terminal states tolerated before failing.
+func WithUnexpectedStateTolerance(tolerance int) Option {
+	return func(c *config) {
+		if tolerance >= 0 {
+			c.unexpectedStateTolerance = tolerance
+		}
+	}
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		timeout:                  DefaultTimeout,
+		initialInterval:          DefaultInitialInterval,
+		maxInterval:              DefaultMaxInterval,
+		multiplier:               DefaultMultiplier,
+		jitter:                   DefaultJitter,
+		unexpectedStateTolerance: DefaultUnexpectedStateTolerance,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// timeoutError is returned when the timeout expires, it matches ErrWaitTimeout and wraps the error that interrupted the polling.
+type timeoutError struct {
+	cause error
+}
+
+// Error implements the Error interface.
+func (e timeoutError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrWaitTimeout, e.cause)
+}
+
+// Is returns true if the target is ErrWaitTimeout.
+func (e timeoutError) Is(target error) bool {
+	return target == ErrWaitTimeout
+}
+
+// Unwrap returns the error that interrupted the polling.
+func (e timeoutError) Unwrap() error {
+	return e.cause
+}
+
+// Until calls f until it returns true or an error.
+// It returns an error wrapping ErrWaitTimeout if the timeout or the deadline of the context expires,
+// and the error of the context if it is cancelled.
+func Until(ctx context.Context, f ConditionFunc, opts ...Option) error {
+	cfg := newConfig(opts)
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
+	defer cancel()
+
+	interval := cfg.initialInterval
+	for {
+		done, err := f(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return newContextError(ctx, err)
+			}
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		if err := sleep(ctx, withJitter(interval, cfg.jitter)); err != nil {
+			return newContextError(ctx, err)
+		}
+		interval = nextInterval(interval, cfg)
+	}
+}
+
+// UntilState calls f until the resource reaches one of the expected states.
+// In progress states are waited for, while unexpected terminal states are only tolerated a few consecutive times
+// before returning an error wrapping ErrUnexpectedState.
+func UntilState(ctx context.Context, f StateFunc, expected []status.State, opts ...Option) error {
+	cfg := newConfig(opts)
+
+	unexpectedCount := 0
+	return Until(ctx, func(ctx context.Context) (bool, error) {
+		state, err := f(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		for _, e := range expected {
+			if state == e {
+				return true, nil
+			}
+		}
+
+		switch {
+		case state.IsInProgress():
+			unexpectedCount = 0
+			return false, nil
+		case state.IsTerminal():
+			unexpectedCount++
+			if unexpectedCount > cfg.unexpectedStateTolerance {
+				return false, fmt.Errorf("%w: expected %s but got %s", ErrUnexpectedState, expected, state)
+			}
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%w: %s", ErrUnknownState, state)
+	}, opts...)
+}
+
+// UntilTerminalState calls f until the resource reaches a terminal state, whether successful or not.
+func UntilTerminalState(ctx context.Context, f StateFunc, opts ...Option) error {
+	return Until(ctx, func(ctx context.Context) (bool, error) {
+		state, err := f(ctx)
+		if err != nil {
+			return false, err
+		}
+
+		if !state.IsValid() {
+			return false, fmt.Errorf("%w: %s", ErrUnknownState, state)
+		}
+
+		return state.IsTerminal(), nil
+	}, opts...)
+}
+
+// newContextError returns the error to return once the given context is done, with the given cause.
+// It wraps ErrWaitTimeout and the cause if the deadline of the context expired, or returns the error of the context if it has been cancelled.
+func newContextError(ctx context.Context, cause error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ctx.Err()
+	}
+
+	return timeoutError{cause: cause}
+}
+
+func nextInterval(interval time.Duration, cfg *config) time.Duration {
+	next := time.Duration(float64(interval) * cfg.multiplier)
+	if next > cfg.maxInterval {
+		return cfg.maxInterval
+	}
+
+	return next
+}
+
+func withJitter(interval time.Duration, jitter float64) time.Duration {
+	if jitter == 0 {
+		return interval
+	}
+
+	delta := jitter * float64(interval)
+	return time.Duration(float64(interval) - delta + rand.Float64()*2*delta)
+}
+
+// sleep pauses the current goroutine for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
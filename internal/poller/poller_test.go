@@ -0,0 +1,233 @@
+package poller_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
+	"github.com/qovery/terraform-provider-qovery/internal/poller"
+)
+
+var fastOptions = []poller.Option{
+	poller.WithInterval(time.Millisecond, 2*time.Millisecond),
+	poller.WithJitter(0),
+}
+
+// newStateSequence returns a poller.StateFunc returning the given states one after the other.
+// The last state is returned forever once the sequence is exhausted.
+func newStateSequence(states ...status.State) (poller.StateFunc, *int) {
+	calls := 0
+	return func(ctx context.Context) (status.State, error) {
+		idx := calls
+		if idx >= len(states) {
+			idx = len(states) - 1
+		}
+		calls++
+		return states[idx], nil
+	}, &calls
+}
+
+func TestUntil(t *testing.T) {
+	t.Parallel()
+
+	errAPI := errors.New("api error")
+
+	testCases := []struct {
+		TestName      string
+		Condition     poller.ConditionFunc
+		Options       []poller.Option
+		ExpectedError error
+	}{
+		{
+			TestName: "success_done",
+			Condition: func(ctx context.Context) (bool, error) {
+				return true, nil
+			},
+		},
+		{
+			TestName: "fail_on_error",
+			Condition: func(ctx context.Context) (bool, error) {
+				return false, errAPI
+			},
+			ExpectedError: errAPI,
+		},
+		{
+			TestName: "fail_on_timeout",
+			Condition: func(ctx context.Context) (bool, error) {
+				return false, nil
+			},
+			Options:       []poller.Option{poller.WithTimeout(10 * time.Millisecond)},
+			ExpectedError: poller.ErrWaitTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := poller.Until(context.Background(), tc.Condition, append(fastOptions, tc.Options...)...)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUntil_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := poller.Until(ctx, func(ctx context.Context) (bool, error) {
+		return false, nil
+	}, fastOptions...)
+	assert.ErrorIs(t, err, poller.ErrWaitTimeout)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestUntil_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := poller.Until(ctx, func(ctx context.Context) (bool, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return false, nil
+	}, fastOptions...)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.NotErrorIs(t, err, poller.ErrWaitTimeout)
+}
+
+func TestUntil_TimeoutWrapsCause(t *testing.T) {
+	t.Parallel()
+
+	errAPI := errors.New("api error")
+	err := poller.Until(context.Background(), func(ctx context.Context) (bool, error) {
+		<-ctx.Done()
+		return false, errAPI
+	}, append(fastOptions, poller.WithTimeout(10*time.Millisecond))...)
+	assert.ErrorIs(t, err, poller.ErrWaitTimeout)
+	assert.ErrorIs(t, err, errAPI)
+}
+
+func TestUntilState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		States        []status.State
+		Expected      []status.State
+		ExpectedCalls int
+		ExpectedError error
+	}{
+		{
+			TestName:      "success_expected_state",
+			States:        []status.State{status.StateDeployed},
+			Expected:      []status.State{status.StateDeployed},
+			ExpectedCalls: 1,
+		},
+		{
+			TestName:      "success_after_in_progress_states",
+			States:        []status.State{status.StateDeploymentQueued, status.StateBuilding, status.StateDeploying, status.StateDeployed},
+			Expected:      []status.State{status.StateDeployed},
+			ExpectedCalls: 4,
+		},
+		{
+			TestName:      "success_one_of_expected_states",
+			States:        []status.State{status.StateRestarting, status.StateDeployed},
+			Expected:      []status.State{status.StateRestarted, status.StateDeployed},
+			ExpectedCalls: 2,
+		},
+		{
+			TestName:      "success_after_tolerated_previous_state",
+			States:        []status.State{status.StateStopped, status.StateStopped, status.StateDeploying, status.StateDeployed},
+			Expected:      []status.State{status.StateDeployed},
+			ExpectedCalls: 4,
+		},
+		{
+			TestName:      "fail_unexpected_terminal_state",
+			States:        []status.State{status.StateDeploying, status.StateDeploymentError},
+			Expected:      []status.State{status.StateDeployed},
+			ExpectedCalls: 2 + poller.DefaultUnexpectedStateTolerance,
+			ExpectedError: poller.ErrUnexpectedState,
+		},
+		{
+			TestName:      "fail_unknown_state",
+			States:        []status.State{"UNKNOWN"},
+			Expected:      []status.State{status.StateDeployed},
+			ExpectedCalls: 1,
+			ExpectedError: poller.ErrUnknownState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			f, calls := newStateSequence(tc.States...)
+			err := poller.UntilState(context.Background(), f, tc.Expected, fastOptions...)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedCalls, *calls)
+		})
+	}
+}
+
+func TestUntilTerminalState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		States        []status.State
+		ExpectedCalls int
+		ExpectedError error
+	}{
+		{
+			TestName:      "success_terminal_state",
+			States:        []status.State{status.StateReady},
+			ExpectedCalls: 1,
+		},
+		{
+			TestName:      "success_error_state",
+			States:        []status.State{status.StateStopping, status.StateStopError},
+			ExpectedCalls: 2,
+		},
+		{
+			TestName:      "fail_unknown_state",
+			States:        []status.State{status.StateDeleting, "UNKNOWN"},
+			ExpectedCalls: 2,
+			ExpectedError: poller.ErrUnknownState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			f, calls := newStateSequence(tc.States...)
+			err := poller.UntilTerminalState(context.Background(), f, fastOptions...)
+			if tc.ExpectedError != nil {
+				assert.ErrorIs(t, err, tc.ExpectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.ExpectedCalls, *calls)
+		})
+	}
+}
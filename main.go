@@ -16,6 +16,10 @@ import (
 // ensure the documentation is formatted properly.
 //go:generate terraform fmt -recursive ./examples/
 
+// Generate the advanced settings schemas of the services and clusters from the models of the api client, before the
+// documentation so it describes them.
+//go:generate go run ./scripts/advancedsettings -output ./qovery/advanced_settings_gen.go
+
 // Run the documentation generation tool, check its repository for more information on how it works and how docs
 // can be customized.
 //go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs
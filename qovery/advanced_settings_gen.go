@@ -0,0 +1,685 @@
+// Code generated by scripts/advancedsettings from github.com/qovery/qovery-client-go v0.0.0-20230425070439-b167214fb241; DO NOT EDIT.
+
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
+)
+
+// clusterAdvancedSettings holds the advanced settings attributes generated from qovery.ClusterAdvancedSettings.
+var clusterAdvancedSettings = map[string]advSettingAttr{
+	"aws.cloudwatch.eks_logs_retention_days": {
+		description:   descriptions.NewInt64DefaultDescription("Set the number of retention days for EKS Cloudwatch logs.", 90),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(90)},
+		defaultValue:  types.Int64{Value: 90},
+	},
+	"aws.vpc.enable_s3_flow_logs": {
+		description:   descriptions.NewBoolDefaultDescription("Enable flow logs for on the VPC and store them in an S3 bucket.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"aws.vpc.flow_logs_retention_days": {
+		description:   descriptions.NewInt64DefaultDescription("Set the number of retention days for flow logs. Disable with value \"0\".", 365),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(365)},
+		defaultValue:  types.Int64{Value: 365},
+	},
+	"loki.log_retention_in_week": {
+		description:   descriptions.NewInt64DefaultDescription("For how long in week loki is going to keep logs of your applications.", 12),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(12)},
+		defaultValue:  types.Int64{Value: 12},
+	},
+	"registry.image_retention_time": {
+		description:   descriptions.NewInt64DefaultDescription("Configure the number of seconds before cleaning images in the registry.", 31536000),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(31536000)},
+		defaultValue:  types.Int64{Value: 31536000},
+	},
+	"cloud_provider.container_registry.tags": {
+		description:   "Add additional tags on the cluster dedicated registry.",
+		_type:         types.MapType{ElemType: types.StringType},
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringMapDefaultModifier(map[string]string{})},
+		defaultValue:  types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}},
+	},
+	"load_balancer.size": {
+		description:   descriptions.NewStringDefaultDescription("Select the size of the main load_balancer (only effective for Scaleway).", "lb-s"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("lb-s")},
+		defaultValue:  types.String{Value: "lb-s"},
+	},
+	"database.postgresql.deny_public_access": {
+		description:   descriptions.NewBoolDefaultDescription("Deny public access to any PostgreSQL database.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"database.postgresql.allowed_cidrs": {
+		description:   "List of CIDRs allowed to access the PostgreSQL database.",
+		_type:         types.SetType{ElemType: types.StringType},
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"})},
+		defaultValue:  types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}},
+	},
+	"database.mysql.deny_public_access": {
+		description:   descriptions.NewBoolDefaultDescription("Deny public access to any MySql database.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"database.mysql.allowed_cidrs": {
+		description:   "List of CIDRs allowed to access the MySql database.",
+		_type:         types.SetType{ElemType: types.StringType},
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"})},
+		defaultValue:  types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}},
+	},
+	"database.mongodb.deny_public_access": {
+		description:   descriptions.NewBoolDefaultDescription("Deny public access to any MongoDB/DocumentDB database.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"database.mongodb.allowed_cidrs": {
+		description:   "List of CIDRs allowed to access the MongoDB/DocumentDB database.",
+		_type:         types.SetType{ElemType: types.StringType},
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"})},
+		defaultValue:  types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}},
+	},
+	"database.redis.deny_public_access": {
+		description:   descriptions.NewBoolDefaultDescription("Deny public access to any Redis database.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"database.redis.allowed_cidrs": {
+		description:   "List of CIDRs allowed to access the Redis database.",
+		_type:         types.SetType{ElemType: types.StringType},
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"})},
+		defaultValue:  types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}},
+	},
+	"aws.iam.admin_group": {
+		description:   descriptions.NewStringDefaultDescription("AWS IAM group name with cluster access.", "Admins"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("Admins")},
+		defaultValue:  types.String{Value: "Admins"},
+	},
+	"aws.eks.ec2.metadata_imds": {
+		description:   descriptions.NewStringDefaultDescription("Specify the [IMDS](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-metadata.html) version you want to use: * `required`: IMDS V2 only * `optional`: IMDS V1 + V2.", "Optional"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("Optional")},
+		defaultValue:  types.String{Value: "Optional"},
+	},
+	"pleco.resources_ttl": {
+		description:   descriptions.NewInt64DefaultDescription("Deprecated.", -1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(-1)},
+		defaultValue:  types.Int64{Value: -1},
+	},
+}
+
+// applicationAdvancedSettings holds the advanced settings attributes generated from qovery.ApplicationAdvancedSettings.
+var applicationAdvancedSettings = map[string]advSettingAttr{
+	"deployment.delay_start_time_sec": {
+		description:   descriptions.NewInt64DefaultDescription("Please use `readiness_probe.initial_delay_seconds` and `liveness_probe.initial_delay_seconds` instead. Deprecated.", 30),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(30)},
+		defaultValue:  types.Int64{Value: 30},
+	},
+	"deployment.custom_domain_check_enabled": {
+		description:   descriptions.NewBoolDefaultDescription("Disable custom domain check when deploying an application.", true),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(true)},
+		defaultValue:  types.Bool{Value: true},
+	},
+	"deployment.termination_grace_period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Define how long in seconds an application is supposed to be stopped gracefully.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"build.timeout_max_sec": {
+		description:   descriptions.NewInt64DefaultDescription("Build timeout max sec.", 1800),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1800)},
+		defaultValue:  types.Int64{Value: 1800},
+	},
+	"network.ingress.proxy_body_size_mb": {
+		description:   descriptions.NewInt64DefaultDescription("Network ingress proxy body size mb.", 100),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(100)},
+		defaultValue:  types.Int64{Value: 100},
+	},
+	"network.ingress.enable_cors": {
+		description:   descriptions.NewBoolDefaultDescription("Network ingress enable cors.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"network.ingress.cors_allow_origin": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow origin.", "*"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("*")},
+		defaultValue:  types.String{Value: "*"},
+	},
+	"network.ingress.cors_allow_methods": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow methods.", "GET, PUT, POST, DELETE, PATCH, OPTIONS"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("GET, PUT, POST, DELETE, PATCH, OPTIONS")},
+		defaultValue:  types.String{Value: "GET, PUT, POST, DELETE, PATCH, OPTIONS"},
+	},
+	"network.ingress.cors_allow_headers": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow headers.", "DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization")},
+		defaultValue:  types.String{Value: "DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization"},
+	},
+	"network.ingress.proxy_buffer_size_kb": {
+		description:   descriptions.NewInt64DefaultDescription("Header buffer size used while reading response header from upstream.", 4),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(4)},
+		defaultValue:  types.Int64{Value: 4},
+	},
+	"network.ingress.keepalive_time_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Limits the maximum time (in seconds) during which requests can be processed through one keepalive connection.", 3600),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3600)},
+		defaultValue:  types.Int64{Value: 3600},
+	},
+	"network.ingress.keepalive_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) during which an idle keepalive connection to an upstream server will stay open.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.send_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for transmitting a response to the client.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_connect_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for establishing a connection to a proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_send_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for transmitting a request to the proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_read_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for reading a response from the proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.whitelist_source_range": {
+		description:   descriptions.NewStringDefaultDescription("List of source ranges to allow access to ingress proxy. This property can be used to whitelist source IP ranges for ingress proxy. The value is a comma separated list of CIDRs, e.g. 10.0.0.0/24,172.10.0.1 To allow all source ranges, set 0.0.0.0/0.", "0.0.0.0/0"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("0.0.0.0/0")},
+		defaultValue:  types.String{Value: "0.0.0.0/0"},
+	},
+	"network.ingress.denylist_source_range": {
+		description:   descriptions.NewStringDefaultDescription("List of source ranges to deny access to ingress proxy. This property can be used to blacklist source IP ranges for ingress proxy. The value is a comma separated list of CIDRs, e.g. 10.0.0.0/24,172.10.0.1.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"network.ingress.basic_auth_env_var": {
+		description:   descriptions.NewStringDefaultDescription("Set the name of an environment variable to use as a basic authentication (`login:crypted_password`) from `htpasswd` command.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"network.ingress.enable_sticky_session": {
+		description:   descriptions.NewBoolDefaultDescription("Enable the load balancer to bind a user's session to a specific target. This ensures that all requests from the user during the session are sent to the same target.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"readiness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable readiness probe `TCP` enable TCP readiness probe `HTTP` enable HTTP readiness probe.", "TCP"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("TCP")},
+		defaultValue:  types.String{Value: "TCP"},
+	},
+	"readiness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", "/"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("/")},
+		defaultValue:  types.String{Value: "/"},
+	},
+	"readiness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 30),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(30)},
+		defaultValue:  types.Int64{Value: 30},
+	},
+	"readiness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 10),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(10)},
+		defaultValue:  types.Int64{Value: 10},
+	},
+	"readiness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"readiness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"readiness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 3),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3)},
+		defaultValue:  types.Int64{Value: 3},
+	},
+	"liveness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable liveness probe `TCP` enable TCP liveness probe `HTTP` enable HTTP liveness probe.", "TCP"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("TCP")},
+		defaultValue:  types.String{Value: "TCP"},
+	},
+	"liveness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", "/"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("/")},
+		defaultValue:  types.String{Value: "/"},
+	},
+	"liveness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 30),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(30)},
+		defaultValue:  types.Int64{Value: 30},
+	},
+	"liveness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 10),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(10)},
+		defaultValue:  types.Int64{Value: 10},
+	},
+	"liveness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 5),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(5)},
+		defaultValue:  types.Int64{Value: 5},
+	},
+	"liveness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"liveness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 3),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3)},
+		defaultValue:  types.Int64{Value: 3},
+	},
+	"hpa.cpu.average_utilization_percent": {
+		description:   descriptions.NewInt64DefaultDescription("Percentage value of cpu usage at which point pods should scale up.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"security.service_account_name": {
+		description:   descriptions.NewStringDefaultDescription("Allows you to set an existing Kubernetes service account name.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+}
+
+// containerAdvancedSettings holds the advanced settings attributes generated from qovery.ContainerAdvancedSettings.
+var containerAdvancedSettings = map[string]advSettingAttr{
+	"deployment.custom_domain_check_enabled": {
+		description:   descriptions.NewBoolDefaultDescription("Disable custom domain check when deploying an application.", true),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(true)},
+		defaultValue:  types.Bool{Value: true},
+	},
+	"deployment.termination_grace_period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Define how long in seconds an application is supposed to be stopped gracefully.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_body_size_mb": {
+		description:   descriptions.NewInt64DefaultDescription("Network ingress proxy body size mb.", 100),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(100)},
+		defaultValue:  types.Int64{Value: 100},
+	},
+	"network.ingress.enable_cors": {
+		description:   descriptions.NewBoolDefaultDescription("Network ingress enable cors.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"network.ingress.cors_allow_origin": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow origin.", "*"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("*")},
+		defaultValue:  types.String{Value: "*"},
+	},
+	"network.ingress.cors_allow_methods": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow methods.", "GET, PUT, POST, DELETE, PATCH, OPTIONS"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("GET, PUT, POST, DELETE, PATCH, OPTIONS")},
+		defaultValue:  types.String{Value: "GET, PUT, POST, DELETE, PATCH, OPTIONS"},
+	},
+	"network.ingress.cors_allow_headers": {
+		description:   descriptions.NewStringDefaultDescription("Network ingress cors allow headers.", "DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization")},
+		defaultValue:  types.String{Value: "DNT,Keep-Alive,User-Agent,X-Requested-With,If-Modified-Since,Cache-Control,Content-Type,Range,Authorization"},
+	},
+	"network.ingress.proxy_buffer_size_kb": {
+		description:   descriptions.NewInt64DefaultDescription("Header buffer size used while reading response header from upstream.", 4),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(4)},
+		defaultValue:  types.Int64{Value: 4},
+	},
+	"network.ingress.keepalive_time_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Limits the maximum time (in seconds) during which requests can be processed through one keepalive connection.", 3600),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3600)},
+		defaultValue:  types.Int64{Value: 3600},
+	},
+	"network.ingress.keepalive_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) during which an idle keepalive connection to an upstream server will stay open.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.send_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for transmitting a response to the client.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_connect_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for establishing a connection to a proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_send_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for transmitting a request to the proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.proxy_read_timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Sets a timeout (in seconds) for reading a response from the proxied server.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"network.ingress.whitelist_source_range": {
+		description:   descriptions.NewStringDefaultDescription("List of source ranges to allow access to ingress proxy. This property can be used to whitelist source IP ranges for ingress proxy. The value is a comma separated list of CIDRs, e.g. 10.0.0.0/24,172.10.0.1 To allow all source ranges, set 0.0.0.0/0.", "0.0.0.0/0"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("0.0.0.0/0")},
+		defaultValue:  types.String{Value: "0.0.0.0/0"},
+	},
+	"network.ingress.denylist_source_range": {
+		description:   descriptions.NewStringDefaultDescription("List of source ranges to deny access to ingress proxy. This property can be used to blacklist source IP ranges for ingress proxy. The value is a comma separated list of CIDRs, e.g. 10.0.0.0/24,172.10.0.1.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"network.ingress.basic_auth_env_var": {
+		description:   descriptions.NewStringDefaultDescription("Set the name of an environment variable to use as a basic authentication (`login:crypted_password`) from `htpasswd` command. You can add multiples comma separated values.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"network.ingress.enable_sticky_session": {
+		description:   descriptions.NewBoolDefaultDescription("Enable the load balancer to bind a user's session to a specific target. This ensures that all requests from the user during the session are sent to the same target.", false),
+		_type:         types.BoolType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(false)},
+		defaultValue:  types.Bool{Value: false},
+	},
+	"readiness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable readiness probe `TCP` enable TCP readiness probe `HTTP` enable HTTP readiness probe.", "TCP"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("TCP")},
+		defaultValue:  types.String{Value: "TCP"},
+	},
+	"readiness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", "/"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("/")},
+		defaultValue:  types.String{Value: "/"},
+	},
+	"readiness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 30),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(30)},
+		defaultValue:  types.Int64{Value: 30},
+	},
+	"readiness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 10),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(10)},
+		defaultValue:  types.Int64{Value: 10},
+	},
+	"readiness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"readiness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"readiness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 3),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3)},
+		defaultValue:  types.Int64{Value: 3},
+	},
+	"liveness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable liveness probe `TCP` enable TCP liveness probe `HTTP` enable HTTP liveness probe.", "TCP"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("TCP")},
+		defaultValue:  types.String{Value: "TCP"},
+	},
+	"liveness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", "/"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("/")},
+		defaultValue:  types.String{Value: "/"},
+	},
+	"liveness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 30),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(30)},
+		defaultValue:  types.Int64{Value: 30},
+	},
+	"liveness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 10),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(10)},
+		defaultValue:  types.Int64{Value: 10},
+	},
+	"liveness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 5),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(5)},
+		defaultValue:  types.Int64{Value: 5},
+	},
+	"liveness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"liveness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 3),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(3)},
+		defaultValue:  types.Int64{Value: 3},
+	},
+	"security.service_account_name": {
+		description:   descriptions.NewStringDefaultDescription("Allows you to set an existing Kubernetes service account name.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"hpa.cpu.average_utilization_percent": {
+		description:   descriptions.NewInt64DefaultDescription("Percentage value of cpu usage at which point pods should scale up.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+}
+
+// jobAdvancedSettings holds the advanced settings attributes generated from qovery.JobAdvancedSettings.
+var jobAdvancedSettings = map[string]advSettingAttr{
+	"deployment.termination_grace_period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Define how long in seconds an application is supposed to be stopped gracefully.", 60),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(60)},
+		defaultValue:  types.Int64{Value: 60},
+	},
+	"job.delete_ttl_seconds_after_finished": {
+		description:  "Job delete ttl seconds after finished.",
+		_type:        types.Int64Type,
+		defaultValue: types.Int64{Null: true},
+	},
+	"cronjob.concurrency_policy": {
+		description:   descriptions.NewStringDefaultDescription("Cronjob concurrency policy.", "Forbid"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("Forbid")},
+		defaultValue:  types.String{Value: "Forbid"},
+	},
+	"cronjob.failed_jobs_history_limit": {
+		description:   descriptions.NewInt64DefaultDescription("Cronjob failed jobs history limit.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"cronjob.success_jobs_history_limit": {
+		description:   descriptions.NewInt64DefaultDescription("Cronjob success jobs history limit.", 1),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(1)},
+		defaultValue:  types.Int64{Value: 1},
+	},
+	"readiness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable readiness probe `TCP` enable TCP readiness probe `HTTP` enable HTTP readiness probe.", "NONE"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("NONE")},
+		defaultValue:  types.String{Value: "NONE"},
+	},
+	"readiness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"readiness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"readiness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"readiness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"readiness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"readiness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"liveness_probe.type": {
+		description:   descriptions.NewStringDefaultDescription("`NONE` disable liveness probe `TCP` enable TCP liveness probe `HTTP` enable HTTP liveness probe.", "NONE"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("NONE")},
+		defaultValue:  types.String{Value: "NONE"},
+	},
+	"liveness_probe.http_get.path": {
+		description:   descriptions.NewStringDefaultDescription("HTTP GET path to check status (must returns 2xx E.g \"/healtz\") - only usable with TYPE = HTTP.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+	"liveness_probe.initial_delay_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("Delay before liveness probe is initiated.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"liveness_probe.period_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("How often to perform the probe.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"liveness_probe.timeout_seconds": {
+		description:   descriptions.NewInt64DefaultDescription("When the probe times out.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"liveness_probe.success_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive successes for the probe to be considered successful after having failed.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"liveness_probe.failure_threshold": {
+		description:   descriptions.NewInt64DefaultDescription("Minimum consecutive failures for the probe to be considered failed after having succeeded.", 0),
+		_type:         types.Int64Type,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(0)},
+		defaultValue:  types.Int64{Value: 0},
+	},
+	"security.service_account_name": {
+		description:   descriptions.NewStringDefaultDescription("Allows you to set an existing Kubernetes service account name.", ""),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("")},
+		defaultValue:  types.String{Value: ""},
+	},
+}
+
+// databaseAdvancedSettings holds the advanced settings attributes generated from qovery.DatabaseAdvancedSettings.
+var databaseAdvancedSettings = map[string]advSettingAttr{
+	"network.ingress.whitelist_source_range": {
+		description:   descriptions.NewStringDefaultDescription("List of source ranges to allow access to the database. This property can be used to whitelist source IP ranges for the database. The value is a comma separated list of CIDRs, e.g. 10.0.0.0/24,172.10.0.1 To allow all source ranges, set 0.0.0.0/0.", "0.0.0.0/0"),
+		_type:         types.StringType,
+		planModifiers: tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier("0.0.0.0/0")},
+		defaultValue:  types.String{Value: "0.0.0.0/0"},
+	},
+}
@@ -0,0 +1,143 @@
+package qovery
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+// advSettingAttr holds the schema of an advanced setting attribute.
+// The advanced settings attributes are generated from the models of the api client (i.e: qovery.ClusterAdvancedSettings) in advanced_settings_gen.go,
+// run `go generate` to update them after upgrading the api client.
+type advSettingAttr struct {
+	description   string
+	_type         attr.Type
+	planModifiers tfsdk.AttributePlanModifiers
+	defaultValue  attr.Value
+}
+
+// advancedSettingsResourceAttribute returns the schema of the `advanced_settings` attribute of a resource using the given advanced settings attributes.
+func advancedSettingsResourceAttribute(service string, settings map[string]advSettingAttr) tfsdk.Attribute {
+	attributes := make(map[string]tfsdk.Attribute, len(settings))
+	for key, setting := range settings {
+		attributes[key] = tfsdk.Attribute{
+			Description:   setting.description,
+			Type:          setting._type,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: setting.planModifiers,
+		}
+	}
+
+	return tfsdk.Attribute{
+		Description: "Advanced settings of the " + service + ".",
+		Optional:    true,
+		Computed:    true,
+		Attributes:  tfsdk.SingleNestedAttributes(attributes),
+	}
+}
+
+// advancedSettingsDataSourceAttribute returns the schema of the `advanced_settings` attribute of a data source using the given advanced settings attributes.
+func advancedSettingsDataSourceAttribute(service string, settings map[string]advSettingAttr) tfsdk.Attribute {
+	attributes := make(map[string]tfsdk.Attribute, len(settings))
+	for key, setting := range settings {
+		attributes[key] = tfsdk.Attribute{
+			Description: setting.description,
+			Type:        setting._type,
+			Computed:    true,
+		}
+	}
+
+	return tfsdk.Attribute{
+		Description: "Advanced settings of the " + service + ".",
+		Computed:    true,
+		Attributes:  tfsdk.SingleNestedAttributes(attributes),
+	}
+}
+
+// toAdvancedSettings returns the advanced settings set in the given `advanced_settings` object.
+// The settings that are null or unknown are omitted so their current value is kept.
+func toAdvancedSettings(obj types.Object) (advancedsettings.AdvancedSettings, error) {
+	if obj.Null || obj.Unknown {
+		return nil, nil
+	}
+
+	settings := make(advancedsettings.AdvancedSettings, len(obj.Attrs))
+	for key, v := range obj.Attrs {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+
+		switch value := v.(type) {
+		case types.String:
+			settings[key] = value.Value
+		case types.Int64:
+			settings[key] = value.Value
+		case types.Bool:
+			settings[key] = value.Value
+		default:
+			goValue, err := FromTfValueToGoValue(v)
+			if err != nil {
+				return nil, err
+			}
+			settings[key] = goValue
+		}
+	}
+
+	return settings, nil
+}
+
+// fromAdvancedSettings returns the `advanced_settings` object holding the given advanced settings.
+// The settings that are not part of the given attributes are ignored, while the attributes without setting are null.
+func fromAdvancedSettings(settings advancedsettings.AdvancedSettings, attrs map[string]advSettingAttr) types.Object {
+	attrTypes := make(map[string]attr.Type, len(attrs))
+	for key, setting := range attrs {
+		attrTypes[key] = setting._type
+	}
+
+	if settings == nil {
+		return types.Object{Null: true, AttrTypes: attrTypes}
+	}
+
+	values := make(map[string]attr.Value, len(attrs))
+	for key, setting := range attrs {
+		value, ok := settings[key]
+		if !ok || value == nil {
+			values[key] = nullValueOf(setting._type)
+			continue
+		}
+
+		v, err := FromGoValueToTfValue(value, setting._type)
+		if err != nil {
+			tflog.Warn(context.Background(), "Unable to parse attribute, using default value.", map[string]interface{}{"error": err.Error()})
+			v = setting.defaultValue
+		}
+		values[key] = v
+	}
+
+	return types.Object{
+		Attrs:     values,
+		AttrTypes: attrTypes,
+	}
+}
+
+// nullValueOf returns the null value of the given advanced setting type.
+func nullValueOf(t attr.Type) attr.Value {
+	switch t {
+	case types.BoolType:
+		return types.Bool{Null: true}
+	case types.Int64Type:
+		return types.Int64{Null: true}
+	case types.SetType{ElemType: types.StringType}:
+		return types.Set{ElemType: types.StringType, Null: true}
+	case types.MapType{ElemType: types.StringType}:
+		return types.Map{ElemType: types.StringType, Null: true}
+	}
+
+	return types.String{Null: true}
+}
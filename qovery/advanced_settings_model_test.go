@@ -0,0 +1,133 @@
+package qovery
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/qovery/qovery-client-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+)
+
+// TestAdvancedSettingsAttrs fails when the generated advanced settings attributes are outdated, run `go generate` to update them.
+func TestAdvancedSettingsAttrs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Model    interface{}
+		Settings map[string]advSettingAttr
+	}{
+		{TestName: "cluster", Model: newClusterAdvancedSettingsModel(), Settings: clusterAdvancedSettings},
+		{TestName: "application", Model: qovery.NewApplicationAdvancedSettings(), Settings: applicationAdvancedSettings},
+		{TestName: "container", Model: qovery.NewContainerAdvancedSettings(), Settings: containerAdvancedSettings},
+		{TestName: "job", Model: qovery.NewJobAdvancedSettings(), Settings: jobAdvancedSettings},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			modelType := reflect.TypeOf(tc.Model).Elem()
+			assert.Len(t, tc.Settings, modelType.NumField())
+			for i := 0; i < modelType.NumField(); i++ {
+				assert.Contains(t, tc.Settings, strings.TrimSuffix(modelType.Field(i).Tag.Get("json"), ",omitempty"))
+			}
+
+			// The defaults must be the values set by the constructor of the model, unless they are overridden.
+			defaults := make(map[string]attr.Value, len(tc.Settings))
+			for key, setting := range tc.Settings {
+				defaults[key] = setting.defaultValue
+			}
+			settings, err := toAdvancedSettings(types.Object{Attrs: defaults})
+			require.NoError(t, err)
+			rawSettings, err := json.Marshal(settings)
+			require.NoError(t, err)
+			expectedSettings, err := json.Marshal(tc.Model)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expectedSettings), string(rawSettings))
+		})
+	}
+
+	imds := clusterAdvancedSettings["aws.eks.ec2.metadata_imds"]
+	assert.Equal(t, types.StringType, imds._type)
+	assert.Equal(t, types.String{Value: "Optional"}, imds.defaultValue)
+	assert.Len(t, imds.planModifiers, 1)
+
+	allowedCidrs := clusterAdvancedSettings["database.postgresql.allowed_cidrs"]
+	assert.Equal(t, types.SetType{ElemType: types.StringType}, allowedCidrs._type)
+	assert.Equal(t, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}}, allowedCidrs.defaultValue)
+	assert.Len(t, allowedCidrs.planModifiers, 1)
+
+	registryTags := clusterAdvancedSettings["cloud_provider.container_registry.tags"]
+	assert.Equal(t, types.MapType{ElemType: types.StringType}, registryTags._type)
+	assert.Equal(t, types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}}, registryTags.defaultValue)
+	assert.Len(t, registryTags.planModifiers, 1)
+
+	gracePeriod := containerAdvancedSettings["deployment.termination_grace_period_seconds"]
+	assert.Equal(t, types.Int64Type, gracePeriod._type)
+	assert.Equal(t, types.Int64{Value: 60}, gracePeriod.defaultValue)
+	assert.Len(t, gracePeriod.planModifiers, 1)
+
+	deleteTTL := jobAdvancedSettings["job.delete_ttl_seconds_after_finished"]
+	assert.Equal(t, types.Int64Type, deleteTTL._type)
+	assert.Equal(t, types.Int64{Null: true}, deleteTTL.defaultValue)
+	assert.Empty(t, deleteTTL.planModifiers)
+}
+
+// newClusterAdvancedSettingsModel returns the cluster advanced settings model with the defaults overridden by scripts/advancedsettings.
+func newClusterAdvancedSettingsModel() *qovery.ClusterAdvancedSettings {
+	settings := qovery.NewClusterAdvancedSettings()
+	settings.SetAwsEksEc2MetadataImds("Optional")
+	settings.SetCloudProviderContainerRegistryTags(map[string]string{})
+	settings.SetDatabaseMongodbAllowedCidrs([]string{"0.0.0.0/0"})
+	settings.SetDatabaseMysqlAllowedCidrs([]string{"0.0.0.0/0"})
+	settings.SetDatabasePostgresqlAllowedCidrs([]string{"0.0.0.0/0"})
+	settings.SetDatabaseRedisAllowedCidrs([]string{"0.0.0.0/0"})
+
+	return settings
+}
+
+func TestAdvancedSettings_Conversions(t *testing.T) {
+	t.Parallel()
+
+	settings := advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": float64(120),
+		"network.ingress.enable_cors":                 true,
+		"network.ingress.cors_allow_origin":           "https://qovery.com",
+		"unknown.setting":                             "ignored",
+	}
+
+	obj := fromAdvancedSettings(settings, containerAdvancedSettings)
+	assert.Len(t, obj.Attrs, len(containerAdvancedSettings))
+	assert.Equal(t, types.Int64{Value: 120}, obj.Attrs["deployment.termination_grace_period_seconds"])
+	assert.Equal(t, types.Bool{Value: true}, obj.Attrs["network.ingress.enable_cors"])
+	assert.Equal(t, types.String{Null: true}, obj.Attrs["security.service_account_name"])
+
+	converted, err := toAdvancedSettings(obj)
+	require.NoError(t, err)
+	assert.Equal(t, advancedsettings.AdvancedSettings{
+		"deployment.termination_grace_period_seconds": int64(120),
+		"network.ingress.enable_cors":                 true,
+		"network.ingress.cors_allow_origin":           "https://qovery.com",
+	}, converted)
+	assert.False(t, converted.HasChanges(settings))
+
+	assert.True(t, fromAdvancedSettings(nil, containerAdvancedSettings).Null)
+
+	obj = fromAdvancedSettings(advancedsettings.AdvancedSettings{
+		"database.postgresql.allowed_cidrs":      []interface{}{"10.0.0.0/8"},
+		"cloud_provider.container_registry.tags": map[string]interface{}{},
+	}, clusterAdvancedSettings)
+	assert.Equal(t, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "10.0.0.0/8"}}}, obj.Attrs["database.postgresql.allowed_cidrs"])
+	assert.Equal(t, types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}}, obj.Attrs["cloud_provider.container_registry.tags"])
+
+	converted, err = toAdvancedSettings(types.Object{Unknown: true})
+	require.NoError(t, err)
+	assert.Nil(t, converted)
+}
@@ -4,7 +4,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
 var clusterRouteAttrTypes = map[string]attr.Type{
@@ -34,16 +34,14 @@ func (routes ClusterRouteList) toTerraformSet() types.Set {
 	return set
 }
 
-func (routes ClusterRouteList) toUpsertRequest() client.ClusterRoutingTable {
-	list := make([]client.ClusterRoute, 0, len(routes))
+func (routes ClusterRouteList) toDomainRoutingTable() cluster.RoutingTable {
+	routingTable := make(cluster.RoutingTable, 0, len(routes))
 
 	for _, r := range routes {
-		list = append(list, r.toUpsertRequest())
+		routingTable = append(routingTable, r.toDomainRoute())
 	}
 
-	return client.ClusterRoutingTable{
-		Routes: list,
-	}
+	return routingTable
 }
 
 type ClusterRoute struct {
@@ -63,15 +61,15 @@ func (r ClusterRoute) toTerraformObject() types.Object {
 	}
 }
 
-func (r ClusterRoute) toUpsertRequest() client.ClusterRoute {
-	return client.ClusterRoute{
+func (r ClusterRoute) toDomainRoute() cluster.Route {
+	return cluster.Route{
 		Description: ToString(r.Description),
 		Destination: ToString(r.Destination),
 		Target:      ToString(r.Target),
 	}
 }
 
-func fromClusterRoute(r client.ClusterRoute) ClusterRoute {
+func fromClusterRoute(r cluster.Route) ClusterRoute {
 	return ClusterRoute{
 		Description: FromString(r.Description),
 		Destination: FromString(r.Destination),
@@ -79,13 +77,13 @@ func fromClusterRoute(r client.ClusterRoute) ClusterRoute {
 	}
 }
 
-func fromClusterRoutingTable(routingTable *client.ClusterRoutingTable) ClusterRouteList {
+func fromClusterRoutingTable(routingTable cluster.RoutingTable) ClusterRouteList {
 	if routingTable == nil {
 		return nil
 	}
 
-	list := make([]ClusterRoute, 0, len(routingTable.Routes))
-	for _, v := range routingTable.Routes {
+	list := make([]ClusterRoute, 0, len(routingTable))
+	for _, v := range routingTable {
 		list = append(list, fromClusterRoute(v))
 	}
 
@@ -3,9 +3,8 @@ package qovery
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
 )
 
 var customDomainAttrTypes = map[string]attr.Type{
@@ -54,22 +53,37 @@ func (domains CustomDomainList) find(domain string) *CustomDomain {
 	return nil
 }
 
-func (domains CustomDomainList) diff(oldDomains CustomDomainList) client.CustomDomainsDiff {
-	diff := client.CustomDomainsDiff{
-		Create: []client.CustomDomainCreateRequest{},
-		Update: []client.CustomDomainUpdateRequest{},
-		Delete: []client.CustomDomainDeleteRequest{},
+// managed returns the domains also listed in the given managed domains.
+// The managed domains are nil when the resource doesn't manage any custom domain, so nil is returned as well.
+func (domains CustomDomainList) managed(managedDomains CustomDomainList) CustomDomainList {
+	if managedDomains == nil {
+		return nil
+	}
+
+	list := make([]CustomDomain, 0, len(domains))
+	for _, d := range domains {
+		if managedDomains.contains(d) {
+			list = append(list, d)
+		}
+	}
+	return list
+}
+
+func (domains CustomDomainList) diffRequest(oldDomains CustomDomainList) customdomain.DiffRequest {
+	diff := customdomain.DiffRequest{
+		Create: []customdomain.DiffCreateRequest{},
+		Delete: []customdomain.DiffDeleteRequest{},
 	}
 
 	for _, od := range oldDomains {
 		if found := domains.find(ToString(od.Domain)); found == nil {
-			diff.Delete = append(diff.Delete, od.toDeleteRequest())
+			diff.Delete = append(diff.Delete, od.toDiffDeleteRequest())
 		}
 	}
 
 	for _, d := range domains {
 		if !oldDomains.contains(d) {
-			diff.Create = append(diff.Create, d.toCreateRequest())
+			diff.Create = append(diff.Create, d.toDiffCreateRequest())
 		}
 	}
 
@@ -95,46 +109,37 @@ func (d CustomDomain) toTerraformObject() types.Object {
 	}
 }
 
-func (d CustomDomain) toCreateRequest() client.CustomDomainCreateRequest {
-	return client.CustomDomainCreateRequest{
-		CustomDomainRequest: qovery.CustomDomainRequest{
+func (d CustomDomain) toDiffCreateRequest() customdomain.DiffCreateRequest {
+	return customdomain.DiffCreateRequest{
+		UpsertRequest: customdomain.UpsertRequest{
 			Domain: ToString(d.Domain),
 		},
 	}
 }
 
-func (d CustomDomain) toUpdateRequest(new CustomDomain) client.CustomDomainUpdateRequest {
-	return client.CustomDomainUpdateRequest{
-		Id: ToString(d.Id),
-		CustomDomainRequest: qovery.CustomDomainRequest{
-			Domain: ToString(new.Domain),
-		},
-	}
-}
-
-func (d CustomDomain) toDeleteRequest() client.CustomDomainDeleteRequest {
-	return client.CustomDomainDeleteRequest{
-		Id: ToString(d.Id),
+func (d CustomDomain) toDiffDeleteRequest() customdomain.DiffDeleteRequest {
+	return customdomain.DiffDeleteRequest{
+		CustomDomainID: ToString(d.Id),
 	}
 }
 
-func fromCustomDomain(d *qovery.CustomDomain) CustomDomain {
+func convertDomainCustomDomainToCustomDomain(d customdomain.CustomDomain) CustomDomain {
 	return CustomDomain{
-		Id:               FromString(d.Id),
+		Id:               FromString(d.ID.String()),
 		Domain:           FromString(d.Domain),
 		ValidationDomain: FromStringPointer(d.ValidationDomain),
-		Status:           fromClientEnumPointer(d.Status),
+		Status:           FromStringPointer(d.Status),
 	}
 }
 
-func fromCustomDomainList(vars []*qovery.CustomDomain) CustomDomainList {
-	if len(vars) == 0 {
+func convertDomainCustomDomainsToCustomDomainList(customDomains customdomain.CustomDomains) CustomDomainList {
+	if len(customDomains) == 0 {
 		return nil
 	}
 
-	list := make([]CustomDomain, 0, len(vars))
-	for _, v := range vars {
-		list = append(list, fromCustomDomain(v))
+	list := make([]CustomDomain, 0, len(customDomains))
+	for _, d := range customDomains {
+		list = append(list, convertDomainCustomDomainToCustomDomain(d))
 	}
 
 	return list
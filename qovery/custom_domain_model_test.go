@@ -0,0 +1,87 @@
+package qovery
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+// TestApplication_KeepManagedCustomDomains ensures a custom domain managed by a `qovery_custom_domain` resource survives an update of its application.
+func TestApplication_KeepManagedCustomDomains(t *testing.T) {
+	t.Parallel()
+
+	ownedDomain := customdomain.CustomDomain{ID: uuid.New(), Domain: "owned.example.com"}
+	standaloneDomain := customdomain.CustomDomain{ID: uuid.New(), Domain: "standalone.example.com"}
+	apiDomains := customdomain.CustomDomains{ownedDomain, standaloneDomain}
+
+	testCases := []struct {
+		TestName        string
+		Managed         CustomDomainList
+		ExpectedDomains []string
+	}{
+		{
+			TestName:        "only_owned_domains",
+			Managed:         CustomDomainList{{Domain: FromString(ownedDomain.Domain)}},
+			ExpectedDomains: []string{ownedDomain.Domain},
+		},
+		{
+			TestName:        "no_owned_domains",
+			Managed:         CustomDomainList{},
+			ExpectedDomains: []string{},
+		},
+		{
+			TestName:        "custom_domains_not_set",
+			Managed:         nil,
+			ExpectedDomains: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			managed := Application{
+				EnvironmentId: FromString(uuid.NewString()),
+				CustomDomains: tc.Managed.toTerraformSet(),
+			}
+			app := Application{CustomDomains: convertDomainCustomDomainsToCustomDomainList(apiDomains).toTerraformSet()}.keepManaged(managed)
+
+			domains := app.CustomDomainsList()
+			if tc.ExpectedDomains == nil {
+				assert.True(t, app.CustomDomains.Null)
+				return
+			}
+			require.Len(t, domains, len(tc.ExpectedDomains))
+			for _, d := range tc.ExpectedDomains {
+				assert.NotNil(t, domains.find(d))
+			}
+
+			// The next update of the application must not delete the custom domain it doesn't own.
+			diff := tc.Managed.diffRequest(domains)
+			for _, d := range diff.Delete {
+				assert.NotEqual(t, standaloneDomain.ID.String(), d.CustomDomainID)
+			}
+		})
+	}
+}
+
+func TestApplication_KeepManagedCustomDomains_OnImport(t *testing.T) {
+	t.Parallel()
+
+	apiDomains := customdomain.CustomDomains{
+		{ID: uuid.New(), Domain: "owned.example.com"},
+		{ID: uuid.New(), Domain: "standalone.example.com"},
+	}
+
+	// On import, only the id of the application is known.
+	managed := Application{Id: FromString(uuid.NewString()), EnvironmentId: types.String{Null: true}}
+	app := Application{CustomDomains: convertDomainCustomDomainsToCustomDomainList(apiDomains).toTerraformSet()}.keepManaged(managed)
+
+	assert.Len(t, app.CustomDomainsList(), len(apiDomains))
+}
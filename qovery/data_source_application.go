@@ -10,14 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
 )
 
 // Ensure provider defined types fully satisfy terraform framework interfaces.
 var _ datasource.DataSourceWithConfigure = &applicationDataSource{}
 
 type applicationDataSource struct {
-	client *client.Client
+	applicationService application.Service
 }
 
 func newApplicationDataSource() datasource.DataSource {
@@ -43,7 +43,7 @@ func (d *applicationDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	d.client = provider.client
+	d.applicationService = provider.applicationService
 }
 
 func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -84,6 +84,21 @@ func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"git_token_id": {
+						Description: "Id of the git token used to access the git repository.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"commit_id": {
+						Description: "Sha of the commit of the git repository pinned by the `qovery_application` resource.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"deployed_commit_id": {
+						Description: "Sha of the commit of the git repository currently deployed.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"build_mode": {
@@ -219,6 +234,11 @@ func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
@@ -240,6 +260,63 @@ func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this application.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this application.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
@@ -262,6 +339,11 @@ func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Computed:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"custom_domains": {
@@ -306,6 +388,9 @@ func (d applicationDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksDataSourceAttribute("application"),
+			"advanced_settings": advancedSettingsDataSourceAttribute("application", applicationAdvancedSettings),
+			"timeouts":          timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -320,13 +405,13 @@ func (d applicationDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Get application from API
-	application, apiErr := d.client.GetApplication(ctx, data.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := d.applicationService.Get(ctx, data.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application read", err)
 		return
 	}
 
-	state := convertResponseToApplication(data, application)
+	state := convertDomainApplicationToApplication(data, app)
 	tflog.Trace(ctx, "read application", map[string]interface{}{"application_id": state.Id.Value})
 
 	// Set state
@@ -81,7 +81,7 @@ func (d awsCredentialsDataSource) Read(ctx context.Context, req datasource.ReadR
 	// Get credentials from API
 	creds, err := d.awsCredentialsService.Get(ctx, data.OrganizationId.Value, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on aws credentials read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on aws credentials read", err)
 		return
 	}
 
@@ -10,14 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 )
 
 // Ensure provider defined types fully satisfy terraform framework interfaces.
 var _ datasource.DataSourceWithConfigure = &clusterDataSource{}
 
 type clusterDataSource struct {
-	client *client.Client
+	clusterService cluster.Service
 }
 
 func newClusterDataSource() datasource.DataSource {
@@ -43,19 +43,10 @@ func (d *clusterDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	d.client = provider.client
+	d.clusterService = provider.clusterService
 }
 
 func (d clusterDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	advSettings := map[string]tfsdk.Attribute{}
-	for k, v := range advancedSettingsDefault {
-		advSettings[k] = tfsdk.Attribute{
-			Description: v.description,
-			Computed:    true,
-			Type:        v._type,
-		}
-	}
-
 	return tfsdk.Schema{
 		Description: "Use this data source to retrieve information about an existing cluster.",
 		Attributes: map[string]tfsdk.Attribute{
@@ -156,11 +147,8 @@ func (d clusterDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Type:        types.StringType,
 				Computed:    true,
 			},
-			"advanced_settings": {
-				Description: "Advanced settings of the cluster.",
-				Computed:    true,
-				Attributes:  tfsdk.SingleNestedAttributes(advSettings),
-			},
+			"advanced_settings": advancedSettingsDataSourceAttribute("cluster", clusterAdvancedSettings),
+			"timeouts":          timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -175,13 +163,13 @@ func (d clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Get cluster from the API
-	cluster, apiErr := d.client.GetCluster(ctx, data.OrganizationId.Value, data.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	cl, err := d.clusterService.Get(ctx, data.OrganizationId.Value, data.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster read", err)
 		return
 	}
 
-	state := convertResponseToCluster(cluster)
+	state := convertDomainClusterToCluster(data, cl)
 	tflog.Trace(ctx, "read cluster", map[string]interface{}{"cluster_id": state.Id.Value})
 
 	// Set state
@@ -192,6 +192,11 @@ func (d containerDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
@@ -213,6 +218,63 @@ func (d containerDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this container.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this container.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
@@ -235,6 +297,11 @@ func (d containerDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Computed:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"arguments": {
@@ -286,6 +353,9 @@ func (d containerDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksDataSourceAttribute("container"),
+			"advanced_settings": advancedSettingsDataSourceAttribute("container", containerAdvancedSettings),
+			"timeouts":          timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -302,7 +372,7 @@ func (d containerDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// Get container from API
 	cont, err := d.containerService.Get(ctx, data.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container read", err)
 		return
 	}
 
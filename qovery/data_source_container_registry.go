@@ -94,7 +94,7 @@ func (d containerRegistryDataSource) Read(ctx context.Context, req datasource.Re
 	// Get container registry from API
 	reg, err := d.containerRegistryService.Get(ctx, data.OrganizationId.Value, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container registry read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container registry read", err)
 		return
 	}
 
@@ -10,14 +10,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 )
 
 // Ensure provider defined types fully satisfy terraform framework interfaces.
 var _ datasource.DataSource = databaseDataSource{}
 
 type databaseDataSource struct {
-	client *client.Client
+	databaseService database.Service
 }
 
 func newDatabaseDataSource() datasource.DataSource {
@@ -43,7 +43,7 @@ func (d *databaseDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = provider.client
+	d.databaseService = provider.databaseService
 }
 
 func (d databaseDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -132,6 +132,8 @@ func (d databaseDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Optional:    true,
 				Computed:    true,
 			},
+			"advanced_settings": advancedSettingsDataSourceAttribute("database", databaseAdvancedSettings),
+			"timeouts":          timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -146,12 +148,13 @@ func (d databaseDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	}
 
 	// Get database from API
-	database, apiErr := d.client.GetDatabase(ctx, data.Id.Value)
-	if apiErr != nil {
+	db, err := d.databaseService.Get(ctx, data.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database read", err)
 		return
 	}
 
-	state := convertResponseToDatabase(database)
+	state := convertDomainDatabaseToDatabase(data, db)
 	tflog.Trace(ctx, "read database", map[string]interface{}{"database_id": state.Id.Value})
 
 	// Set state
@@ -71,6 +71,7 @@ func (d deploymentDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.D
 				Type:        types.StringType,
 				Optional:    true,
 			},
+			"timeouts": timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -92,7 +93,7 @@ func (d deploymentDataSource) Read(ctx context.Context, req datasource.ReadReque
 		DesiredState:  ToString(data.DesiredState),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment read", err)
 		return
 	}
 
@@ -86,7 +86,7 @@ func (d deploymentStageDataSource) Read(ctx context.Context, req datasource.Read
 	// Get deployment stage from API
 	deploymentStageDomain, err := d.deploymentStageService.Get(ctx, data.EnvironmentId.Value, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment stage read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment stage read", err)
 		return
 	}
 
@@ -0,0 +1,43 @@
+//go:build integration && !unit
+// +build integration,!unit
+
+package qovery_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAcc_DigitalOceanCredentialsDataSource(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccDigitalOceanCredentialsDataSourceConfig(
+					getTestDigitalOceanCredentialsID(),
+					getTestOrganizationID(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.qovery_digitalocean_credentials.test", "id", getTestDigitalOceanCredentialsID()),
+					resource.TestCheckResourceAttr("data.qovery_digitalocean_credentials.test", "organization_id", getTestOrganizationID()),
+					resource.TestCheckResourceAttrSet("data.qovery_digitalocean_credentials.test", "name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDigitalOceanCredentialsDataSourceConfig(credentialsID string, organizationID string) string {
+	return fmt.Sprintf(`
+data "qovery_digitalocean_credentials" "test" {
+  id = "%s"
+  organization_id = "%s"
+}
+`, credentialsID, organizationID,
+	)
+}
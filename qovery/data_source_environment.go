@@ -94,6 +94,11 @@ func (d environmentDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
@@ -116,6 +121,11 @@ func (d environmentDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
@@ -138,8 +148,14 @@ func (d environmentDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.
 						Computed:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
+			"timeouts": timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -156,7 +172,7 @@ func (d environmentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Get environment from API
 	env, err := d.environmentService.Get(ctx, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on environment read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment read", err)
 		return
 	}
 
@@ -0,0 +1,93 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ datasource.DataSourceWithConfigure = &gcpCredentialsDataSource{}
+
+type gcpCredentialsDataSource struct {
+	gcpCredentialsService credentials.GcpService
+}
+
+func newGcpCredentialsDataSource() datasource.DataSource {
+	return &gcpCredentialsDataSource{}
+}
+
+func (d gcpCredentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcp_credentials"
+}
+
+func (d *gcpCredentialsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.gcpCredentialsService = provider.gcpCredentialsService
+}
+
+func (d gcpCredentialsDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Use this data source to retrieve information about an existing GCP credentials.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the credentials.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"organization_id": {
+				Description: "Id of the organization.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"name": {
+				Description: "Name of the GCP credentials.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+		},
+	}, nil
+}
+
+// Read qovery gcpCredentials data source
+func (d gcpCredentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	// Get current state
+	var data GcpCredentialsDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get credentials from API
+	creds, err := d.gcpCredentialsService.Get(ctx, data.OrganizationId.Value, data.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on gcp credentials read", err)
+		return
+	}
+
+	state := convertDomainCredentialsToGcpCredentialsDataSource(creds)
+	tflog.Trace(ctx, "read gcp credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
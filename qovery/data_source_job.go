@@ -265,6 +265,21 @@ func (d jobDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnost
 										Type:        types.StringType,
 										Computed:    true,
 									},
+									"git_token_id": {
+										Description: "Job's docker source git token id used to access the git repository.",
+										Type:        types.StringType,
+										Computed:    true,
+									},
+									"commit_id": {
+										Description: "Job's docker source git repository sha of the commit pinned by the `qovery_job` resource.",
+										Type:        types.StringType,
+										Computed:    true,
+									},
+									"deployed_commit_id": {
+										Description: "Job's docker source git repository sha of the commit currently deployed.",
+										Type:        types.StringType,
+										Computed:    true,
+									},
 								}),
 							},
 						}),
@@ -290,6 +305,11 @@ func (d jobDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnost
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
@@ -311,6 +331,63 @@ func (d jobDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnost
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this job.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this job.",
+				Computed:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
@@ -333,6 +410,11 @@ func (d jobDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnost
 						Computed:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"external_host": {
@@ -351,6 +433,9 @@ func (d jobDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnost
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksDataSourceAttribute("job"),
+			"advanced_settings": advancedSettingsDataSourceAttribute("job", jobAdvancedSettings),
+			"timeouts":          timeoutsDataSourceAttribute(),
 		},
 	}, nil
 }
@@ -367,7 +452,7 @@ func (d jobDataSource) Read(ctx context.Context, req datasource.ReadRequest, res
 	// Get job from API
 	cont, err := d.jobService.Get(ctx, data.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job read", err)
 		return
 	}
 
@@ -86,7 +86,7 @@ func (d organizationDataSource) Read(ctx context.Context, req datasource.ReadReq
 	// Get organization from API
 	orga, err := d.organizationService.Get(ctx, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on organization read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on organization read", err)
 		return
 	}
 
@@ -89,6 +89,11 @@ func (d projectDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
@@ -111,6 +116,11 @@ func (d projectDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"secrets": {
@@ -133,6 +143,11 @@ func (d projectDataSource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Computed:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 		},
@@ -151,7 +166,7 @@ func (d projectDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get project from API
 	proj, err := d.projectService.Get(ctx, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on project read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on project read", err)
 		return
 	}
 
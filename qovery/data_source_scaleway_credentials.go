@@ -81,7 +81,7 @@ func (d scalewayCredentialsDataSource) Read(ctx context.Context, req datasource.
 	// Get credentials from API
 	creds, err := d.scalewayCredentialsService.Get(ctx, data.OrganizationId.Value, data.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on scaleway credentials read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on scaleway credentials read", err)
 		return
 	}
 
@@ -0,0 +1,22 @@
+package qovery
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+// addErrorDiagnostic adds an error diagnostic with the given summary for the given error.
+// When the error comes from Qovery's API, the detail is completed with a hint telling the user how to handle it.
+func addErrorDiagnostic(diags *diag.Diagnostics, summary string, err error) {
+	detail := err.Error()
+	if apiErr := apierrors.NewApiErrorFromError(err); apiErr != nil {
+		if hint := apiErr.Hint(); hint != "" {
+			detail = fmt.Sprintf("%s\n\n%s", detail, hint)
+		}
+	}
+
+	diags.AddError(summary, detail)
+}
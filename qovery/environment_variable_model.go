@@ -3,16 +3,15 @@ package qovery
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
 var environmentVariableAttrTypes = map[string]attr.Type{
-	"id":    types.StringType,
-	"key":   types.StringType,
-	"value": types.StringType,
+	"id":         types.StringType,
+	"key":        types.StringType,
+	"value":      types.StringType,
+	"mount_path": types.StringType,
 }
 
 type EnvironmentVariableList []EnvironmentVariable
@@ -54,6 +53,33 @@ func (vars EnvironmentVariableList) find(key string) *EnvironmentVariable {
 	return nil
 }
 
+// managed returns the environment variables whose key is also in the given managed environment variables.
+// The managed environment variables are nil when the resource doesn't manage any, so nil is returned as well.
+func (vars EnvironmentVariableList) managed(managedVars EnvironmentVariableList) EnvironmentVariableList {
+	if managedVars == nil {
+		return nil
+	}
+
+	list := make([]EnvironmentVariable, 0, len(vars))
+	for _, v := range vars {
+		if managedVars.contains(v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// mountPaths returns the mount paths of the file environment variables.
+func (vars EnvironmentVariableList) mountPaths() []string {
+	mountPaths := make([]string, 0, len(vars))
+	for _, v := range vars {
+		if p := ToStringPointer(v.MountPath); p != nil {
+			mountPaths = append(mountPaths, *p)
+		}
+	}
+	return mountPaths
+}
+
 func (vars EnvironmentVariableList) diffRequest(old EnvironmentVariableList) variable.DiffRequest {
 	diff := variable.DiffRequest{
 		Create: []variable.DiffCreateRequest{},
@@ -62,17 +88,18 @@ func (vars EnvironmentVariableList) diffRequest(old EnvironmentVariableList) var
 	}
 
 	for _, e := range old {
-		if updatedVar := vars.find(ToString(e.Key)); updatedVar != nil {
+		if updatedVar := vars.find(ToString(e.Key)); updatedVar != nil && updatedVar.MountPath == e.MountPath {
 			if updatedVar.Value != e.Value {
 				diff.Update = append(diff.Update, e.toDiffUpdateRequest(*updatedVar))
 			}
 		} else {
+			// The mount path of a variable can't be edited: it is deleted and created again when the mount path changes.
 			diff.Delete = append(diff.Delete, e.toDiffDeleteRequest())
 		}
 	}
 
 	for _, e := range vars {
-		if !old.contains(e) {
+		if oldVar := old.find(ToString(e.Key)); oldVar == nil || oldVar.MountPath != e.MountPath {
 			diff.Create = append(diff.Create, e.toDiffCreateRequest())
 		}
 	}
@@ -80,26 +107,39 @@ func (vars EnvironmentVariableList) diffRequest(old EnvironmentVariableList) var
 	return diff
 }
 
-func (vars EnvironmentVariableList) diff(old EnvironmentVariableList) client.EnvironmentVariablesDiff {
-	diff := client.EnvironmentVariablesDiff{
-		Create: []client.EnvironmentVariableCreateRequest{},
-		Update: []client.EnvironmentVariableUpdateRequest{},
-		Delete: []client.EnvironmentVariableDeleteRequest{},
+// newEnvironmentVariablesDiffRequest returns the variable.DiffRequest needed to turn the environment variables, aliases and overrides of the state into the planned ones.
+// Aliases are identified by their key and overrides by the key of the overridden variable.
+func newEnvironmentVariablesDiffRequest(vars, aliases, overrides, stateVars, stateAliases, stateOverrides EnvironmentVariableList) variable.DiffRequest {
+	diff := vars.diffRequest(stateVars)
+	diff.CreateAlias = []variable.DiffCreateAliasRequest{}
+	diff.CreateOverride = []variable.DiffCreateOverrideRequest{}
+
+	// An alias can't be edited: it is deleted and created again when the aliased variable changes.
+	for _, e := range stateAliases {
+		if updatedAlias := aliases.find(ToString(e.Key)); updatedAlias == nil || updatedAlias.Value != e.Value {
+			diff.Delete = append(diff.Delete, e.toDiffDeleteRequest())
+		}
 	}
 
-	for _, e := range old {
-		if updatedVar := vars.find(ToString(e.Key)); updatedVar != nil {
-			if updatedVar.Value != e.Value {
-				diff.Update = append(diff.Update, e.toUpdateRequest(*updatedVar))
+	for _, e := range aliases {
+		if oldAlias := stateAliases.find(ToString(e.Key)); oldAlias == nil || oldAlias.Value != e.Value {
+			diff.CreateAlias = append(diff.CreateAlias, e.toDiffCreateAliasRequest())
+		}
+	}
+
+	for _, e := range stateOverrides {
+		if updatedOverride := overrides.find(ToString(e.Key)); updatedOverride != nil {
+			if updatedOverride.Value != e.Value {
+				diff.Update = append(diff.Update, e.toDiffUpdateRequest(*updatedOverride))
 			}
 		} else {
-			diff.Delete = append(diff.Delete, e.toDeleteRequest())
+			diff.Delete = append(diff.Delete, e.toDiffDeleteRequest())
 		}
 	}
 
-	for _, e := range vars {
-		if !old.contains(e) {
-			diff.Create = append(diff.Create, e.toCreateRequest())
+	for _, e := range overrides {
+		if !stateOverrides.contains(e) {
+			diff.CreateOverride = append(diff.CreateOverride, e.toDiffCreateOverrideRequest())
 		}
 	}
 
@@ -107,27 +147,20 @@ func (vars EnvironmentVariableList) diff(old EnvironmentVariableList) client.Env
 }
 
 type EnvironmentVariable struct {
-	Id    types.String `tfsdk:"id"`
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
+	Id        types.String `tfsdk:"id"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	MountPath types.String `tfsdk:"mount_path"`
 }
 
 func (e EnvironmentVariable) toTerraformObject() types.Object {
 	return types.Object{
 		AttrTypes: environmentVariableAttrTypes,
 		Attrs: map[string]attr.Value{
-			"id":    e.Id,
-			"key":   e.Key,
-			"value": e.Value,
-		},
-	}
-}
-
-func (e EnvironmentVariable) toCreateRequest() client.EnvironmentVariableCreateRequest {
-	return client.EnvironmentVariableCreateRequest{
-		EnvironmentVariableRequest: qovery.EnvironmentVariableRequest{
-			Key:   ToString(e.Key),
-			Value: ToString(e.Value),
+			"id":         e.Id,
+			"key":        e.Key,
+			"value":      e.Value,
+			"mount_path": e.MountPath,
 		},
 	}
 }
@@ -135,19 +168,26 @@ func (e EnvironmentVariable) toCreateRequest() client.EnvironmentVariableCreateR
 func (e EnvironmentVariable) toDiffCreateRequest() variable.DiffCreateRequest {
 	return variable.DiffCreateRequest{
 		UpsertRequest: variable.UpsertRequest{
-			Key:   ToString(e.Key),
-			Value: ToString(e.Value),
+			Key:       ToString(e.Key),
+			Value:     ToString(e.Value),
+			MountPath: ToStringPointer(e.MountPath),
 		},
 	}
 }
 
-func (e EnvironmentVariable) toUpdateRequest(new EnvironmentVariable) client.EnvironmentVariableUpdateRequest {
-	return client.EnvironmentVariableUpdateRequest{
-		Id: ToString(e.Id),
-		EnvironmentVariableEditRequest: qovery.EnvironmentVariableEditRequest{
-			Key:   ToString(e.Key),
-			Value: ToString(new.Value),
-		},
+// toDiffCreateAliasRequest turns an alias, whose value is the key of the aliased variable, into a variable.DiffCreateAliasRequest.
+func (e EnvironmentVariable) toDiffCreateAliasRequest() variable.DiffCreateAliasRequest {
+	return variable.DiffCreateAliasRequest{
+		Key:                ToString(e.Key),
+		AliasedVariableKey: ToString(e.Value),
+	}
+}
+
+// toDiffCreateOverrideRequest turns an override, whose key is the key of the overridden variable, into a variable.DiffCreateOverrideRequest.
+func (e EnvironmentVariable) toDiffCreateOverrideRequest() variable.DiffCreateOverrideRequest {
+	return variable.DiffCreateOverrideRequest{
+		OverriddenVariableKey: ToString(e.Key),
+		Value:                 ToString(e.Value),
 	}
 }
 
@@ -161,33 +201,41 @@ func (e EnvironmentVariable) toDiffUpdateRequest(new EnvironmentVariable) variab
 	}
 }
 
-func (e EnvironmentVariable) toDeleteRequest() client.EnvironmentVariableDeleteRequest {
-	return client.EnvironmentVariableDeleteRequest{
-		Id: ToString(e.Id),
-	}
-}
-
 func (e EnvironmentVariable) toDiffDeleteRequest() variable.DiffDeleteRequest {
 	return variable.DiffDeleteRequest{
 		VariableID: ToString(e.Id),
 	}
 }
 
-func fromEnvironmentVariable(v *qovery.EnvironmentVariable) EnvironmentVariable {
+func toEnvironmentVariable(v types.Object) EnvironmentVariable {
 	return EnvironmentVariable{
-		Id:    FromString(v.Id),
-		Key:   FromString(v.Key),
-		Value: FromString(v.Value),
+		Id:        v.Attrs["id"].(types.String),
+		Key:       v.Attrs["key"].(types.String),
+		Value:     v.Attrs["value"].(types.String),
+		MountPath: v.Attrs["mount_path"].(types.String),
 	}
 }
 
-func fromEnvironmentVariableList(vars []*qovery.EnvironmentVariable, scope qovery.APIVariableScopeEnum) EnvironmentVariableList {
+func toEnvironmentVariableList(vars types.Set) EnvironmentVariableList {
+	if vars.Null || vars.Unknown {
+		return nil
+	}
+
+	environmentVariables := make([]EnvironmentVariable, 0, len(vars.Elems))
+	for _, elem := range vars.Elems {
+		environmentVariables = append(environmentVariables, toEnvironmentVariable(elem.(types.Object)))
+	}
+
+	return environmentVariables
+}
+
+func convertDomainVariablesToEnvironmentVariableList(vars variable.Variables, scope variable.Scope) EnvironmentVariableList {
 	list := make([]EnvironmentVariable, 0, len(vars))
 	for _, v := range vars {
-		if v.Scope != scope {
+		if v.Scope != scope || v.Type.IsReference() {
 			continue
 		}
-		list = append(list, fromEnvironmentVariable(v))
+		list = append(list, convertDomainVariableToEnvironmentVariable(v))
 	}
 
 	if len(list) == 0 {
@@ -196,31 +244,32 @@ func fromEnvironmentVariableList(vars []*qovery.EnvironmentVariable, scope qover
 	return list
 }
 
-func toEnvironmentVariable(v types.Object) EnvironmentVariable {
-	return EnvironmentVariable{
-		Id:    v.Attrs["id"].(types.String),
-		Key:   v.Attrs["key"].(types.String),
-		Value: v.Attrs["value"].(types.String),
+// convertDomainVariablesToEnvironmentVariableAliasList returns the aliases defined in the given scope, with the key of the aliased variable as value.
+func convertDomainVariablesToEnvironmentVariableAliasList(vars variable.Variables, scope variable.Scope) EnvironmentVariableList {
+	list := make([]EnvironmentVariable, 0, len(vars))
+	for _, v := range vars {
+		if v.Scope != scope || v.Type != variable.TypeAlias || v.AliasedVariable == nil {
+			continue
+		}
+		list = append(list, EnvironmentVariable{
+			Id:        FromString(v.ID.String()),
+			Key:       FromString(v.Key),
+			Value:     FromString(v.AliasedVariable.Key),
+			MountPath: FromStringPointer(v.MountPath),
+		})
 	}
-}
 
-func toEnvironmentVariableList(vars types.Set) EnvironmentVariableList {
-	if vars.Null || vars.Unknown {
+	if len(list) == 0 {
 		return nil
 	}
-
-	environmentVariables := make([]EnvironmentVariable, 0, len(vars.Elems))
-	for _, elem := range vars.Elems {
-		environmentVariables = append(environmentVariables, toEnvironmentVariable(elem.(types.Object)))
-	}
-
-	return environmentVariables
+	return list
 }
 
-func convertDomainVariablesToEnvironmentVariableList(vars variable.Variables, scope variable.Scope) EnvironmentVariableList {
+// convertDomainVariablesToEnvironmentVariableOverrideList returns the overrides defined in the given scope.
+func convertDomainVariablesToEnvironmentVariableOverrideList(vars variable.Variables, scope variable.Scope) EnvironmentVariableList {
 	list := make([]EnvironmentVariable, 0, len(vars))
 	for _, v := range vars {
-		if v.Scope != scope {
+		if v.Scope != scope || v.Type != variable.TypeOverride {
 			continue
 		}
 		list = append(list, convertDomainVariableToEnvironmentVariable(v))
@@ -234,8 +283,9 @@ func convertDomainVariablesToEnvironmentVariableList(vars variable.Variables, sc
 
 func convertDomainVariableToEnvironmentVariable(v variable.Variable) EnvironmentVariable {
 	return EnvironmentVariable{
-		Id:    FromString(v.ID.String()),
-		Key:   FromString(v.Key),
-		Value: FromString(v.Value),
+		Id:        FromString(v.ID.String()),
+		Key:       FromString(v.Key),
+		Value:     FromString(v.Value),
+		MountPath: FromStringPointer(v.MountPath),
 	}
 }
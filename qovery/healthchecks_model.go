@@ -0,0 +1,345 @@
+package qovery
+
+import (
+	"github.com/AlekSi/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/healthcheck"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Healthchecks represents the `healthchecks` block of the services.
+type Healthchecks struct {
+	LivenessProbe  *Probe `tfsdk:"liveness_probe"`
+	ReadinessProbe *Probe `tfsdk:"readiness_probe"`
+}
+
+type Probe struct {
+	Type                ProbeType   `tfsdk:"type"`
+	InitialDelaySeconds types.Int64 `tfsdk:"initial_delay_seconds"`
+	PeriodSeconds       types.Int64 `tfsdk:"period_seconds"`
+	TimeoutSeconds      types.Int64 `tfsdk:"timeout_seconds"`
+	SuccessThreshold    types.Int64 `tfsdk:"success_threshold"`
+	FailureThreshold    types.Int64 `tfsdk:"failure_threshold"`
+}
+
+type ProbeType struct {
+	HTTP *HTTPProbe `tfsdk:"http"`
+	TCP  *TCPProbe  `tfsdk:"tcp"`
+	GRPC *GRPCProbe `tfsdk:"grpc"`
+	Exec *ExecProbe `tfsdk:"exec"`
+}
+
+type HTTPProbe struct {
+	Path   types.String `tfsdk:"path"`
+	Port   types.Int64  `tfsdk:"port"`
+	Scheme types.String `tfsdk:"scheme"`
+}
+
+type TCPProbe struct {
+	Port types.Int64  `tfsdk:"port"`
+	Host types.String `tfsdk:"host"`
+}
+
+type GRPCProbe struct {
+	Port    types.Int64  `tfsdk:"port"`
+	Service types.String `tfsdk:"service"`
+}
+
+type ExecProbe struct {
+	Command types.List `tfsdk:"command"`
+}
+
+func (h *Healthchecks) toUpsertRequest() healthcheck.Healthchecks {
+	if h == nil {
+		return healthcheck.Healthchecks{}
+	}
+
+	return healthcheck.Healthchecks{
+		LivenessProbe:  h.LivenessProbe.toUpsertRequest(),
+		ReadinessProbe: h.ReadinessProbe.toUpsertRequest(),
+	}
+}
+
+func (p *Probe) toUpsertRequest() *healthcheck.Probe {
+	if p == nil {
+		return nil
+	}
+
+	var probeType healthcheck.ProbeType
+	if p.Type.HTTP != nil {
+		probeType.HTTP = &healthcheck.HTTPProbe{
+			Path:   ToString(p.Type.HTTP.Path),
+			Port:   ToInt32(p.Type.HTTP.Port),
+			Scheme: ToString(p.Type.HTTP.Scheme),
+		}
+	}
+	if p.Type.TCP != nil {
+		probeType.TCP = &healthcheck.TCPProbe{
+			Port: ToInt32(p.Type.TCP.Port),
+			Host: ToStringPointer(p.Type.TCP.Host),
+		}
+	}
+	if p.Type.GRPC != nil {
+		probeType.GRPC = &healthcheck.GRPCProbe{
+			Port:    ToInt32(p.Type.GRPC.Port),
+			Service: ToStringPointer(p.Type.GRPC.Service),
+		}
+	}
+	if p.Type.Exec != nil {
+		probeType.Exec = &healthcheck.ExecProbe{
+			Command: ToStringArray(p.Type.Exec.Command),
+		}
+	}
+
+	return &healthcheck.Probe{
+		Type:                probeType,
+		InitialDelaySeconds: ToInt32(p.InitialDelaySeconds),
+		PeriodSeconds:       ToInt32(p.PeriodSeconds),
+		TimeoutSeconds:      ToInt32(p.TimeoutSeconds),
+		SuccessThreshold:    ToInt32(p.SuccessThreshold),
+		FailureThreshold:    ToInt32(p.FailureThreshold),
+	}
+}
+
+// convertDomainHealthchecksToHealthchecks returns the `healthchecks` block of the given healthchecks, nil if no probe is set.
+func convertDomainHealthchecksToHealthchecks(h healthcheck.Healthchecks) *Healthchecks {
+	if h.LivenessProbe == nil && h.ReadinessProbe == nil {
+		return nil
+	}
+
+	return &Healthchecks{
+		LivenessProbe:  convertDomainProbeToProbe(h.LivenessProbe),
+		ReadinessProbe: convertDomainProbeToProbe(h.ReadinessProbe),
+	}
+}
+
+func convertDomainProbeToProbe(p *healthcheck.Probe) *Probe {
+	if p == nil {
+		return nil
+	}
+
+	var probeType ProbeType
+	if p.Type.HTTP != nil {
+		probeType.HTTP = &HTTPProbe{
+			Path:   FromString(p.Type.HTTP.Path),
+			Port:   FromInt32(p.Type.HTTP.Port),
+			Scheme: FromString(p.Type.HTTP.Scheme),
+		}
+	}
+	if p.Type.TCP != nil {
+		probeType.TCP = &TCPProbe{
+			Port: FromInt32(p.Type.TCP.Port),
+			Host: FromStringPointer(p.Type.TCP.Host),
+		}
+	}
+	if p.Type.GRPC != nil {
+		probeType.GRPC = &GRPCProbe{
+			Port:    FromInt32(p.Type.GRPC.Port),
+			Service: FromStringPointer(p.Type.GRPC.Service),
+		}
+	}
+	if p.Type.Exec != nil {
+		probeType.Exec = &ExecProbe{
+			Command: FromStringArray(p.Type.Exec.Command),
+		}
+	}
+
+	return &Probe{
+		Type:                probeType,
+		InitialDelaySeconds: FromInt32(p.InitialDelaySeconds),
+		PeriodSeconds:       FromInt32(p.PeriodSeconds),
+		TimeoutSeconds:      FromInt32(p.TimeoutSeconds),
+		SuccessThreshold:    FromInt32(p.SuccessThreshold),
+		FailureThreshold:    FromInt32(p.FailureThreshold),
+	}
+}
+
+// healthchecksResourceAttribute returns the schema of the `healthchecks` block of the given kind of service.
+func healthchecksResourceAttribute(service string) tfsdk.Attribute {
+	portAttribute := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: descriptions.NewInt64MinMaxDescription(description, port.MinPort, port.MaxPort, nil),
+			Type:        types.Int64Type,
+			Required:    true,
+			Validators: []tfsdk.AttributeValidator{
+				validators.Int64MinMaxValidator{Min: port.MinPort, Max: port.MaxPort},
+			},
+		}
+	}
+
+	int64Attribute := func(description string, min int64, defaultValue int64) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: descriptions.NewInt64MinDescription(description, min, pointer.ToInt64(defaultValue)),
+			Type:        types.Int64Type,
+			Optional:    true,
+			Computed:    true,
+			Validators: []tfsdk.AttributeValidator{
+				validators.Int64MinValidator{Min: min},
+			},
+			PlanModifiers: tfsdk.AttributePlanModifiers{
+				modifiers.NewInt64DefaultModifier(defaultValue),
+			},
+		}
+	}
+
+	probeAttribute := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: description,
+			Optional:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"type": {
+					Description: "Kind of check run by the probe, exactly one of `http`, `tcp`, `grpc` or `exec` must be set.",
+					Required:    true,
+					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+						"http": {
+							Description: "Check the " + service + " with an HTTP GET request, any status code between 200 and 399 is a success.",
+							Optional:    true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"path": {
+									Description: descriptions.NewStringDefaultDescription("Path requested by the probe.", "/"),
+									Type:        types.StringType,
+									Optional:    true,
+									Computed:    true,
+									PlanModifiers: tfsdk.AttributePlanModifiers{
+										modifiers.NewStringDefaultModifier("/"),
+									},
+								},
+								"port": portAttribute("Port requested by the probe, it must be declared as an `HTTP` port of the " + service + "."),
+								"scheme": {
+									Description: descriptions.NewStringEnumDescription(
+										"Scheme used to request the port.",
+										healthcheck.AllowedSchemeValues,
+										pointer.ToString(healthcheck.DefaultScheme),
+									),
+									Type:     types.StringType,
+									Optional: true,
+									Computed: true,
+									Validators: []tfsdk.AttributeValidator{
+										validators.NewStringEnumValidator(healthcheck.AllowedSchemeValues),
+									},
+									PlanModifiers: tfsdk.AttributePlanModifiers{
+										modifiers.NewStringDefaultModifier(healthcheck.DefaultScheme),
+									},
+								},
+							}),
+						},
+						"tcp": {
+							Description: "Check the " + service + " by opening a TCP connection.",
+							Optional:    true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"port": portAttribute("Port connected to by the probe, it must be declared as a port of the " + service + " that is not `UDP`."),
+								"host": {
+									Description: "Host connected to by the probe, the pod IP if not set.",
+									Type:        types.StringType,
+									Optional:    true,
+								},
+							}),
+						},
+						"grpc": {
+							Description: "Check the " + service + " using the gRPC health checking protocol.",
+							Optional:    true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"port": portAttribute("Port requested by the probe, it must be declared as a `GRPC` port of the " + service + "."),
+								"service": {
+									Description: "Name of the gRPC service checked by the probe, the whole server if not set.",
+									Type:        types.StringType,
+									Optional:    true,
+								},
+							}),
+						},
+						"exec": {
+							Description: "Check the " + service + " by running a command inside its container, an exit code of 0 is a success.",
+							Optional:    true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"command": {
+									Description: "Command run by the probe.",
+									Type:        types.ListType{ElemType: types.StringType},
+									Required:    true,
+								},
+							}),
+						},
+					}),
+				},
+				"initial_delay_seconds": int64Attribute("Number of seconds to wait after the start of the "+service+" before running the probe.", 0, healthcheck.DefaultInitialDelaySeconds),
+				"period_seconds":        int64Attribute("Number of seconds between two runs of the probe.", 1, healthcheck.DefaultPeriodSeconds),
+				"timeout_seconds":       int64Attribute("Number of seconds after which the probe times out.", 1, healthcheck.DefaultTimeoutSeconds),
+				"success_threshold":     int64Attribute("Number of consecutive successes for the probe to be considered successful after a failure, it must be 1 for a liveness probe.", 1, healthcheck.DefaultSuccessThreshold),
+				"failure_threshold":     int64Attribute("Number of consecutive failures for the probe to be considered failed.", 1, healthcheck.DefaultFailureThreshold),
+			}),
+		}
+	}
+
+	return tfsdk.Attribute{
+		Description: "Probes used by Kubernetes to know whether the " + service + " is ready to serve traffic and still alive.",
+		Optional:    true,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"readiness_probe": probeAttribute("Probe telling whether the " + service + " is ready to serve traffic, it receives no traffic until the probe succeeds."),
+			"liveness_probe":  probeAttribute("Probe telling whether the " + service + " is still alive, it is restarted once the probe fails."),
+		}),
+	}
+}
+
+// healthchecksDataSourceAttribute returns the schema of the `healthchecks` block of the data sources of the given kind of service.
+func healthchecksDataSourceAttribute(service string) tfsdk.Attribute {
+	probeAttribute := func(description string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: description,
+			Computed:    true,
+			Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+				"type": {
+					Description: "Kind of check run by the probe.",
+					Computed:    true,
+					Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+						"http": {
+							Computed: true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"path":   {Type: types.StringType, Computed: true},
+								"port":   {Type: types.Int64Type, Computed: true},
+								"scheme": {Type: types.StringType, Computed: true},
+							}),
+						},
+						"tcp": {
+							Computed: true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"port": {Type: types.Int64Type, Computed: true},
+								"host": {Type: types.StringType, Computed: true},
+							}),
+						},
+						"grpc": {
+							Computed: true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"port":    {Type: types.Int64Type, Computed: true},
+								"service": {Type: types.StringType, Computed: true},
+							}),
+						},
+						"exec": {
+							Computed: true,
+							Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+								"command": {Type: types.ListType{ElemType: types.StringType}, Computed: true},
+							}),
+						},
+					}),
+				},
+				"initial_delay_seconds": {Type: types.Int64Type, Computed: true},
+				"period_seconds":        {Type: types.Int64Type, Computed: true},
+				"timeout_seconds":       {Type: types.Int64Type, Computed: true},
+				"success_threshold":     {Type: types.Int64Type, Computed: true},
+				"failure_threshold":     {Type: types.Int64Type, Computed: true},
+			}),
+		}
+	}
+
+	return tfsdk.Attribute{
+		Description: "Probes used by Kubernetes to know whether the " + service + " is ready to serve traffic and still alive.",
+		Computed:    true,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"readiness_probe": probeAttribute("Probe telling whether the " + service + " is ready to serve traffic."),
+			"liveness_probe":  probeAttribute("Probe telling whether the " + service + " is still alive."),
+		}),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/job"
 	"os"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -15,19 +16,31 @@ import (
 	"github.com/qovery/qovery-client-go"
 
 	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/container"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/deploymentstage"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/newdeployment"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/organization"
+	"github.com/qovery/terraform-provider-qovery/internal/httpretry"
 )
 
-const APITokenEnvName = "QOVERY_API_TOKEN"
+const (
+	APITokenEnvName = "QOVERY_API_TOKEN"
+	APIURLEnvName   = "QOVERY_API_URL"
+	// MaxAttemptsEnvName is the environment variable setting the maximum number of attempts of an api call.
+	MaxAttemptsEnvName = "QOVERY_API_MAX_ATTEMPTS"
+)
 
 // Ensure provider defined types fully satisfy terraform framework interfaces.
 var _ provider.Provider = &qProvider{}
@@ -46,31 +59,51 @@ type qProvider struct {
 	// testing.
 	version string
 
-	// client is set at the end of the Configure method.
-	// This is used to make http request to Qovery API.
-	client *client.Client
-
 	// organizationService is an instance of an organization.Service that handles the domain logic.
 	organizationService organization.Service
 
+	// clusterService is an instance of a cluster.Service that handles the domain logic.
+	clusterService cluster.Service
+
 	// awsCredentialsService is an instance of a credentials.AwsService that handles the domain logic.
 	awsCredentialsService credentials.AwsService
 
 	// scalewayCredentialsService is an instance of a credentials.ScalewayService that handles the domain logic.
 	scalewayCredentialsService credentials.ScalewayService
 
+	// gcpCredentialsService is an instance of a credentials.GcpService that handles the domain logic.
+	gcpCredentialsService credentials.GcpService
+
+	// digitalOceanCredentialsService is an instance of a credentials.DigitalOceanService that handles the domain logic.
+	digitalOceanCredentialsService credentials.DigitalOceanService
+
 	// projectService is an instance of a project.Service that handles the domain logic.
 	projectService project.Service
 
+	// applicationService is an instance of an application.Service that handles the domain logic.
+	applicationService application.Service
+
+	// applicationCustomDomainService is an instance of a customdomain.Service that handles the domain logic of the application custom domains.
+	applicationCustomDomainService customdomain.Service
+
 	// containerService is an instance of a container.Service that handles the domain logic.
 	containerService container.Service
 
+	// containerCustomDomainService is an instance of a customdomain.Service that handles the domain logic of the container custom domains.
+	containerCustomDomainService customdomain.Service
+
 	// jobService is an instance of a job.Service that handles the domain logic.
 	jobService job.Service
 
+	// databaseService is an instance of a database.Service that handles the domain logic.
+	databaseService database.Service
+
 	// containerRegistryService is an instance of a registry.Service that handles the domain logic.
 	containerRegistryService registry.Service
 
+	// gitTokenService is an instance of a gittoken.Service that handles the domain logic.
+	gitTokenService gittoken.Service
+
 	// environmentService is an instance of an environment.Service that handles the domain logic.
 	environmentService environment.Service
 
@@ -79,11 +112,19 @@ type qProvider struct {
 
 	// deploymentService is an instance of a newdeployment.Service that handles the domain logic.
 	deploymentService newdeployment.Service
+
+	// environmentVariableService is an instance of a variable.KeyService that handles the domain logic.
+	environmentVariableService variable.KeyService
+
+	// secretService is an instance of a secret.KeyService that handles the domain logic.
+	secretService secret.KeyService
 }
 
 // providerData can be used to store data from the Terraform configuration.
 type providerData struct {
-	Token types.String `tfsdk:"token"`
+	Token       types.String `tfsdk:"token"`
+	APIURL      types.String `tfsdk:"api_url"`
+	MaxAttempts types.Int64  `tfsdk:"max_attempts"`
 }
 
 func (p *qProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -125,8 +166,56 @@ func (p *qProvider) Configure(ctx context.Context, req provider.ConfigureRequest
 		return
 	}
 
+	if data.APIURL.Unknown {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as api_url",
+		)
+		return
+	}
+
+	apiURL := data.APIURL.Value
+	if data.APIURL.Null {
+		apiURL = os.Getenv(APIURLEnvName)
+	}
+
+	if data.MaxAttempts.Unknown {
+		// Cannot connect to client with an unknown value
+		resp.Diagnostics.AddWarning(
+			"Unable to create client",
+			"Cannot use unknown value as max_attempts",
+		)
+		return
+	}
+
+	// A zero value lets the api client use its default number of attempts.
+	var maxAttempts int64
+	maxAttemptsEnv := os.Getenv(MaxAttemptsEnvName)
+	if !data.MaxAttempts.Null {
+		maxAttempts = data.MaxAttempts.Value
+	} else if maxAttemptsEnv != "" {
+		parsed, err := strconv.ParseInt(maxAttemptsEnv, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid max_attempts",
+				fmt.Sprintf("%s must be an integer: %s", MaxAttemptsEnvName, err),
+			)
+			return
+		}
+		maxAttempts = parsed
+	}
+
+	if maxAttempts < 1 && (!data.MaxAttempts.Null || maxAttemptsEnv != "") {
+		resp.Diagnostics.AddError(
+			"Invalid max_attempts",
+			"max_attempts must be at least 1",
+		)
+		return
+	}
+
 	// Initialize qovery client
-	domainServices, err := services.New(services.WithQoveryRepository(token, p.version))
+	domainServices, err := services.New(services.WithQoveryRepository(token, p.version, apiURL, int(maxAttempts)))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to initialize domain services",
@@ -135,19 +224,28 @@ func (p *qProvider) Configure(ctx context.Context, req provider.ConfigureRequest
 		return
 	}
 
-	// Create a new Qovery client and set it to the provider client
+	// Set the domain services on the provider
 	p.configured = true
-	p.client = client.New(token, p.version)
 	p.organizationService = domainServices.Organization
+	p.clusterService = domainServices.Cluster
 	p.awsCredentialsService = domainServices.CredentialsAws
 	p.scalewayCredentialsService = domainServices.CredentialsScaleway
+	p.gcpCredentialsService = domainServices.CredentialsGcp
+	p.digitalOceanCredentialsService = domainServices.CredentialsDigitalOcean
 	p.projectService = domainServices.Project
+	p.applicationService = domainServices.Application
+	p.applicationCustomDomainService = domainServices.ApplicationCustomDomain
 	p.containerService = domainServices.Container
+	p.containerCustomDomainService = domainServices.ContainerCustomDomain
 	p.jobService = domainServices.Job
 	p.containerRegistryService = domainServices.ContainerRegistry
+	p.gitTokenService = domainServices.GitToken
+	p.databaseService = domainServices.Database
 	p.environmentService = domainServices.Environment
 	p.deploymentStageService = domainServices.DeploymentStage
 	p.deploymentService = domainServices.Deployment
+	p.environmentVariableService = domainServices.EnvironmentVariable
+	p.secretService = domainServices.Secret
 
 	resp.DataSourceData = p
 	resp.ResourceData = p
@@ -163,11 +261,17 @@ func (p *qProvider) Resources(_ context.Context) []func() resource.Resource {
 		newOrganizationResource,
 		newProjectResource,
 		newScalewayCredentialsResource,
+		newGcpCredentialsResource,
+		newDigitalOceanCredentialsResource,
 		newContainerResource,
 		newContainerRegistryResource,
+		newGitTokenResource,
 		newJobResource,
 		newDeploymentStageResource,
 		newDeploymentResource,
+		newCustomDomainResource,
+		newEnvironmentVariableResource,
+		newSecretResource,
 	}
 }
 
@@ -184,6 +288,8 @@ func (p *qProvider) DataSources(_ context.Context) []func() datasource.DataSourc
 		newOrganizationDataSource,
 		newProjectDataSource,
 		newScalewayCredentialsDataSource,
+		newGcpCredentialsDataSource,
+		newDigitalOceanCredentialsDataSource,
 		newDeploymentStageDataSource,
 		newDeploymentDataSource,
 	}
@@ -197,14 +303,20 @@ func (p *qProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics
 				Type:        types.StringType,
 				Optional:    true,
 			},
+			"api_url": {
+				Description: "The Qovery API URL to use. Defaults to the public Qovery API. This can also be specified with the `QOVERY_API_URL` shell environment variable.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"max_attempts": {
+				Description: fmt.Sprintf("The maximum number of attempts of a Qovery API call, retries included. Only the calls that are safe to send again are retried on rate limiting & transient server errors. Set to 1 to disable retries. Defaults to %d. This can also be specified with the `QOVERY_API_MAX_ATTEMPTS` shell environment variable.", httpretry.DefaultMaxAttempts),
+				Type:        types.Int64Type,
+				Optional:    true,
+			},
 		},
 	}, nil
 }
 
-func (p qProvider) GetClient() *client.Client {
-	return p.client
-}
-
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &qProvider{
@@ -213,10 +325,20 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func NewQoveryAPIClient(token string, version string) *qovery.APIClient {
+// NewQoveryAPIClient returns a new qovery.APIClient using the given token.
+// If apiURL is empty, the default Qovery API server is used.
+func NewQoveryAPIClient(token string, version string, apiURL string) *qovery.APIClient {
 	cfg := qovery.NewConfiguration()
 	cfg.AddDefaultHeader("Authorization", fmt.Sprintf("Token %s", token))
 	cfg.AddDefaultHeader("content-type", "application/json")
 	cfg.UserAgent = fmt.Sprintf("terraform-provider-qovery/%s", version)
+	cfg.HTTPClient = httpretry.NewClient()
+	if apiURL != "" {
+		cfg.Servers = qovery.ServerConfigurations{
+			{
+				URL: apiURL,
+			},
+		}
+	}
 	return qovery.NewAPIClient(cfg)
 }
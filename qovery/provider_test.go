@@ -15,8 +15,6 @@ import (
 	"github.com/sethvargo/go-envconfig"
 
 	"github.com/qovery/terraform-provider-qovery/internal/application/services"
-
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/qovery"
 )
 
@@ -35,6 +33,13 @@ type testEnvironment struct {
 	ScalewayCredentialsProjectID  string `env:"TEST_SCALEWAY_CREDENTIALS_PROJECT_ID,required"`
 	ScalewayCredentialsAccessKey  string `env:"TEST_SCALEWAY_CREDENTIALS_ACCESS_KEY,required"`
 	ScalewayCredentialsSecretKey  string `env:"TEST_SCALEWAY_CREDENTIALS_SECRET_KEY,required"`
+	GcpCredentialsID              string `env:"TEST_GCP_CREDENTIALS_ID,required"`
+	DigitalOceanCredentialsID     string `env:"TEST_DIGITALOCEAN_CREDENTIALS_ID,required"`
+	DigitalOceanToken             string `env:"TEST_DIGITALOCEAN_CREDENTIALS_TOKEN,required"`
+	DigitalOceanSpacesAccessID    string `env:"TEST_DIGITALOCEAN_CREDENTIALS_SPACES_ACCESS_ID,required"`
+	DigitalOceanSpacesSecretKey   string `env:"TEST_DIGITALOCEAN_CREDENTIALS_SPACES_SECRET_KEY,required"`
+	GcpCredentials                string `env:"TEST_GCP_CREDENTIALS,required"`
+	GitHubToken                   string `env:"TEST_GITHUB_TOKEN,required"`
 	ClusterID                     string `env:"TEST_CLUSTER_ID,required"`
 	ProjectID                     string `env:"TEST_PROJECT_ID,required"`
 	EnvironmentID                 string `env:"TEST_ENVIRONMENT_ID,required"`
@@ -47,9 +52,8 @@ type testEnvironment struct {
 }
 
 var (
-	apiClient         = client.New(os.Getenv(qovery.APITokenEnvName), "test")
-	qoveryServices, _ = services.New(services.WithQoveryRepository(os.Getenv(qovery.APITokenEnvName), "test"))
-	qoveryApiClient   = client.NewQoveryApiClient(os.Getenv(qovery.APITokenEnvName), "test")
+	qoveryServices, _ = services.New(services.WithQoveryRepository(os.Getenv(qovery.APITokenEnvName), "test", os.Getenv(qovery.APIURLEnvName), 0))
+	qoveryApiClient   = qovery.NewQoveryAPIClient(os.Getenv(qovery.APITokenEnvName), "test", os.Getenv(qovery.APIURLEnvName))
 )
 
 var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
@@ -95,6 +99,34 @@ func getTestScalewayCredentialsSecretKey() string {
 	return os.Getenv("TEST_SCALEWAY_CREDENTIALS_SECRET_KEY")
 }
 
+func getTestGcpCredentialsID() string {
+	return os.Getenv("TEST_GCP_CREDENTIALS_ID")
+}
+
+func getTestGcpCredentials() string {
+	return os.Getenv("TEST_GCP_CREDENTIALS")
+}
+
+func getTestDigitalOceanCredentialsID() string {
+	return os.Getenv("TEST_DIGITALOCEAN_CREDENTIALS_ID")
+}
+
+func getTestDigitalOceanCredentialsToken() string {
+	return os.Getenv("TEST_DIGITALOCEAN_CREDENTIALS_TOKEN")
+}
+
+func getTestDigitalOceanCredentialsSpacesAccessID() string {
+	return os.Getenv("TEST_DIGITALOCEAN_CREDENTIALS_SPACES_ACCESS_ID")
+}
+
+func getTestDigitalOceanCredentialsSpacesSecretKey() string {
+	return os.Getenv("TEST_DIGITALOCEAN_CREDENTIALS_SPACES_SECRET_KEY")
+}
+
+func getTestGitHubToken() string {
+	return os.Getenv("TEST_GITHUB_TOKEN")
+}
+
 func getTestClusterID() string {
 	return os.Getenv("TEST_CLUSTER_ID")
 }
@@ -12,7 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
@@ -57,8 +58,8 @@ var (
 	// Application Port
 	applicationPortMin                       int64 = 1
 	applicationPortMax                       int64 = 65535
-	applicationPortProtocols                       = clientEnumToStringArray(qovery.AllowedPortProtocolEnumEnumValues)
-	applicationPortProtocolDefault                 = string(qovery.PORTPROTOCOLENUM_HTTP)
+	applicationPortProtocols                       = clientEnumToStringArray(port.AllowedProtocolValues)
+	applicationPortProtocolDefault                 = port.DefaultProtocol.String()
 	applicationPortPubliclyAccessibleDefault       = false
 
 	// Application Git Repository
@@ -67,7 +68,7 @@ var (
 )
 
 type applicationResource struct {
-	client *client.Client
+	applicationService application.Service
 }
 
 func newApplicationResource() resource.Resource {
@@ -93,7 +94,7 @@ func (r *applicationResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = provider.client
+	r.applicationService = provider.applicationService
 }
 
 func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -109,6 +110,9 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the application.",
@@ -145,6 +149,21 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 							modifiers.NewStringDefaultModifier(applicationGitRepositoryRootPathDefault),
 						},
 					},
+					"git_token_id": {
+						Description: "Id of the git token used to access the git repository (see `qovery_git_token`). If not set, the git provider app of the organization is used.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"commit_id": {
+						Description: "Full sha of the commit of the git repository to deploy. The commit is deployed each time it changes. If not set, the application is deployed with the last commit of the branch.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"deployed_commit_id": {
+						Description: "Sha of the commit of the git repository currently deployed.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"build_mode": {
@@ -342,7 +361,7 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 					},
 					"external_port": {
 						Description: descriptions.NewInt64MinMaxDescription(
-							"External port of the application.\n\t- Required if: `ports.publicly_accessible=true`.",
+							"External port of the application.\n\t- Required if: `ports.publicly_accessible=true` and `ports.protocol` is `TCP` or `UDP`.",
 							applicationPortMin,
 							applicationPortMax,
 							nil,
@@ -367,6 +386,9 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:     types.StringType,
 						Optional: true,
 						Computed: true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.NewStringEnumValidator(applicationPortProtocols),
+						},
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							modifiers.NewStringDefaultModifier(applicationPortProtocolDefault),
 						},
@@ -392,10 +414,15 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
-				Description: "List of environment variables linked to this application.",
+				Description: "List of environment variables linked to this application. The environment variables of the application not listed here (i.e: managed by a `qovery_environment_variable` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -413,10 +440,67 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this application.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this application.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
-				Description: "List of secrets linked to this application.",
+				Description: "List of secrets linked to this application. The secrets of the application not listed here (i.e: managed by a `qovery_secret` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -435,10 +519,15 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Required:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"custom_domains": {
-				Description: "List of custom domains linked to this application.",
+				Description: "List of custom domains linked to this application. The custom domains of the application not listed here (i.e: managed by a `qovery_custom_domain` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -479,6 +568,9 @@ func (r applicationResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksResourceAttribute("application"),
+			"advanced_settings": advancedSettingsResourceAttribute("application", applicationAdvancedSettings),
+			"timeouts":          timeoutsResourceAttribute(defaultServiceTimeout),
 		},
 	}, nil
 }
@@ -492,20 +584,23 @@ func (r applicationResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Create new application
-	request, err := plan.toCreateApplicationRequest()
+	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application create", err)
 		return
 	}
-	application, apiErr := r.client.CreateApplication(ctx, ToString(plan.EnvironmentId), request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := r.applicationService.Create(ctx, ToString(plan.EnvironmentId), *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertResponseToApplication(plan, application)
+	state := convertDomainApplicationToApplication(plan, app).keepManaged(plan)
 	tflog.Trace(ctx, "created application", map[string]interface{}{"application_id": state.Id.Value})
 
 	// Set state
@@ -522,14 +617,14 @@ func (r applicationResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get application from the API
-	application, apiErr := r.client.GetApplication(ctx, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := r.applicationService.Get(ctx, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertResponseToApplication(state, application)
+	state = convertDomainApplicationToApplication(state, app).keepManaged(state)
 	tflog.Trace(ctx, "read application", map[string]interface{}{"application_id": state.Id.Value})
 
 	// Set state
@@ -546,20 +641,23 @@ func (r applicationResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Update application in the backend
-	request, err := plan.toUpdateApplicationRequest(state)
+	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application update", err)
 		return
 	}
-	application, apiErr := r.client.UpdateApplication(ctx, state.Id.Value, request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	app, err := r.applicationService.Update(ctx, state.Id.Value, *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application update", err)
 		return
 	}
 
 	// Update state values
-	state = convertResponseToApplication(plan, application)
+	state = convertDomainApplicationToApplication(plan, app).keepManaged(plan)
 	tflog.Trace(ctx, "updated application", map[string]interface{}{"application_id": state.Id.Value})
 
 	// Set state
@@ -575,10 +673,13 @@ func (r applicationResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Delete application
-	apiErr := r.client.DeleteApplication(ctx, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	err := r.applicationService.Delete(ctx, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on application delete", err)
 		return
 	}
 
@@ -1,42 +1,59 @@
 package qovery
 
 import (
+	"github.com/AlekSi/pointer"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/application"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/git_repository"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/storage"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
 type Application struct {
-	Id                          types.String              `tfsdk:"id"`
-	EnvironmentId               types.String              `tfsdk:"environment_id"`
-	Name                        types.String              `tfsdk:"name"`
-	GitRepository               *ApplicationGitRepository `tfsdk:"git_repository"`
-	BuildMode                   types.String              `tfsdk:"build_mode"`
-	DockerfilePath              types.String              `tfsdk:"dockerfile_path"`
-	BuildpackLanguage           types.String              `tfsdk:"buildpack_language"`
-	CPU                         types.Int64               `tfsdk:"cpu"`
-	Memory                      types.Int64               `tfsdk:"memory"`
-	MinRunningInstances         types.Int64               `tfsdk:"min_running_instances"`
-	MaxRunningInstances         types.Int64               `tfsdk:"max_running_instances"`
-	AutoPreview                 types.Bool                `tfsdk:"auto_preview"`
-	Storage                     []ApplicationStorage      `tfsdk:"storage"`
-	Ports                       []ApplicationPort         `tfsdk:"ports"`
-	CustomDomains               types.Set                 `tfsdk:"custom_domains"`
-	BuiltInEnvironmentVariables types.Set                 `tfsdk:"built_in_environment_variables"`
-	EnvironmentVariables        types.Set                 `tfsdk:"environment_variables"`
-	Secrets                     types.Set                 `tfsdk:"secrets"`
-	ExternalHost                types.String              `tfsdk:"external_host"`
-	InternalHost                types.String              `tfsdk:"internal_host"`
-	Entrypoint                  types.String              `tfsdk:"entrypoint"`
-	Arguments                   types.List                `tfsdk:"arguments"`
-	DeploymentStageId           types.String              `tfsdk:"deployment_stage_id"`
+	Id                           types.String              `tfsdk:"id"`
+	EnvironmentId                types.String              `tfsdk:"environment_id"`
+	Name                         types.String              `tfsdk:"name"`
+	GitRepository                *ApplicationGitRepository `tfsdk:"git_repository"`
+	BuildMode                    types.String              `tfsdk:"build_mode"`
+	DockerfilePath               types.String              `tfsdk:"dockerfile_path"`
+	BuildpackLanguage            types.String              `tfsdk:"buildpack_language"`
+	CPU                          types.Int64               `tfsdk:"cpu"`
+	Memory                       types.Int64               `tfsdk:"memory"`
+	MinRunningInstances          types.Int64               `tfsdk:"min_running_instances"`
+	MaxRunningInstances          types.Int64               `tfsdk:"max_running_instances"`
+	AutoPreview                  types.Bool                `tfsdk:"auto_preview"`
+	Storage                      []ApplicationStorage      `tfsdk:"storage"`
+	Ports                        []ApplicationPort         `tfsdk:"ports"`
+	Healthchecks                 *Healthchecks             `tfsdk:"healthchecks"`
+	AdvancedSettings             types.Object              `tfsdk:"advanced_settings"`
+	CustomDomains                types.Set                 `tfsdk:"custom_domains"`
+	BuiltInEnvironmentVariables  types.Set                 `tfsdk:"built_in_environment_variables"`
+	EnvironmentVariables         types.Set                 `tfsdk:"environment_variables"`
+	EnvironmentVariableAliases   types.Set                 `tfsdk:"environment_variable_aliases"`
+	EnvironmentVariableOverrides types.Set                 `tfsdk:"environment_variable_overrides"`
+	Secrets                      types.Set                 `tfsdk:"secrets"`
+	ExternalHost                 types.String              `tfsdk:"external_host"`
+	InternalHost                 types.String              `tfsdk:"internal_host"`
+	Entrypoint                   types.String              `tfsdk:"entrypoint"`
+	Arguments                    types.List                `tfsdk:"arguments"`
+	DeploymentStageId            types.String              `tfsdk:"deployment_stage_id"`
+	Timeouts                     *Timeouts                 `tfsdk:"timeouts"`
 }
 
 func (app Application) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(app.EnvironmentVariables)
 }
 
+func (app Application) EnvironmentVariableAliasList() EnvironmentVariableList {
+	return toEnvironmentVariableList(app.EnvironmentVariableAliases)
+}
+
+func (app Application) EnvironmentVariableOverrideList() EnvironmentVariableList {
+	return toEnvironmentVariableList(app.EnvironmentVariableOverrides)
+}
+
 func (app Application) BuiltInEnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(app.BuiltInEnvironmentVariables)
 }
@@ -49,185 +66,175 @@ func (app Application) CustomDomainsList() CustomDomainList {
 	return toCustomDomainList(app.CustomDomains)
 }
 
-func (app Application) toCreateApplicationRequest() (*client.ApplicationCreateParams, error) {
-	storage := make([]qovery.ServiceStorageRequestStorageInner, 0, len(app.Storage))
-	for _, store := range app.Storage {
-		s, err := store.toCreateRequest()
-		if err != nil {
-			return nil, err
-		}
-		storage = append(storage, *s)
+func (app Application) toUpsertServiceRequest(state *Application) (*application.UpsertServiceRequest, error) {
+	var stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides EnvironmentVariableList
+	if state != nil {
+		stateEnvironmentVariables = state.EnvironmentVariableList()
+		stateEnvironmentVariableAliases = state.EnvironmentVariableAliasList()
+		stateEnvironmentVariableOverrides = state.EnvironmentVariableOverrideList()
 	}
 
-	ports := make([]qovery.ServicePortRequestPortsInner, 0, len(app.Ports))
-	for _, port := range app.Ports {
-		p, err := port.toCreateRequest()
-		if err != nil {
-			return nil, err
-		}
-		ports = append(ports, *p)
+	environmentVariables := newEnvironmentVariablesDiffRequest(
+		app.EnvironmentVariableList(), app.EnvironmentVariableAliasList(), app.EnvironmentVariableOverrideList(),
+		stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides,
+	)
+
+	var stateSecrets SecretList
+	if state != nil {
+		stateSecrets = state.SecretList()
 	}
 
-	var buildMode *qovery.BuildModeEnum
-	if !app.BuildMode.Null && !app.BuildMode.Unknown {
-		bm, err := qovery.NewBuildModeEnumFromValue(ToString(app.BuildMode))
-		if err != nil {
-			return nil, err
-		}
-		buildMode = bm
+	var stateCustomDomains CustomDomainList
+	if state != nil {
+		stateCustomDomains = state.CustomDomainsList()
 	}
 
-	return &client.ApplicationCreateParams{
-		ApplicationRequest: qovery.ApplicationRequest{
-			Name:                ToString(app.Name),
-			BuildMode:           buildMode,
-			DockerfilePath:      ToNullableString(app.DockerfilePath),
-			BuildpackLanguage:   ToNullableNullableBuildPackLanguageEnum(app.BuildpackLanguage),
-			Cpu:                 ToInt32Pointer(app.CPU),
-			Memory:              ToInt32Pointer(app.Memory),
-			MinRunningInstances: ToInt32Pointer(app.MinRunningInstances),
-			MaxRunningInstances: ToInt32Pointer(app.MaxRunningInstances),
-			AutoPreview:         ToBoolPointer(app.AutoPreview),
-			GitRepository:       app.GitRepository.toCreateRequest(),
-			Storage:             storage,
-			Ports:               ports,
-			Entrypoint:          ToStringPointer(app.Entrypoint),
-			Arguments:           ToStringArray(app.Arguments),
-		},
-		EnvironmentVariablesDiff:     app.EnvironmentVariableList().diff(nil),
-		SecretsDiff:                  app.SecretList().diff(nil),
-		CustomDomainsDiff:            app.CustomDomainsList().diff(nil),
-		ApplicationDeploymentStageID: ToString(app.DeploymentStageId),
+	advancedSettings, err := toAdvancedSettings(app.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+	repositoryRequest := app.toUpsertRepositoryRequest(state)
+	repositoryRequest.AdvancedSettings = advancedSettings
+
+	return &application.UpsertServiceRequest{
+		ApplicationUpsertRequest: repositoryRequest,
+		EnvironmentVariables:     environmentVariables,
+		Secrets:                  app.SecretList().diffRequest(stateSecrets),
+		CustomDomains:            app.CustomDomainsList().diffRequest(stateCustomDomains),
+		MountPaths:               append(app.EnvironmentVariableList().mountPaths(), app.SecretList().mountPaths()...),
 	}, nil
-
 }
 
-func (app Application) toUpdateApplicationRequest(state Application) (*client.ApplicationUpdateParams, error) {
-	// Create a hashmap containing current terraform state ApplicationStorage by MountPoint
-	// MountPoint is unique with an application
-	stateApplicationStoragesByMountPoint := make(map[string]ApplicationStorage)
-	for _, existingStorage := range state.Storage {
-		stateApplicationStoragesByMountPoint[existingStorage.MountPoint.String()] = existingStorage
-	}
-
-	applicationStorageRequest := make([]qovery.ServiceStorageRequestStorageInner, 0, len(app.Storage))
-	for _, storage := range app.Storage {
-		// The storage id can be:
-		// - nil if a new storage is declared in the application resource
-		// - set if it already exists
-		var storageId string
-		value, exists := stateApplicationStoragesByMountPoint[storage.MountPoint.String()]
-		if exists {
-			storageId = ToString(value.Id)
+func (app Application) toUpsertRepositoryRequest(state *Application) application.UpsertRepositoryRequest {
+	// Storages & ports ids are only known from the state:
+	// a storage is unique by its mount point and a port by its internal port within an application.
+	stateStorageIDsByMountPoint := make(map[string]types.String)
+	statePortIDsByInternalPort := make(map[int32]types.String)
+	if state != nil {
+		for _, store := range state.Storage {
+			stateStorageIDsByMountPoint[ToString(store.MountPoint)] = store.Id
 		}
-
-		s, err := storage.toUpdateRequest(storageId)
-		if err != nil {
-			return nil, err
+		for _, prt := range state.Ports {
+			statePortIDsByInternalPort[ToInt64(prt.InternalPort)] = prt.Id
 		}
-		applicationStorageRequest = append(applicationStorageRequest, *s)
 	}
 
-	ports := make([]qovery.ServicePort, 0, len(app.Ports))
-	for _, port := range app.Ports {
-		p, err := port.toUpdateRequest()
-		if err != nil {
-			return nil, err
-		}
-		ports = append(ports, *p)
+	storages := make([]storage.UpsertRequest, 0, len(app.Storage))
+	for _, store := range app.Storage {
+		storages = append(storages, store.toUpsertRequest(stateStorageIDsByMountPoint[ToString(store.MountPoint)]))
 	}
 
-	var buildMode *qovery.BuildModeEnum
-	if !app.BuildMode.Null && !app.BuildMode.Unknown {
-		bm, err := qovery.NewBuildModeEnumFromValue(ToString(app.BuildMode))
-		if err != nil {
-			return nil, err
-		}
-		buildMode = bm
+	ports := make([]port.UpsertRequest, 0, len(app.Ports))
+	for _, prt := range app.Ports {
+		ports = append(ports, prt.toUpsertRequest(statePortIDsByInternalPort[ToInt64(prt.InternalPort)]))
+	}
+
+	var gitRepository application.GitRepositoryUpsertRequest
+	if app.GitRepository != nil {
+		gitRepository = app.GitRepository.toUpsertRequest()
 	}
 
-	applicationEditRequest := qovery.ApplicationEditRequest{
-		Name:                ToStringPointer(app.Name),
-		BuildMode:           buildMode,
+	return application.UpsertRepositoryRequest{
+		Name:                ToString(app.Name),
+		GitRepository:       gitRepository,
+		BuildMode:           ToStringPointer(app.BuildMode),
 		DockerfilePath:      ToStringPointer(app.DockerfilePath),
-		BuildpackLanguage:   ToNullableNullableBuildPackLanguageEnum(app.BuildpackLanguage),
-		Cpu:                 ToInt32Pointer(app.CPU),
+		BuildpackLanguage:   ToStringPointer(app.BuildpackLanguage),
+		AutoPreview:         ToBoolPointer(app.AutoPreview),
+		Entrypoint:          ToStringPointer(app.Entrypoint),
+		CPU:                 ToInt32Pointer(app.CPU),
 		Memory:              ToInt32Pointer(app.Memory),
 		MinRunningInstances: ToInt32Pointer(app.MinRunningInstances),
 		MaxRunningInstances: ToInt32Pointer(app.MaxRunningInstances),
-		AutoPreview:         ToBoolPointer(app.AutoPreview),
-		GitRepository:       app.GitRepository.toUpdateRequest(),
-		Storage:             applicationStorageRequest,
-		Ports:               ports,
-		Entrypoint:          ToStringPointer(app.Entrypoint),
 		Arguments:           ToStringArray(app.Arguments),
+		Storages:            storages,
+		Ports:               ports,
+		Healthchecks:        app.Healthchecks.toUpsertRequest(),
+		DeploymentStageID:   ToString(app.DeploymentStageId),
 	}
-	return &client.ApplicationUpdateParams{
-		ApplicationEditRequest:       applicationEditRequest,
-		EnvironmentVariablesDiff:     app.EnvironmentVariableList().diff(state.EnvironmentVariableList()),
-		SecretsDiff:                  app.SecretList().diff(state.SecretList()),
-		CustomDomainsDiff:            app.CustomDomainsList().diff(state.CustomDomainsList()),
-		ApplicationDeploymentStageID: ToString(app.DeploymentStageId),
-	}, nil
-
 }
 
-func convertResponseToApplication(state Application, app *client.ApplicationResponse) Application {
+func convertDomainApplicationToApplication(state Application, app *application.Application) Application {
 	return Application{
-		Id:                          FromString(app.ApplicationResponse.Id),
-		EnvironmentId:               FromString(app.ApplicationResponse.Environment.Id),
-		Name:                        FromStringPointer(app.ApplicationResponse.Name),
-		BuildMode:                   fromClientEnumPointer(app.ApplicationResponse.BuildMode),
-		DockerfilePath:              FromNullableString(app.ApplicationResponse.DockerfilePath),
-		BuildpackLanguage:           FromNullableNullableBuildPackLanguageEnum(app.ApplicationResponse.BuildpackLanguage),
-		CPU:                         FromInt32Pointer(app.ApplicationResponse.Cpu),
-		Memory:                      FromInt32Pointer(app.ApplicationResponse.Memory),
-		MinRunningInstances:         FromInt32Pointer(app.ApplicationResponse.MinRunningInstances),
-		MaxRunningInstances:         FromInt32Pointer(app.ApplicationResponse.MaxRunningInstances),
-		AutoPreview:                 FromBoolPointer(app.ApplicationResponse.AutoPreview),
-		GitRepository:               convertResponseToApplicationGitRepository(app.ApplicationResponse.GitRepository),
-		Storage:                     convertResponseToApplicationStorage(app.ApplicationResponse.Storage),
-		Ports:                       convertResponseToApplicationPorts(app.ApplicationResponse.Ports),
-		BuiltInEnvironmentVariables: fromEnvironmentVariableList(app.ApplicationEnvironmentVariables, qovery.APIVARIABLESCOPEENUM_BUILT_IN).toTerraformSet(),
-		EnvironmentVariables:        fromEnvironmentVariableList(app.ApplicationEnvironmentVariables, qovery.APIVARIABLESCOPEENUM_APPLICATION).toTerraformSet(),
-		Secrets:                     fromSecretList(state.SecretList(), app.ApplicationSecrets, qovery.APIVARIABLESCOPEENUM_APPLICATION).toTerraformSet(),
-		CustomDomains:               fromCustomDomainList(app.ApplicationCustomDomains).toTerraformSet(),
-		InternalHost:                FromString(app.ApplicationInternalHost),
-		ExternalHost:                FromStringPointer(app.ApplicationExternalHost),
-		Entrypoint:                  FromStringPointer(app.ApplicationResponse.Entrypoint),
-		Arguments:                   FromStringArray(app.ApplicationResponse.Arguments),
-		DeploymentStageId:           FromString(app.ApplicationDeploymentStageID),
+		Id:                           FromString(app.ID.String()),
+		EnvironmentId:                FromString(app.EnvironmentID.String()),
+		Name:                         FromString(app.Name),
+		BuildMode:                    FromString(app.BuildMode.String()),
+		DockerfilePath:               FromStringPointer(app.DockerfilePath),
+		BuildpackLanguage:            FromStringPointer(app.BuildpackLanguage),
+		CPU:                          FromInt32(app.CPU),
+		Memory:                       FromInt32(app.Memory),
+		MinRunningInstances:          FromInt32(app.MinRunningInstances),
+		MaxRunningInstances:          FromInt32(app.MaxRunningInstances),
+		AutoPreview:                  FromBool(app.AutoPreview),
+		GitRepository:                convertDomainGitRepositoryToApplicationGitRepository(state.GitRepository, app.GitRepository),
+		Storage:                      convertDomainStoragesToApplicationStorage(app.Storages),
+		Ports:                        convertDomainPortsToApplicationPorts(app.Ports),
+		Healthchecks:                 convertDomainHealthchecksToHealthchecks(app.Healthchecks),
+		AdvancedSettings:             fromAdvancedSettings(app.AdvancedSettings, applicationAdvancedSettings),
+		BuiltInEnvironmentVariables:  convertDomainVariablesToEnvironmentVariableList(app.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
+		EnvironmentVariables:         convertDomainVariablesToEnvironmentVariableList(app.EnvironmentVariables, variable.ScopeApplication).toTerraformSet(),
+		EnvironmentVariableAliases:   convertDomainVariablesToEnvironmentVariableAliasList(app.EnvironmentVariables, variable.ScopeApplication).toTerraformSet(),
+		EnvironmentVariableOverrides: convertDomainVariablesToEnvironmentVariableOverrideList(app.EnvironmentVariables, variable.ScopeApplication).toTerraformSet(),
+		Secrets:                      convertDomainSecretsToSecretList(state.SecretList(), app.Secrets, variable.ScopeApplication).toTerraformSet(),
+		CustomDomains:                convertDomainCustomDomainsToCustomDomainList(app.CustomDomains).toTerraformSet(),
+		InternalHost:                 FromString(pointer.GetString(app.InternalHost)),
+		ExternalHost:                 FromStringPointer(app.ExternalHost),
+		Entrypoint:                   FromStringPointer(app.Entrypoint),
+		Arguments:                    FromStringArray(app.Arguments),
+		DeploymentStageId:            FromString(app.DeploymentStageID),
+		Timeouts:                     state.Timeouts,
 	}
 }
 
-type ApplicationGitRepository struct {
-	URL      types.String `tfsdk:"url"`
-	RootPath types.String `tfsdk:"root_path"`
-	Branch   types.String `tfsdk:"branch"`
+// keepManaged returns the application with only the environment variables, secrets and custom domains managed in the given plan or state,
+// so the ones managed by the `qovery_environment_variable`, `qovery_secret` and `qovery_custom_domain` resources are neither shown as a drift nor deleted.
+// Everything is kept on import, as the managed ones aren't known yet.
+func (app Application) keepManaged(managed Application) Application {
+	if managed.EnvironmentId.Null {
+		return app
+	}
+
+	app.EnvironmentVariables = app.EnvironmentVariableList().managed(managed.EnvironmentVariableList()).toTerraformSet()
+	app.EnvironmentVariableAliases = app.EnvironmentVariableAliasList().managed(managed.EnvironmentVariableAliasList()).toTerraformSet()
+	app.EnvironmentVariableOverrides = app.EnvironmentVariableOverrideList().managed(managed.EnvironmentVariableOverrideList()).toTerraformSet()
+	app.Secrets = app.SecretList().managed(managed.SecretList()).toTerraformSet()
+	app.CustomDomains = app.CustomDomainsList().managed(managed.CustomDomainsList()).toTerraformSet()
+	return app
 }
 
-func (repo ApplicationGitRepository) toCreateRequest() qovery.ApplicationGitRepositoryRequest {
-	return qovery.ApplicationGitRepositoryRequest{
-		Url:      ToString(repo.URL),
-		RootPath: ToStringPointer(repo.RootPath),
-		Branch:   ToStringPointer(repo.Branch),
-	}
+type ApplicationGitRepository struct {
+	URL              types.String `tfsdk:"url"`
+	RootPath         types.String `tfsdk:"root_path"`
+	Branch           types.String `tfsdk:"branch"`
+	GitTokenId       types.String `tfsdk:"git_token_id"`
+	CommitId         types.String `tfsdk:"commit_id"`
+	DeployedCommitId types.String `tfsdk:"deployed_commit_id"`
 }
 
-func (repo ApplicationGitRepository) toUpdateRequest() *qovery.ApplicationGitRepositoryRequest {
-	req := repo.toCreateRequest()
-	return &req
+func (repo ApplicationGitRepository) toUpsertRequest() application.GitRepositoryUpsertRequest {
+	return application.GitRepositoryUpsertRequest{
+		URL:        ToString(repo.URL),
+		RootPath:   ToStringPointer(repo.RootPath),
+		Branch:     ToStringPointer(repo.Branch),
+		GitTokenID: ToStringPointer(repo.GitTokenId),
+		CommitID:   ToStringPointer(repo.CommitId),
+	}
 }
 
-func convertResponseToApplicationGitRepository(gitRepository *qovery.ApplicationGitRepository) *ApplicationGitRepository {
-	if gitRepository == nil {
-		return nil
+func convertDomainGitRepositoryToApplicationGitRepository(state *ApplicationGitRepository, gitRepository git_repository.GitRepository) *ApplicationGitRepository {
+	commitID := types.String{Null: true}
+	if state != nil {
+		commitID = convertDomainDeployedCommitIDToPinnedCommitID(state.CommitId, gitRepository.CommitID)
 	}
 
 	return &ApplicationGitRepository{
-		URL:      FromStringPointer(gitRepository.Url),
-		RootPath: FromStringPointer(gitRepository.RootPath),
-		Branch:   FromStringPointer(gitRepository.Branch),
+		URL:              FromString(gitRepository.Url),
+		RootPath:         FromStringPointer(gitRepository.RootPath),
+		Branch:           FromStringPointer(gitRepository.Branch),
+		GitTokenId:       FromStringPointer(gitRepository.GitTokenID),
+		CommitId:         commitID,
+		DeployedCommitId: FromStringPointer(gitRepository.CommitID),
 	}
 }
 
@@ -238,43 +245,25 @@ type ApplicationStorage struct {
 	MountPoint types.String `tfsdk:"mount_point"`
 }
 
-func (store ApplicationStorage) toCreateRequest() (*qovery.ServiceStorageRequestStorageInner, error) {
-	storageType, err := qovery.NewStorageTypeEnumFromValue(ToString(store.Type))
-	if err != nil {
-		return nil, err
-	}
-
-	return &qovery.ServiceStorageRequestStorageInner{
-		Type:       *storageType,
+func (store ApplicationStorage) toUpsertRequest(id types.String) storage.UpsertRequest {
+	return storage.UpsertRequest{
+		ID:         ToStringPointer(id),
+		Type:       ToString(store.Type),
 		Size:       ToInt32(store.Size),
 		MountPoint: ToString(store.MountPoint),
-	}, nil
-}
-
-func (store ApplicationStorage) toUpdateRequest(id string) (*qovery.ServiceStorageRequestStorageInner, error) {
-	storageType, err := qovery.NewStorageTypeEnumFromValue(ToString(store.Type))
-	if err != nil {
-		return nil, err
 	}
-
-	return &qovery.ServiceStorageRequestStorageInner{
-		Id:         &id,
-		Type:       *storageType,
-		Size:       ToInt32(store.Size),
-		MountPoint: ToString(store.MountPoint),
-	}, nil
 }
 
-func convertResponseToApplicationStorage(storage []qovery.ServiceStorageStorageInner) []ApplicationStorage {
-	if len(storage) == 0 {
+func convertDomainStoragesToApplicationStorage(storages storage.Storages) []ApplicationStorage {
+	if len(storages) == 0 {
 		return nil
 	}
 
-	list := make([]ApplicationStorage, 0, len(storage))
-	for _, s := range storage {
+	list := make([]ApplicationStorage, 0, len(storages))
+	for _, s := range storages {
 		list = append(list, ApplicationStorage{
-			Id:         FromString(s.Id),
-			Type:       fromClientEnum(s.Type),
+			Id:         FromString(s.ID.String()),
+			Type:       FromString(s.Type.String()),
 			Size:       FromInt32(s.Size),
 			MountPoint: FromString(s.MountPoint),
 		})
@@ -291,50 +280,37 @@ type ApplicationPort struct {
 	Protocol           types.String `tfsdk:"protocol"`
 }
 
-func (port ApplicationPort) toCreateRequest() (*qovery.ServicePortRequestPortsInner, error) {
-	protocol, err := qovery.NewPortProtocolEnumFromValue(ToString(port.Protocol))
-	if err != nil {
-		return nil, err
+func (p ApplicationPort) toUpsertRequest(id types.String) port.UpsertRequest {
+	return port.UpsertRequest{
+		ID:                 ToStringPointer(id),
+		Name:               ToStringPointer(p.Name),
+		InternalPort:       ToInt32(p.InternalPort),
+		ExternalPort:       ToInt32Pointer(p.ExternalPort),
+		Protocol:           ToStringPointer(p.Protocol),
+		PubliclyAccessible: ToBool(p.PubliclyAccessible),
 	}
-
-	return &qovery.ServicePortRequestPortsInner{
-		Name:               ToStringPointer(port.Name),
-		InternalPort:       ToInt32(port.InternalPort),
-		ExternalPort:       ToInt32Pointer(port.ExternalPort),
-		Protocol:           protocol,
-		PubliclyAccessible: ToBool(port.PubliclyAccessible),
-	}, nil
 }
 
-func (port ApplicationPort) toUpdateRequest() (*qovery.ServicePort, error) {
-	protocol, err := qovery.NewPortProtocolEnumFromValue(ToString(port.Protocol))
-	if err != nil {
-		return nil, err
-	}
-
-	return &qovery.ServicePort{
-		Id:                 ToString(port.Id),
-		Name:               ToStringPointer(port.Name),
-		InternalPort:       ToInt32(port.InternalPort),
-		ExternalPort:       ToInt32Pointer(port.ExternalPort),
-		Protocol:           *protocol,
-		PubliclyAccessible: ToBool(port.PubliclyAccessible),
-	}, nil
-}
-
-func convertResponseToApplicationPorts(ports []qovery.ServicePort) []ApplicationPort {
+func convertDomainPortsToApplicationPorts(ports port.Ports) []ApplicationPort {
 	if len(ports) == 0 {
 		return nil
 	}
 
 	list := make([]ApplicationPort, 0, len(ports))
 	for _, p := range ports {
+		var protocol types.String
+		if p.Protocol != nil {
+			protocol = FromString(p.Protocol.String())
+		} else {
+			protocol = FromStringPointer(nil)
+		}
+
 		list = append(list, ApplicationPort{
-			Id:                 FromString(p.Id),
+			Id:                 FromString(p.ID.String()),
 			Name:               FromStringPointer(p.Name),
 			InternalPort:       FromInt32(p.InternalPort),
 			ExternalPort:       FromInt32Pointer(p.ExternalPort),
-			Protocol:           fromClientEnum(p.Protocol),
+			Protocol:           protocol,
 			PubliclyAccessible: FromBool(p.PubliclyAccessible),
 		})
 	}
@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy terraform framework interfaces.
 var _ resource.ResourceWithConfigure = &awsCredentialsResource{}
 var _ resource.ResourceWithImportState = awsCredentialsResource{}
+var _ resource.ResourceWithValidateConfig = awsCredentialsResource{}
 
 type awsCredentialsResource struct {
 	awsCredentialsService credentials.AwsService
@@ -51,7 +52,8 @@ func (r *awsCredentialsResource) Configure(_ context.Context, req resource.Confi
 
 func (r awsCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
 	return tfsdk.Schema{
-		Description: "Provides a Qovery AWS credentials resource. This can be used to create and manage Qovery AWS credentials.",
+		Description: "Provides a Qovery AWS credentials resource. This can be used to create and manage Qovery AWS credentials.\n" +
+			"The credentials either use static keys (`access_key_id` and `secret_access_key`) or a role to assume (`role_arn` and `external_id`), exactly one of them must be set.",
 		Attributes: map[string]tfsdk.Attribute{
 			"id": {
 				Description: "Id of the AWS credentials.",
@@ -62,6 +64,9 @@ func (r awsCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema, diag
 				Description: "Id of the organization.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the aws credentials.",
@@ -69,21 +74,52 @@ func (r awsCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema, diag
 				Required:    true,
 			},
 			"access_key_id": {
-				Description: "Your AWS access key id.",
+				Description: "Your AWS access key id. Required with `secret_access_key` if `role_arn` is not set.",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
 			},
 			"secret_access_key": {
-				Description: "Your AWS secret access key.",
+				Description: "Your AWS secret access key. Required with `access_key_id` if `role_arn` is not set.",
 				Type:        types.StringType,
-				Required:    true,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"role_arn": {
+				Description: "Arn of the AWS IAM role assumed by Qovery. Required with `external_id` if `access_key_id` is not set.",
+				Type:        types.StringType,
+				Optional:    true,
+			},
+			"external_id": {
+				Description: "External id required by the trust policy of the AWS IAM role assumed by Qovery. Required with `role_arn` if `access_key_id` is not set.",
+				Type:        types.StringType,
+				Optional:    true,
 				Sensitive:   true,
 			},
 		},
 	}, nil
 }
 
+// ValidateConfig checks that exactly one of the static keys or role modes is set on the qovery aws credentials resource
+func (r awsCredentialsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AWSCredentials
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The values may not be known yet if they come from other resources.
+	for _, v := range []types.String{config.AccessKeyId, config.SecretAccessKey, config.RoleArn, config.ExternalId} {
+		if v.Unknown {
+			return
+		}
+	}
+
+	if err := config.toUpsertAwsRequest().ValidateMode(); err != nil {
+		resp.Diagnostics.AddError("Invalid AWS credentials configuration", err.Error())
+	}
+}
+
 // Create qovery aws credentials resource
 func (r awsCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -96,7 +132,7 @@ func (r awsCredentialsResource) Create(ctx context.Context, req resource.CreateR
 	// Create new credentials
 	creds, err := r.awsCredentialsService.Create(ctx, plan.OrganizationId.Value, plan.toUpsertAwsRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on aws credentials create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on aws credentials create", err)
 		return
 	}
 
@@ -120,7 +156,7 @@ func (r awsCredentialsResource) Read(ctx context.Context, req resource.ReadReque
 	// Get credentials from API
 	creds, err := r.awsCredentialsService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on aws credentials read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on aws credentials read", err)
 		return
 	}
 
@@ -144,7 +180,7 @@ func (r awsCredentialsResource) Update(ctx context.Context, req resource.UpdateR
 	// Update credentials in the backend
 	creds, err := r.awsCredentialsService.Update(ctx, state.OrganizationId.Value, state.Id.Value, plan.toUpsertAwsRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on aws credentials update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on aws credentials update", err)
 		return
 	}
 
@@ -168,7 +204,7 @@ func (r awsCredentialsResource) Delete(ctx context.Context, req resource.DeleteR
 	// Delete credentials in the backend
 	err := r.awsCredentialsService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on aws credentials delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on aws credentials delete", err)
 		return
 	}
 
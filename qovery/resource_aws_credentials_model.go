@@ -12,6 +12,8 @@ type AWSCredentials struct {
 	Name            types.String `tfsdk:"name"`
 	AccessKeyId     types.String `tfsdk:"access_key_id"`
 	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+	RoleArn         types.String `tfsdk:"role_arn"`
+	ExternalId      types.String `tfsdk:"external_id"`
 }
 
 type AWSCredentialsDataSource struct {
@@ -25,6 +27,8 @@ func (creds AWSCredentials) toUpsertAwsRequest() credentials.UpsertAwsRequest {
 		Name:            ToString(creds.Name),
 		AccessKeyID:     ToString(creds.AccessKeyId),
 		SecretAccessKey: ToString(creds.SecretAccessKey),
+		RoleArn:         ToString(creds.RoleArn),
+		ExternalID:      ToString(creds.ExternalId),
 	}
 }
 
@@ -35,6 +39,8 @@ func convertDomainCredentialsToAWSCredentials(creds *credentials.Credentials, pl
 		Name:            FromString(creds.Name),
 		AccessKeyId:     plan.AccessKeyId,
 		SecretAccessKey: plan.SecretAccessKey,
+		RoleArn:         plan.RoleArn,
+		ExternalId:      plan.ExternalId,
 	}
 }
 
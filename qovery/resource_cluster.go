@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
@@ -38,7 +36,7 @@ var (
 	clusterDescriptionDefault = ""
 
 	// Cloud Provider
-	cloudProviders = clientEnumToStringArray(qovery.AllowedCloudProviderEnumEnumValues)
+	cloudProviders = clientEnumToStringArray(cluster.AllowedCloudProviderValues)
 
 	// Cluster Min Running Nodes
 	clusterMinRunningNodesMin     int64 = 1
@@ -60,75 +58,10 @@ var (
 		qovery.KUBERNETESENUM_K3_S,
 	})
 	clusterKubernetesModeDefault = string(qovery.KUBERNETESENUM_MANAGED)
-
-	// Cluster advanced settings
-	advancedSettingsDefault = map[string]advSettingAttr{
-		"aws.cloudwatch.eks_logs_retention_days": {"Maximum retention days in Cloudwatch for EKS logs", types.Int64Type, tfsdk.AttributePlanModifiers{
-			modifiers.NewInt64DefaultModifier(90),
-		}, types.Int64{Value: 90}},
-		"aws.iam.admin_group": {"Allows you to specify the IAM group name associated to the Qovery user", types.StringType, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringDefaultModifier("Admins"),
-		}, types.String{Value: "Admins"}},
-		"aws.vpc.enable_s3_flow_logs": {"Enable flow logs on the cluster VPC and store them in an s3 bucket", types.BoolType, tfsdk.AttributePlanModifiers{
-			modifiers.NewBoolDefaultModifier(false),
-		}, types.Bool{Value: false}},
-		"aws.vpc.flow_logs_retention_days": {"Set the number of retention days for flow logs. Unlimited retention with value", types.Int64Type, tfsdk.AttributePlanModifiers{
-			modifiers.NewInt64DefaultModifier(365),
-		}, types.Int64{Value: 365}},
-		"cloud_provider.container_registry.tags": {"Add additional tags on the cluster dedicated registry", types.MapType{ElemType: types.StringType}, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringMapDefaultModifier(map[string]string{}),
-		}, types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{}}},
-		"database.mongodb.allowed_cidrs": {"List of allowed CIDRS", types.SetType{ElemType: types.StringType}, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"}),
-		}, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}}},
-		"database.mongodb.deny_public_access": {"Deny public access to all MongoDB databases", types.BoolType, tfsdk.AttributePlanModifiers{
-			modifiers.NewBoolDefaultModifier(false),
-		}, types.Bool{Value: false}},
-		"database.mysql.allowed_cidrs": {"List of allowed CIDRS", types.SetType{ElemType: types.StringType}, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"}),
-		}, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}}},
-		"database.mysql.deny_public_access": {"Deny public access to all MySQL databases", types.BoolType, tfsdk.AttributePlanModifiers{
-			modifiers.NewBoolDefaultModifier(false),
-		}, types.Bool{Value: false}},
-		"database.postgresql.allowed_cidrs": {"List of allowed CIDRS", types.SetType{ElemType: types.StringType}, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"}),
-		}, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}}},
-		"database.postgresql.deny_public_access": {"Deny public access to all PostgreSQL databases", types.BoolType, tfsdk.AttributePlanModifiers{
-			modifiers.NewBoolDefaultModifier(false),
-		}, types.Bool{Value: false}},
-		"database.redis.allowed_cidrs": {"List of allowed CIDRS", types.SetType{ElemType: types.StringType}, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringSetDefaultModifier([]string{"0.0.0.0/0"}),
-		}, types.Set{ElemType: types.StringType, Elems: []attr.Value{types.String{Value: "0.0.0.0/0"}}}},
-		"database.redis.deny_public_access": {"Deny public access to all Redis databases", types.BoolType, tfsdk.AttributePlanModifiers{
-			modifiers.NewBoolDefaultModifier(false),
-		}, types.Bool{Value: false}},
-		"load_balancer.size": {"Allows you to specify the load balancer size in front of your cluster", types.StringType, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringDefaultModifier("lb-s"),
-		}, types.String{Value: "lb-s"}},
-		"loki.log_retention_in_week": {"Maximum Kubernetes pods (containers/application/jobs/cronjob) retention logs in weeks", types.Int64Type, tfsdk.AttributePlanModifiers{
-			modifiers.NewInt64DefaultModifier(12),
-		}, types.Int64{Value: 12}},
-		"registry.image_retention_time": {"Allows you to specify an amount in seconds after which images in the default registry are deleted", types.Int64Type, tfsdk.AttributePlanModifiers{
-			modifiers.NewInt64DefaultModifier(31536000),
-		}, types.Int64{Value: 31536000}},
-		"pleco.resources_ttl": {"Deprecated", types.Int64Type, tfsdk.AttributePlanModifiers{
-			modifiers.NewInt64DefaultModifier(-1),
-		}, types.Int64{Value: -1}},
-		"aws.eks.ec2.metadata_imds": {"Specify the IMDS version you want to use. Possible values are Required (IMDS v2 only) and Optional (IMDS v1 and V2)", types.StringType, tfsdk.AttributePlanModifiers{
-			modifiers.NewStringDefaultModifier("Optional"),
-		}, types.String{Value: "Optional"}},
-	}
 )
 
-type advSettingAttr struct {
-	description   string
-	_type         attr.Type
-	planModifiers tfsdk.AttributePlanModifiers
-	defaultValue  attr.Value
-}
-
 type clusterResource struct {
-	client *client.Client
+	clusterService cluster.Service
 }
 
 func newClusterResource() resource.Resource {
@@ -154,20 +87,10 @@ func (r *clusterResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = provider.client
+	r.clusterService = provider.clusterService
 }
 
 func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
-	advSettings := map[string]tfsdk.Attribute{}
-	for k, v := range advancedSettingsDefault {
-		advSettings[k] = tfsdk.Attribute{
-			Description:   v.description,
-			Optional:      true,
-			Computed:      true,
-			Type:          v._type,
-			PlanModifiers: v.planModifiers,
-		}
-	}
 	return tfsdk.Schema{
 		Description: "Provides a Qovery cluster resource. This can be used to create and manage Qovery cluster.",
 		Attributes: map[string]tfsdk.Attribute{
@@ -185,6 +108,9 @@ func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				Description: "Id of the organization.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the cluster.",
@@ -199,6 +125,9 @@ func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				),
 				Type:     types.StringType,
 				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.NewStringEnumValidator(cloudProviders),
 				},
@@ -207,6 +136,9 @@ func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				Description: "Region of the cluster.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"description": {
 				Description: descriptions.NewStringDefaultDescription(
@@ -231,6 +163,7 @@ func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				Computed: true,
 				PlanModifiers: tfsdk.AttributePlanModifiers{
 					modifiers.NewStringDefaultModifier(clusterKubernetesModeDefault),
+					resource.RequiresReplace(),
 				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.NewStringEnumValidator(clusterKubernetesModes),
@@ -342,12 +275,8 @@ func (r clusterResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 					validators.NewStringEnumValidator(clusterStates),
 				},
 			},
-			"advanced_settings": {
-				Description: "Advanced settings of the cluster.",
-				Optional:    true,
-				Computed:    true,
-				Attributes:  tfsdk.SingleNestedAttributes(advSettings),
-			},
+			"advanced_settings": advancedSettingsResourceAttribute("cluster", clusterAdvancedSettings),
+			"timeouts":          timeoutsResourceAttribute(defaultClusterTimeout),
 		},
 	}, nil
 }
@@ -361,20 +290,23 @@ func (r clusterResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultClusterTimeout)
+	defer cancel()
+
 	// Create new cluster
-	request, err := plan.toUpsertClusterRequest(nil)
+	request, err := plan.toUpsertServiceRequest()
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster create", err)
 		return
 	}
-	cluster, apiErr := r.client.CreateCluster(ctx, plan.OrganizationId.Value, request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	cl, err := r.clusterService.Create(ctx, ToString(plan.OrganizationId), *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertResponseToCluster(cluster)
+	state := convertDomainClusterToCluster(plan, cl)
 	tflog.Trace(ctx, "created cluster", map[string]interface{}{"cluster_id": state.Id.Value})
 
 	// Set state
@@ -391,13 +323,14 @@ func (r clusterResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get cluster from the API
-	cluster, apiErr := r.client.GetCluster(ctx, state.OrganizationId.Value, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	cl, err := r.clusterService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster read", err)
 		return
 	}
 
-	state = convertResponseToCluster(cluster)
+	// Refresh state values
+	state = convertDomainClusterToCluster(state, cl)
 	tflog.Trace(ctx, "read cluster", map[string]interface{}{"cluster_id": state.Id.Value})
 
 	// Set state
@@ -414,19 +347,23 @@ func (r clusterResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultClusterTimeout)
+	defer cancel()
+
 	// Update cluster in the backend
-	request, err := plan.toUpsertClusterRequest(&state)
+	request, err := plan.toUpsertServiceRequest()
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster update", err)
 		return
 	}
-	cluster, apiErr := r.client.UpdateCluster(ctx, state.OrganizationId.Value, state.Id.Value, request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	cl, err := r.clusterService.Update(ctx, state.OrganizationId.Value, state.Id.Value, *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster update", err)
 		return
 	}
+
 	// Update state values
-	state = convertResponseToCluster(cluster)
+	state = convertDomainClusterToCluster(plan, cl)
 	tflog.Trace(ctx, "updated cluster", map[string]interface{}{"cluster_id": state.Id.Value})
 
 	// Set state
@@ -442,10 +379,13 @@ func (r clusterResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultClusterTimeout)
+	defer cancel()
+
 	// Delete cluster
-	apiErr := r.client.DeleteCluster(ctx, state.OrganizationId.Value, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	err := r.clusterService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on cluster delete", err)
 		return
 	}
 
@@ -1,19 +1,18 @@
 package qovery
 
 import (
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
-	"reflect"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/advancedsettings"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/status"
 )
 
 const (
 	featureKeyVpcSubnet = "vpc_subnet"
-	featureIdVpcSubnet  = "VPC_SUBNET"
 	featureKeyStaticIP  = "static_ip"
-	featureIdStaticIP   = "STATIC_IP"
 )
 
 type Cluster struct {
@@ -32,187 +31,70 @@ type Cluster struct {
 	RoutingTables    types.Set    `tfsdk:"routing_table"`
 	State            types.String `tfsdk:"state"`
 	AdvancedSettings types.Object `tfsdk:"advanced_settings"`
+	Timeouts         *Timeouts    `tfsdk:"timeouts"`
 }
 
-func (c Cluster) hasFeaturesDiff(state *Cluster) bool {
-	clusterFeatures := toQoveryClusterFeatures(c.Features, c.KubernetesMode.String())
-	if state == nil {
-		return len(clusterFeatures) > 0
-	}
-
-	stateFeature := toQoveryClusterFeatures(state.Features, c.KubernetesMode.String())
-	if len(clusterFeatures) != len(stateFeature) {
-		return true
-	}
-
-	stateFeaturesByID := make(map[string]interface{})
-	for _, sf := range stateFeature {
-		value := sf.GetValue()
-		stateFeaturesByID[sf.GetId()] = value.GetActualInstance()
-	}
-
-	for _, cf := range clusterFeatures {
-		value := cf.GetValue()
-		if stateValue, ok := stateFeaturesByID[cf.GetId()]; !ok || stateValue != value.GetActualInstance() {
-			return true
-		}
-	}
-	return false
-}
-
-func (c Cluster) hasRoutingTableDiff(state *Cluster) bool {
-	clusterRoutes := toClusterRouteList(c.RoutingTables).toUpsertRequest().Routes
-	if state == nil {
-		return len(clusterRoutes) > 0
-	}
-
-	stateRoutes := toClusterRouteList(state.RoutingTables).toUpsertRequest().Routes
-	if len(clusterRoutes) != len(stateRoutes) {
-		return true
-	}
-
-	stateRoutesByDestination := make(map[string]ClusterRoute)
-	for _, sr := range stateRoutes {
-		stateRoutesByDestination[sr.Destination] = fromClusterRoute(sr)
-	}
-
-	for _, cr := range clusterRoutes {
-		stateRoute, ok := stateRoutesByDestination[cr.Destination]
-		if !ok {
-			return true
-		}
-
-		clusterRoute := fromClusterRoute(cr)
-		if stateRoute.Description != clusterRoute.Description || stateRoute.Destination != clusterRoute.Destination || stateRoute.Target != clusterRoute.Target {
-			return true
-		}
-	}
-	return false
-}
-
-func (c Cluster) hasAdvSettingsDiff(state *Cluster) (bool, error) {
-	clusterSettings, clusterErr := ToMapStringString(c.AdvancedSettings)
-	if clusterErr != nil {
-		return false, clusterErr
-	}
-	if state == nil {
-		return len(clusterSettings) > 0, nil
-	}
-
-	stateSettings, stateErr := ToMapStringString(state.AdvancedSettings)
-	if stateErr != nil {
-		return false, stateErr
-	}
-	if len(clusterSettings) != len(stateSettings) {
-		return true, nil
-	}
-
-	return reflect.DeepEqual(clusterSettings, stateSettings), nil
-}
-
-func (c Cluster) toUpsertClusterRequest(state *Cluster) (*client.ClusterUpsertParams, error) {
-	cloudProvider, err := qovery.NewCloudProviderEnumFromValue(ToString(c.CloudProvider))
+func (c Cluster) toUpsertServiceRequest() (*cluster.UpsertServiceRequest, error) {
+	advancedSettings, err := toAdvancedSettings(c.AdvancedSettings)
 	if err != nil {
 		return nil, err
 	}
 
-	kubernetesMode, err := qovery.NewKubernetesEnumFromValue(ToString(c.KubernetesMode))
-	if err != nil {
-		return nil, err
-	}
-
-	routingTable := toClusterRouteList(c.RoutingTables)
-
-	var clusterCloudProviderRequest *qovery.ClusterCloudProviderInfoRequest
-	if state == nil || c.CredentialsId != state.CredentialsId {
-		clusterCloudProviderRequest = &qovery.ClusterCloudProviderInfoRequest{
-			CloudProvider: cloudProvider,
-			Region:        ToStringPointer(c.Region),
-			Credentials: &qovery.ClusterCloudProviderInfoCredentials{
-				Id:   ToStringPointer(c.CredentialsId),
-				Name: ToStringPointer(c.Name),
-			},
-		}
-	}
-
-	// NOTE: force update clusters if features or routing table have changed
-	advSettingsDiff, diffErr := c.hasAdvSettingsDiff(state)
-	if diffErr != nil {
-		return nil, diffErr
-	}
-	forceUpdate := c.hasFeaturesDiff(state) || c.hasRoutingTableDiff(state) || advSettingsDiff
-
-	desiredState, err := qovery.NewStateEnumFromValue(ToString(c.State))
-	if err != nil {
-		return nil, err
-	}
-
-	advSettings, parseErr := ToMapStringString(c.AdvancedSettings)
-	if parseErr != nil {
-		return nil, parseErr
-	}
-
-	return &client.ClusterUpsertParams{
-		ClusterCloudProviderRequest: clusterCloudProviderRequest,
-		ClusterRequest: qovery.ClusterRequest{
+	return &cluster.UpsertServiceRequest{
+		ClusterUpsertRequest: cluster.UpsertRepositoryRequest{
 			Name:            ToString(c.Name),
-			CloudProvider:   *cloudProvider,
+			CloudProvider:   ToString(c.CloudProvider),
 			Region:          ToString(c.Region),
 			Description:     ToStringPointer(c.Description),
-			Kubernetes:      kubernetesMode,
+			KubernetesMode:  ToString(c.KubernetesMode),
 			InstanceType:    ToStringPointer(c.InstanceType),
 			MinRunningNodes: ToInt32Pointer(c.MinRunningNodes),
 			MaxRunningNodes: ToInt32Pointer(c.MaxRunningNodes),
-			Features:        toQoveryClusterFeatures(c.Features, c.KubernetesMode.String()),
+			Features:        toClusterFeatures(c.Features),
 		},
-		ClusterRoutingTable:     routingTable.toUpsertRequest(),
-		ClusterAdvancedSettings: advSettings,
-		ForceUpdate:             forceUpdate,
-		DesiredState:            *desiredState,
+		CredentialsID:    ToString(c.CredentialsId),
+		RoutingTable:     toClusterRouteList(c.RoutingTables).toDomainRoutingTable(),
+		AdvancedSettings: cluster.AdvancedSettings(advancedSettings),
+		DesiredState:     status.State(ToString(c.State)),
 	}, nil
 }
 
-func convertResponseToCluster(res *client.ClusterResponse) Cluster {
-	routingTable := fromClusterRoutingTable(res.ClusterRoutingTable)
+func convertDomainClusterToCluster(state Cluster, c *cluster.Cluster) Cluster {
+	credentialsID := FromStringPointer(nil)
+	if c.CredentialsID != uuid.Nil {
+		credentialsID = FromString(c.CredentialsID.String())
+	}
 
 	return Cluster{
-		Id:               FromString(res.ClusterResponse.Id),
-		CredentialsId:    FromStringPointer(res.ClusterInfo.Credentials.Id),
-		OrganizationId:   FromString(res.OrganizationID),
-		Name:             FromString(res.ClusterResponse.Name),
-		CloudProvider:    fromClientEnum(res.ClusterResponse.CloudProvider),
-		Region:           FromString(res.ClusterResponse.Region),
-		Description:      FromStringPointer(res.ClusterResponse.Description),
-		KubernetesMode:   fromClientEnumPointer(res.ClusterResponse.Kubernetes),
-		InstanceType:     FromStringPointer(res.ClusterResponse.InstanceType),
-		MinRunningNodes:  FromInt32Pointer(res.ClusterResponse.MinRunningNodes),
-		MaxRunningNodes:  FromInt32Pointer(res.ClusterResponse.MaxRunningNodes),
-		Features:         fromQoveryClusterFeatures(res.ClusterResponse.Features),
-		RoutingTables:    routingTable.toTerraformSet(),
-		State:            fromClientEnumPointer(res.ClusterResponse.Status),
-		AdvancedSettings: FromStringMap(res.ClusterAdvancedSetting),
+		Id:               FromString(c.ID.String()),
+		CredentialsId:    credentialsID,
+		OrganizationId:   FromString(c.OrganizationID.String()),
+		Name:             FromString(c.Name),
+		CloudProvider:    FromString(c.CloudProvider.String()),
+		Region:           FromString(c.Region),
+		Description:      FromString(c.Description),
+		KubernetesMode:   FromString(c.KubernetesMode.String()),
+		InstanceType:     FromString(c.InstanceType),
+		MinRunningNodes:  FromInt32(c.MinRunningNodes),
+		MaxRunningNodes:  FromInt32(c.MaxRunningNodes),
+		Features:         fromClusterFeatures(c.Features),
+		RoutingTables:    fromClusterRoutingTable(c.RoutingTable).toTerraformSet(),
+		State:            FromString(c.State.String()),
+		AdvancedSettings: fromAdvancedSettings(advancedsettings.AdvancedSettings(c.AdvancedSettings), clusterAdvancedSettings),
+		Timeouts:         state.Timeouts,
 	}
 }
 
-func fromQoveryClusterFeatures(ff []qovery.ClusterFeature) types.Object {
-	if ff == nil {
-		return types.Object{Null: true}
-	}
-
+func fromClusterFeatures(ff cluster.Features) types.Object {
 	attrs := make(map[string]attr.Value)
 	attrTypes := make(map[string]attr.Type)
-	for _, f := range ff {
-		if f.Id == nil {
-			continue
-		}
-		switch *f.Id {
-		case featureIdVpcSubnet:
-			attrs[featureKeyVpcSubnet] = FromStringPointer(f.GetValue().String)
-			attrTypes[featureKeyVpcSubnet] = types.StringType
-		case featureIdStaticIP:
-			attrs[featureKeyStaticIP] = FromBoolPointer(f.GetValue().Bool)
-			attrTypes[featureKeyStaticIP] = types.BoolType
-		}
+	if vpcSubnet := ff.VpcSubnet(); vpcSubnet != nil {
+		attrs[featureKeyVpcSubnet] = FromStringPointer(vpcSubnet)
+		attrTypes[featureKeyVpcSubnet] = types.StringType
+	}
+	if staticIP := ff.StaticIP(); staticIP != nil {
+		attrs[featureKeyStaticIP] = FromBoolPointer(staticIP)
+		attrTypes[featureKeyStaticIP] = types.BoolType
 	}
 
 	// The object should be fill even if no feature is present, but will be mark as Null
@@ -235,32 +117,18 @@ func fromQoveryClusterFeatures(ff []qovery.ClusterFeature) types.Object {
 	}
 }
 
-func toQoveryClusterFeatures(f types.Object, mode string) []qovery.ClusterRequestFeaturesInner {
-	if f.Null || f.Unknown || mode == "K3S" {
+func toClusterFeatures(f types.Object) cluster.Features {
+	if f.Null || f.Unknown {
 		return nil
 	}
 
-	features := make([]qovery.ClusterRequestFeaturesInner, 0, len(f.Attrs))
-	if _, ok := f.Attrs[featureKeyVpcSubnet]; ok {
-		value := qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{
-			String: ToStringPointer(f.Attrs[featureKeyVpcSubnet].(types.String)),
-		})
-
-		features = append(features, qovery.ClusterRequestFeaturesInner{
-			Id:    StringAsPointer(featureIdVpcSubnet),
-			Value: *value,
-		})
+	features := make(cluster.Features, 0, len(f.Attrs))
+	if vpcSubnet, ok := f.Attrs[featureKeyVpcSubnet]; ok {
+		features = append(features, cluster.NewVpcSubnetFeature(ToString(vpcSubnet.(types.String))))
 	}
 
-	if _, ok := f.Attrs[featureKeyStaticIP]; ok {
-		value := qovery.NewNullableClusterFeatureValue(&qovery.ClusterFeatureValue{
-			Bool: ToBoolPointer(f.Attrs[featureKeyStaticIP].(types.Bool)),
-		})
-
-		features = append(features, qovery.ClusterRequestFeaturesInner{
-			Id:    StringAsPointer(featureIdStaticIP),
-			Value: *value,
-		})
+	if staticIP, ok := f.Attrs[featureKeyStaticIP]; ok {
+		features = append(features, cluster.NewStaticIPFeature(staticIP.(types.Bool).Value))
 	}
 
 	return features
@@ -11,8 +11,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 )
 
 // FIXME: disabled until ttl advanced setting has been implemented for cleaning
@@ -273,9 +274,9 @@ func testAccQoveryClusterExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("cluster.id not found")
 		}
 
-		_, apiErr := apiClient.GetCluster(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
-		if apiErr != nil {
-			return apiErr
+		_, err := qoveryServices.Cluster.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err != nil {
+			return err
 		}
 		return nil
 	}
@@ -292,12 +293,12 @@ func testAccQoveryClusterDestroy(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("cluster.id not found")
 		}
 
-		_, apiErr := apiClient.GetCluster(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
-		if apiErr == nil {
+		_, err := qoveryServices.Cluster.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err == nil {
 			return fmt.Errorf("found cluster but expected it to be deleted")
 		}
-		if !apierrors.IsNotFound(apiErr) {
-			return fmt.Errorf("unexpected error checking for deleted cluster: %s", apiErr.Summary())
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted cluster: %s", err.Error())
 		}
 		return nil
 	}
@@ -67,6 +67,9 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"registry_id": {
 				Description: "Id of the registry.",
@@ -233,7 +236,7 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 					},
 					"external_port": {
 						Description: descriptions.NewInt64MinMaxDescription(
-							"External port of the container.\n\t- Required if: `ports.publicly_accessible=true`.",
+							"External port of the container.\n\t- Required if: `ports.publicly_accessible=true` and `ports.protocol` is `TCP` or `UDP`.",
 							port.MinPort,
 							port.MaxPort,
 							nil,
@@ -258,6 +261,9 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Type:     types.StringType,
 						Optional: true,
 						Computed: true,
+						Validators: []tfsdk.AttributeValidator{
+							validators.NewStringEnumValidator(clientEnumToStringArray(port.AllowedProtocolValues)),
+						},
 						PlanModifiers: tfsdk.AttributePlanModifiers{
 							modifiers.NewStringDefaultModifier(port.DefaultProtocol.String()),
 						},
@@ -283,10 +289,15 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
-				Description: "List of environment variables linked to this container.",
+				Description: "List of environment variables linked to this container. The environment variables of the container not listed here (i.e: managed by a `qovery_environment_variable` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -304,10 +315,67 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this container.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this container.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
-				Description: "List of secrets linked to this container.",
+				Description: "List of secrets linked to this container. The secrets of the container not listed here (i.e: managed by a `qovery_secret` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -326,6 +394,11 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 						Required:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"arguments": {
@@ -381,6 +454,9 @@ func (r containerResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diag
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksResourceAttribute("container"),
+			"advanced_settings": advancedSettingsResourceAttribute("container", containerAdvancedSettings),
+			"timeouts":          timeoutsResourceAttribute(defaultServiceTimeout),
 		},
 	}, nil
 }
@@ -394,20 +470,23 @@ func (r containerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Create new container
 	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container create", err)
 		return
 	}
 	cont, err := r.containerService.Create(ctx, plan.EnvironmentID.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertDomainContainerToContainer(plan, cont)
+	state := convertDomainContainerToContainer(plan, cont).keepManaged(plan)
 	tflog.Trace(ctx, "created container", map[string]interface{}{"container_id": state.ID.Value})
 
 	// Set state
@@ -426,12 +505,12 @@ func (r containerResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// Get container from the API
 	cont, err := r.containerService.Get(ctx, state.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertDomainContainerToContainer(state, cont)
+	state = convertDomainContainerToContainer(state, cont).keepManaged(state)
 	tflog.Trace(ctx, "read container", map[string]interface{}{"container_id": state.ID.Value})
 
 	// Set state
@@ -448,20 +527,23 @@ func (r containerResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Update container in the backend
 	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container create", err)
 		return
 	}
 	cont, err := r.containerService.Update(ctx, state.ID.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container update", err)
 		return
 	}
 
 	// Update state values
-	state = convertDomainContainerToContainer(plan, cont)
+	state = convertDomainContainerToContainer(plan, cont).keepManaged(plan)
 	tflog.Trace(ctx, "updated container", map[string]interface{}{"container_id": state.ID.Value})
 
 	// Set state
@@ -477,10 +559,13 @@ func (r containerResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Delete container
 	err := r.containerService.Delete(ctx, state.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container delete", err)
 		return
 	}
 
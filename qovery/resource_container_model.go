@@ -10,34 +10,47 @@ import (
 )
 
 type Container struct {
-	ID                          types.String `tfsdk:"id"`
-	EnvironmentID               types.String `tfsdk:"environment_id"`
-	RegistryID                  types.String `tfsdk:"registry_id"`
-	Name                        types.String `tfsdk:"name"`
-	ImageName                   types.String `tfsdk:"image_name"`
-	Tag                         types.String `tfsdk:"tag"`
-	Entrypoint                  types.String `tfsdk:"entrypoint"`
-	CPU                         types.Int64  `tfsdk:"cpu"`
-	Memory                      types.Int64  `tfsdk:"memory"`
-	MinRunningInstances         types.Int64  `tfsdk:"min_running_instances"`
-	MaxRunningInstances         types.Int64  `tfsdk:"max_running_instances"`
-	AutoPreview                 types.Bool   `tfsdk:"auto_preview"`
-	BuiltInEnvironmentVariables types.Set    `tfsdk:"built_in_environment_variables"`
-	EnvironmentVariables        types.Set    `tfsdk:"environment_variables"`
-	Secrets                     types.Set    `tfsdk:"secrets"`
-	Storages                    types.Set    `tfsdk:"storage"`
-	Ports                       types.Set    `tfsdk:"ports"`
+	ID                           types.String  `tfsdk:"id"`
+	EnvironmentID                types.String  `tfsdk:"environment_id"`
+	RegistryID                   types.String  `tfsdk:"registry_id"`
+	Name                         types.String  `tfsdk:"name"`
+	ImageName                    types.String  `tfsdk:"image_name"`
+	Tag                          types.String  `tfsdk:"tag"`
+	Entrypoint                   types.String  `tfsdk:"entrypoint"`
+	CPU                          types.Int64   `tfsdk:"cpu"`
+	Memory                       types.Int64   `tfsdk:"memory"`
+	MinRunningInstances          types.Int64   `tfsdk:"min_running_instances"`
+	MaxRunningInstances          types.Int64   `tfsdk:"max_running_instances"`
+	AutoPreview                  types.Bool    `tfsdk:"auto_preview"`
+	BuiltInEnvironmentVariables  types.Set     `tfsdk:"built_in_environment_variables"`
+	EnvironmentVariables         types.Set     `tfsdk:"environment_variables"`
+	EnvironmentVariableAliases   types.Set     `tfsdk:"environment_variable_aliases"`
+	EnvironmentVariableOverrides types.Set     `tfsdk:"environment_variable_overrides"`
+	Secrets                      types.Set     `tfsdk:"secrets"`
+	Storages                     types.Set     `tfsdk:"storage"`
+	Ports                        types.Set     `tfsdk:"ports"`
+	Healthchecks                 *Healthchecks `tfsdk:"healthchecks"`
+	AdvancedSettings             types.Object  `tfsdk:"advanced_settings"`
 	//CustomDomains               types.Set    `tfsdk:"custom_domains"`
 	Arguments         types.List   `tfsdk:"arguments"`
 	ExternalHost      types.String `tfsdk:"external_host"`
 	InternalHost      types.String `tfsdk:"internal_host"`
 	DeploymentStageId types.String `tfsdk:"deployment_stage_id"`
+	Timeouts          *Timeouts    `tfsdk:"timeouts"`
 }
 
 func (cont Container) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(cont.EnvironmentVariables)
 }
 
+func (cont Container) EnvironmentVariableAliasList() EnvironmentVariableList {
+	return toEnvironmentVariableList(cont.EnvironmentVariableAliases)
+}
+
+func (cont Container) EnvironmentVariableOverrideList() EnvironmentVariableList {
+	return toEnvironmentVariableList(cont.EnvironmentVariableOverrides)
+}
+
 func (cont Container) BuiltInEnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(cont.BuiltInEnvironmentVariables)
 }
@@ -46,6 +59,21 @@ func (cont Container) SecretList() SecretList {
 	return toSecretList(cont.Secrets)
 }
 
+// keepManaged returns the container with only the environment variables and secrets managed in the given plan or state,
+// so the ones managed by the `qovery_environment_variable` and `qovery_secret` resources are neither shown as a drift nor deleted.
+// Everything is kept on import, as the managed ones aren't known yet.
+func (cont Container) keepManaged(managed Container) Container {
+	if managed.EnvironmentID.Null {
+		return cont
+	}
+
+	cont.EnvironmentVariables = cont.EnvironmentVariableList().managed(managed.EnvironmentVariableList()).toTerraformSet()
+	cont.EnvironmentVariableAliases = cont.EnvironmentVariableAliasList().managed(managed.EnvironmentVariableAliasList()).toTerraformSet()
+	cont.EnvironmentVariableOverrides = cont.EnvironmentVariableOverrideList().managed(managed.EnvironmentVariableOverrideList()).toTerraformSet()
+	cont.Secrets = cont.SecretList().managed(managed.SecretList()).toTerraformSet()
+	return cont
+}
+
 func (cont Container) StorageList() StorageList {
 	return toStorageList(cont.Storages)
 }
@@ -63,11 +91,18 @@ func (cont Container) ArgumentList() []string {
 //}
 
 func (cont Container) toUpsertServiceRequest(state *Container) (*container.UpsertServiceRequest, error) {
-	var stateEnvironmentVariables EnvironmentVariableList
+	var stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides EnvironmentVariableList
 	if state != nil {
 		stateEnvironmentVariables = state.EnvironmentVariableList()
+		stateEnvironmentVariableAliases = state.EnvironmentVariableAliasList()
+		stateEnvironmentVariableOverrides = state.EnvironmentVariableOverrideList()
 	}
 
+	environmentVariables := newEnvironmentVariablesDiffRequest(
+		cont.EnvironmentVariableList(), cont.EnvironmentVariableAliasList(), cont.EnvironmentVariableOverrideList(),
+		stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides,
+	)
+
 	var stateSecrets SecretList
 	if state != nil {
 		stateSecrets = state.SecretList()
@@ -78,10 +113,18 @@ func (cont Container) toUpsertServiceRequest(state *Container) (*container.Upser
 	//	stateCustomDomains = state.CustomDomainsList()
 	//}
 
+	advancedSettings, err := toAdvancedSettings(cont.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+	repositoryRequest := cont.toUpsertRepositoryRequest()
+	repositoryRequest.AdvancedSettings = advancedSettings
+
 	return &container.UpsertServiceRequest{
-		ContainerUpsertRequest: cont.toUpsertRepositoryRequest(),
-		EnvironmentVariables:   cont.EnvironmentVariableList().diffRequest(stateEnvironmentVariables),
+		ContainerUpsertRequest: repositoryRequest,
+		EnvironmentVariables:   environmentVariables,
 		Secrets:                cont.SecretList().diffRequest(stateSecrets),
+		MountPaths:             append(cont.EnvironmentVariableList().mountPaths(), cont.SecretList().mountPaths()...),
 		//CustomDomains:          cont.CustomDomainsList().diff(stateCustomDomains),
 	}, nil
 }
@@ -113,31 +156,37 @@ func (cont Container) toUpsertRepositoryRequest() container.UpsertRepositoryRequ
 		Arguments:           cont.ArgumentList(),
 		Storages:            storages,
 		Ports:               ports,
+		Healthchecks:        cont.Healthchecks.toUpsertRequest(),
 		DeploymentStageID:   ToString(cont.DeploymentStageId),
 	}
 }
 
 func convertDomainContainerToContainer(state Container, container *container.Container) Container {
 	return Container{
-		ID:                          FromString(container.ID.String()),
-		EnvironmentID:               FromString(container.EnvironmentID.String()),
-		RegistryID:                  FromString(container.RegistryID.String()),
-		Name:                        FromString(container.Name),
-		ImageName:                   FromString(container.ImageName),
-		Tag:                         FromString(container.Tag),
-		CPU:                         FromInt32(container.CPU),
-		Memory:                      FromInt32(container.Memory),
-		MinRunningInstances:         FromInt32(container.MinRunningInstances),
-		MaxRunningInstances:         FromInt32(container.MaxRunningInstances),
-		AutoPreview:                 FromBool(container.AutoPreview),
-		Arguments:                   FromStringArray(container.Arguments),
-		Storages:                    convertDomainStoragesToStorageList(container.Storages).toTerraformSet(),
-		Ports:                       convertDomainPortsToPortList(container.Ports).toTerraformSet(),
-		EnvironmentVariables:        convertDomainVariablesToEnvironmentVariableList(container.EnvironmentVariables, variable.ScopeContainer).toTerraformSet(),
-		BuiltInEnvironmentVariables: convertDomainVariablesToEnvironmentVariableList(container.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
-		InternalHost:                FromStringPointer(container.InternalHost),
-		ExternalHost:                FromStringPointer(container.ExternalHost),
-		Secrets:                     convertDomainSecretsToSecretList(state.SecretList(), container.Secrets, variable.ScopeContainer).toTerraformSet(),
-		DeploymentStageId:           FromString(container.DeploymentStageID),
+		ID:                           FromString(container.ID.String()),
+		EnvironmentID:                FromString(container.EnvironmentID.String()),
+		RegistryID:                   FromString(container.RegistryID.String()),
+		Name:                         FromString(container.Name),
+		ImageName:                    FromString(container.ImageName),
+		Tag:                          FromString(container.Tag),
+		CPU:                          FromInt32(container.CPU),
+		Memory:                       FromInt32(container.Memory),
+		MinRunningInstances:          FromInt32(container.MinRunningInstances),
+		MaxRunningInstances:          FromInt32(container.MaxRunningInstances),
+		AutoPreview:                  FromBool(container.AutoPreview),
+		Arguments:                    FromStringArray(container.Arguments),
+		Storages:                     convertDomainStoragesToStorageList(container.Storages).toTerraformSet(),
+		Ports:                        convertDomainPortsToPortList(container.Ports).toTerraformSet(),
+		Healthchecks:                 convertDomainHealthchecksToHealthchecks(container.Healthchecks),
+		AdvancedSettings:             fromAdvancedSettings(container.AdvancedSettings, containerAdvancedSettings),
+		EnvironmentVariables:         convertDomainVariablesToEnvironmentVariableList(container.EnvironmentVariables, variable.ScopeContainer).toTerraformSet(),
+		EnvironmentVariableAliases:   convertDomainVariablesToEnvironmentVariableAliasList(container.EnvironmentVariables, variable.ScopeContainer).toTerraformSet(),
+		EnvironmentVariableOverrides: convertDomainVariablesToEnvironmentVariableOverrideList(container.EnvironmentVariables, variable.ScopeContainer).toTerraformSet(),
+		BuiltInEnvironmentVariables:  convertDomainVariablesToEnvironmentVariableList(container.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
+		InternalHost:                 FromStringPointer(container.InternalHost),
+		ExternalHost:                 FromStringPointer(container.ExternalHost),
+		Secrets:                      convertDomainSecretsToSecretList(state.SecretList(), container.Secrets, variable.ScopeContainer).toTerraformSet(),
+		DeploymentStageId:            FromString(container.DeploymentStageID),
+		Timeouts:                     state.Timeouts,
 	}
 }
@@ -66,6 +66,9 @@ func (r containerRegistryResource) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Description: "Id of the organization.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the container registry.",
@@ -95,6 +98,11 @@ func (r containerRegistryResource) GetSchema(_ context.Context) (tfsdk.Schema, d
 				Optional:    true,
 				Computed:    true,
 			},
+			"validate_credentials": {
+				Description: "Check that the container registry accepts the credentials of the `config` once it is created or updated. The apply fails if the authentication is rejected.",
+				Type:        types.BoolType,
+				Optional:    true,
+			},
 			"config": {
 				Description: "Configuration needed to authenticate the container registry.",
 				Optional:    true,
@@ -110,7 +118,7 @@ func (r containerRegistryResource) GetSchema(_ context.Context) (tfsdk.Schema, d
 						Optional:    true,
 					},
 					"region": {
-						Description: "Required if kind is `ECR` or `SCALEWAY_CR`.",
+						Description: "Required if kind is `ECR`, `SCALEWAY_CR` or `GCP_ARTIFACT_REGISTRY`.",
 						Type:        types.StringType,
 						Optional:    true,
 					},
@@ -125,12 +133,28 @@ func (r containerRegistryResource) GetSchema(_ context.Context) (tfsdk.Schema, d
 						Optional:    true,
 					},
 					"username": {
-						Description: "Required if kind is `DOCKER_HUB`.",
+						Description: "Required if kind is `DOCR`, `AZURE_CR`, `GITHUB_CR` or `GITLAB_CR`: the DigitalOcean api token for `DOCR`, the client id of the service principal for `AZURE_CR`. Optional if kind is `DOCKER_HUB` or `GENERIC_CR`, but must be set with the `password`.",
 						Type:        types.StringType,
 						Optional:    true,
 					},
 					"password": {
-						Description: "Required if kind is `DOCKER_HUB`.",
+						Description: "Required if kind is `DOCR`, `AZURE_CR`, `GITHUB_CR` or `GITLAB_CR`: the DigitalOcean api token for `DOCR`, the client secret of the service principal for `AZURE_CR`. Optional if kind is `DOCKER_HUB` or `GENERIC_CR`, but must be set with the `username`.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"json_credentials": {
+						Description: "Required if kind is `GCP_ARTIFACT_REGISTRY`. Content of the json key of the GCP service account.",
+						Type:        types.StringType,
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"azure_tenant_id": {
+						Description: "Required if kind is `AZURE_CR`.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+					"azure_subscription_id": {
+						Description: "Required if kind is `AZURE_CR`.",
 						Type:        types.StringType,
 						Optional:    true,
 					},
@@ -152,7 +176,7 @@ func (r containerRegistryResource) Create(ctx context.Context, req resource.Crea
 	// Create new container registry
 	reg, err := r.containerRegistryService.Create(ctx, plan.OrganizationId.Value, plan.toUpsertRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container registry create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container registry create", err)
 		return
 	}
 
@@ -176,7 +200,7 @@ func (r containerRegistryResource) Read(ctx context.Context, req resource.ReadRe
 	// Get container registry from the API
 	reg, err := r.containerRegistryService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container registry read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container registry read", err)
 		return
 	}
 
@@ -201,7 +225,7 @@ func (r containerRegistryResource) Update(ctx context.Context, req resource.Upda
 	// Update container registry in the backend
 	reg, err := r.containerRegistryService.Update(ctx, state.OrganizationId.Value, state.Id.Value, plan.toUpsertRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container registry update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container registry update", err)
 		return
 	}
 
@@ -225,7 +249,7 @@ func (r containerRegistryResource) Delete(ctx context.Context, req resource.Dele
 	// Delete container registry
 	err := r.containerRegistryService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on container registry delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on container registry delete", err)
 		return
 	}
 
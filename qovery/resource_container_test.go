@@ -546,6 +546,61 @@ func TestAcc_ContainerWithEnvironmentVariables(t *testing.T) {
 	})
 }
 
+func TestAcc_ContainerWithEnvironmentVariableAliases(t *testing.T) {
+	t.Parallel()
+	testName := "container-with-environment-variable-aliases"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryContainerDestroy("qovery_container.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContainerDefaultConfigWithEnvironmentVariableAliases(
+					testName,
+					map[string]string{
+						"PROJECT_ID": "QOVERY_PROJECT_ID",
+					},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryProjectExists("qovery_project.test"),
+					testAccQoveryEnvironmentExists("qovery_environment.test"),
+					testAccQoveryContainerRegistryExists("qovery_container_registry.test"),
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckResourceAttr("qovery_container.test", "name", generateTestName(testName)),
+					resource.TestCheckNoResourceAttr("qovery_container.test", "environment_variables.0"),
+					resource.TestCheckTypeSetElemNestedAttrs("qovery_container.test", "environment_variable_aliases.*", map[string]string{
+						"key":   "PROJECT_ID",
+						"value": "QOVERY_PROJECT_ID",
+					}),
+					resource.TestCheckNoResourceAttr("qovery_container.test", "environment_variable_overrides.0"),
+				),
+			},
+			// Update alias
+			{
+				Config: testAccContainerDefaultConfigWithEnvironmentVariableAliases(
+					testName,
+					map[string]string{
+						"PROJECT_ID": "QOVERY_PROJECT_ID",
+						"ENV_ID":     "QOVERY_ENVIRONMENT_ID",
+					},
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckTypeSetElemNestedAttrs("qovery_container.test", "environment_variable_aliases.*", map[string]string{
+						"key":   "PROJECT_ID",
+						"value": "QOVERY_PROJECT_ID",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("qovery_container.test", "environment_variable_aliases.*", map[string]string{
+						"key":   "ENV_ID",
+						"value": "QOVERY_ENVIRONMENT_ID",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_ContainerWithSecrets(t *testing.T) {
 	t.Parallel()
 	testName := "container-with-secrets"
@@ -1320,6 +1375,124 @@ func TestAcc_ContainerWithPorts(t *testing.T) {
 	})
 }
 
+func TestAcc_ContainerWithHealthchecks(t *testing.T) {
+	t.Parallel()
+	testName := "container-with-healthchecks"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryContainerDestroy("qovery_container.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContainerDefaultConfigWithHealthchecks(
+					testName,
+					`{
+    readiness_probe = {
+      type = {
+        http = {
+          port = 80
+        }
+      }
+    }
+  }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.type.http.port", "80"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.type.http.path", "/"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.type.http.scheme", "HTTP"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.initial_delay_seconds", "30"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.failure_threshold", "3"),
+					resource.TestCheckNoResourceAttr("qovery_container.test", "healthchecks.liveness_probe"),
+				),
+			},
+			// Add liveness probe
+			{
+				Config: testAccContainerDefaultConfigWithHealthchecks(
+					testName,
+					`{
+    readiness_probe = {
+      type = {
+        http = {
+          port = 80
+        }
+      }
+    }
+    liveness_probe = {
+      type = {
+        tcp = {
+          port = 80
+        }
+      }
+      period_seconds = 20
+    }
+  }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.readiness_probe.type.http.port", "80"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.liveness_probe.type.tcp.port", "80"),
+					resource.TestCheckResourceAttr("qovery_container.test", "healthchecks.liveness_probe.period_seconds", "20"),
+				),
+			},
+			// Check Import
+			{
+				ResourceName:      "qovery_container.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_ContainerWithAdvancedSettings(t *testing.T) {
+	t.Parallel()
+	testName := "container-with-advanced-settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryContainerDestroy("qovery_container.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContainerDefaultConfigWithAdvancedSettings(
+					testName,
+					`{
+    "deployment.termination_grace_period_seconds" = 120
+  }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckResourceAttr("qovery_container.test", "advanced_settings.deployment.termination_grace_period_seconds", "120"),
+					resource.TestCheckResourceAttr("qovery_container.test", "advanced_settings.network.ingress.proxy_body_size_mb", "100"),
+				),
+			},
+			// Update advanced settings
+			{
+				Config: testAccContainerDefaultConfigWithAdvancedSettings(
+					testName,
+					`{
+    "deployment.termination_grace_period_seconds" = 120
+    "network.ingress.proxy_body_size_mb" = 200
+  }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryContainerExists("qovery_container.test"),
+					resource.TestCheckResourceAttr("qovery_container.test", "advanced_settings.deployment.termination_grace_period_seconds", "120"),
+					resource.TestCheckResourceAttr("qovery_container.test", "advanced_settings.network.ingress.proxy_body_size_mb", "200"),
+				),
+			},
+			// Check Import
+			{
+				ResourceName:      "qovery_container.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccQoveryContainerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -1488,6 +1661,48 @@ resource "qovery_container" "test" {
 	)
 }
 
+func testAccContainerDefaultConfigWithHealthchecks(testName string, healthchecks string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "qovery_container" "test" {
+  environment_id = qovery_environment.test.id
+  registry_id = qovery_container_registry.test.id
+  name = "%s"
+  image_name = "%s"
+  tag = "%s"
+  ports = [
+    {
+      internal_port = 80
+      publicly_accessible = false
+    }
+  ]
+  healthchecks = %s
+}
+`, testAccEnvironmentDefaultConfig(testName), testAccContainerRegistryDefaultConfig(testName), generateTestName(testName), containerImageName, containerTag, healthchecks,
+	)
+}
+
+func testAccContainerDefaultConfigWithAdvancedSettings(testName string, advancedSettings string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "qovery_container" "test" {
+  environment_id = qovery_environment.test.id
+  registry_id = qovery_container_registry.test.id
+  name = "%s"
+  image_name = "%s"
+  tag = "%s"
+  advanced_settings = %s
+}
+`, testAccEnvironmentDefaultConfig(testName), testAccContainerRegistryDefaultConfig(testName), generateTestName(testName), containerImageName, containerTag, advancedSettings,
+	)
+}
+
 func testAccContainerDefaultConfigWithEnvironmentVariables(testName string, environmentVariables map[string]string) string {
 	return fmt.Sprintf(`
 %s
@@ -1506,6 +1721,24 @@ resource "qovery_container" "test" {
 	)
 }
 
+func testAccContainerDefaultConfigWithEnvironmentVariableAliases(testName string, aliases map[string]string) string {
+	return fmt.Sprintf(`
+%s
+
+%s
+
+resource "qovery_container" "test" {
+  environment_id = qovery_environment.test.id
+  registry_id = qovery_container_registry.test.id
+  name = "%s"
+  image_name = "%s"
+  tag = "%s"
+  environment_variable_aliases = %s
+}
+`, testAccEnvironmentDefaultConfig(testName), testAccContainerRegistryDefaultConfig(testName), generateTestName(testName), containerImageName, containerTag, convertEnvVarsToString(aliases),
+	)
+}
+
 func testAccContainerDefaultConfigWithSecrets(testName string, secrets map[string]string) string {
 	return fmt.Sprintf(`
 %s
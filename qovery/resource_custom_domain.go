@@ -0,0 +1,351 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ resource.ResourceWithConfigure = &customDomainResource{}
+var _ resource.ResourceWithImportState = customDomainResource{}
+
+var (
+	// Custom Domain Service Type
+	customDomainServiceTypes = clientEnumToStringArray(customdomain.AllowedServiceTypeValues)
+
+	// Custom Domain Wait For Certificate
+	customDomainWaitForCertificateDefault = false
+)
+
+type customDomainResource struct {
+	applicationCustomDomainService customdomain.Service
+	containerCustomDomainService   customdomain.Service
+}
+
+func newCustomDomainResource() resource.Resource {
+	return &customDomainResource{}
+}
+
+func (r customDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+func (r *customDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.applicationCustomDomainService = provider.applicationCustomDomainService
+	r.containerCustomDomainService = provider.containerCustomDomainService
+}
+
+func (r customDomainResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Provides a Qovery custom domain resource. This can be used to create and manage the custom domains of Qovery applications and containers.\n" +
+			"NOTE: this resource can be used along with the `custom_domains` attribute of the `qovery_application` resource, as long as each custom domain is only managed once.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the custom domain.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"service_id": {
+				Description: "Id of the application or container owning the custom domain.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"service_type": {
+				Description: descriptions.NewStringEnumDescription(
+					"Type of the service owning the custom domain.",
+					customDomainServiceTypes,
+					nil,
+				),
+				Type:     types.StringType,
+				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.NewStringEnumValidator(customDomainServiceTypes),
+				},
+			},
+			"domain": {
+				Description: "Your custom domain.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"validation_domain": {
+				Description: "URL provided by Qovery. You must create a CNAME on your DNS provider using that URL.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"status": {
+				Description: "Status of the custom domain certificate.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"wait_for_certificate": {
+				Description: descriptions.NewBoolDefaultDescription(
+					"Wait for the certificate of the custom domain to be issued. The CNAME record must be created for the certificate to be issued.",
+					customDomainWaitForCertificateDefault,
+				),
+				Type:     types.BoolType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					modifiers.NewBoolDefaultModifier(customDomainWaitForCertificateDefault),
+				},
+			},
+			"timeouts": timeoutsResourceAttribute(defaultCustomDomainTimeout),
+		},
+	}, nil
+}
+
+// Create qovery custom domain resource
+func (r customDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ServiceCustomDomain
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.customDomainService(ToString(plan.ServiceType))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain create", err)
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultCustomDomainTimeout)
+	defer cancel()
+
+	// Create new custom domain
+	d, err := service.Create(ctx, ToString(plan.ServiceId), plan.toUpsertRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain create", err)
+		return
+	}
+
+	// Initialize state values
+	state := convertDomainCustomDomainToServiceCustomDomain(plan, d)
+	tflog.Trace(ctx, "created custom domain", map[string]interface{}{"custom_domain_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	if resp.Diagnostics.HasError() || !ToBool(plan.WaitForCertificate) {
+		return
+	}
+
+	// Wait for the certificate, the custom domain is kept in the state if it is not issued in time
+	d, err = service.WaitForCertificate(ctx, ToString(plan.ServiceId), state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain create", err)
+		return
+	}
+
+	// Set state
+	state = convertDomainCustomDomainToServiceCustomDomain(plan, d)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery custom domain resource
+func (r customDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ServiceCustomDomain
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.customDomainService(ToString(state.ServiceType))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain read", err)
+		return
+	}
+
+	// Get custom domain from the API
+	d, err := service.Get(ctx, ToString(state.ServiceId), state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain read", err)
+		return
+	}
+
+	// Refresh state values
+	state = convertDomainCustomDomainToServiceCustomDomain(state, d)
+	tflog.Trace(ctx, "read custom domain", map[string]interface{}{"custom_domain_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update qovery custom domain resource
+// Every attribute of the custom domain requires a replacement, so only the waiting for the certificate can change.
+func (r customDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state ServiceCustomDomain
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.customDomainService(ToString(plan.ServiceType))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain update", err)
+		return
+	}
+
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultCustomDomainTimeout)
+	defer cancel()
+
+	var d *customdomain.CustomDomain
+	if ToBool(plan.WaitForCertificate) {
+		d, err = service.WaitForCertificate(ctx, ToString(state.ServiceId), state.Id.Value)
+	} else {
+		d, err = service.Get(ctx, ToString(state.ServiceId), state.Id.Value)
+	}
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain update", err)
+		return
+	}
+
+	// Update state values
+	state = convertDomainCustomDomainToServiceCustomDomain(plan, d)
+	tflog.Trace(ctx, "updated custom domain", map[string]interface{}{"custom_domain_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete qovery custom domain resource
+func (r customDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ServiceCustomDomain
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.customDomainService(ToString(state.ServiceType))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain delete", err)
+		return
+	}
+
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultCustomDomainTimeout)
+	defer cancel()
+
+	// Delete custom domain
+	if err := service.Delete(ctx, ToString(state.ServiceId), state.Id.Value); err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain delete", err)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted custom domain", map[string]interface{}{"custom_domain_id": state.Id.Value})
+
+	// Remove custom domain from state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery custom domain resource using its service id and domain
+func (r customDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.FieldsFunc(req.ID, func(c rune) bool { return c == ',' || c == '/' })
+
+	if len(idParts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: service_id,domain or service_id/domain. Got: %q", req.ID),
+		)
+		return
+	}
+
+	serviceType, d, err := r.findCustomDomain(ctx, idParts[0], idParts[1])
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on custom domain import", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_type"), serviceType.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), d.ID.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_certificate"), customDomainWaitForCertificateDefault)...)
+}
+
+// findCustomDomain returns the custom domain of the given service with the given domain, along with the type of the service.
+// The service is looked up as an application first, then as a container.
+func (r customDomainResource) findCustomDomain(ctx context.Context, serviceID string, domain string) (customdomain.ServiceType, *customdomain.CustomDomain, error) {
+	found := false
+	for _, serviceType := range customdomain.AllowedServiceTypeValues {
+		service, err := r.customDomainService(serviceType.String())
+		if err != nil {
+			return "", nil, err
+		}
+
+		domains, err := service.List(ctx, serviceID)
+		if err != nil {
+			// The service is not of this type, try the next one.
+			if apiErr := apierrors.NewApiErrorFromError(err); apiErr != nil && apiErr.IsNotFound() {
+				continue
+			}
+			return "", nil, err
+		}
+		found = true
+
+		for _, d := range domains {
+			if d.Domain == domain {
+				return serviceType, &d, nil
+			}
+		}
+	}
+
+	if !found {
+		return "", nil, fmt.Errorf("service %s not found", serviceID)
+	}
+
+	return "", nil, fmt.Errorf("custom domain %q not found on service %s", domain, serviceID)
+}
+
+// customDomainService returns the customdomain.Service handling the custom domains of the given service type.
+func (r customDomainResource) customDomainService(serviceType string) (customdomain.Service, error) {
+	t, err := customdomain.NewServiceTypeFromString(serviceType)
+	if err != nil {
+		return nil, err
+	}
+
+	switch *t {
+	case customdomain.ServiceTypeContainer:
+		return r.containerCustomDomainService, nil
+	default:
+		return r.applicationCustomDomainService, nil
+	}
+}
@@ -0,0 +1,37 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+)
+
+type ServiceCustomDomain struct {
+	Id                 types.String `tfsdk:"id"`
+	ServiceId          types.String `tfsdk:"service_id"`
+	ServiceType        types.String `tfsdk:"service_type"`
+	Domain             types.String `tfsdk:"domain"`
+	ValidationDomain   types.String `tfsdk:"validation_domain"`
+	Status             types.String `tfsdk:"status"`
+	WaitForCertificate types.Bool   `tfsdk:"wait_for_certificate"`
+	Timeouts           *Timeouts    `tfsdk:"timeouts"`
+}
+
+func (d ServiceCustomDomain) toUpsertRequest() customdomain.UpsertRequest {
+	return customdomain.UpsertRequest{
+		Domain: ToString(d.Domain),
+	}
+}
+
+func convertDomainCustomDomainToServiceCustomDomain(state ServiceCustomDomain, d *customdomain.CustomDomain) ServiceCustomDomain {
+	return ServiceCustomDomain{
+		Id:                 FromString(d.ID.String()),
+		ServiceId:          state.ServiceId,
+		ServiceType:        state.ServiceType,
+		Domain:             FromString(d.Domain),
+		ValidationDomain:   FromStringPointer(d.ValidationDomain),
+		Status:             FromStringPointer(d.Status),
+		WaitForCertificate: state.WaitForCertificate,
+		Timeouts:           state.Timeouts,
+	}
+}
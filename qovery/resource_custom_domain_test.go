@@ -0,0 +1,112 @@
+package qovery
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/application/services"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
+	"github.com/qovery/terraform-provider-qovery/internal/infrastructure/repositories/mocks_test"
+)
+
+func TestCustomDomainResource_FindCustomDomain(t *testing.T) {
+	t.Parallel()
+
+	serviceID := uuid.NewString()
+	domain := customdomain.CustomDomain{ID: uuid.New(), Domain: gofakeit.DomainName()}
+	notFound := apierrors.NewNotFoundApiError(apierrors.ApiResourceApplicationCustomDomain, serviceID)
+	forbidden := apierrors.NewReadApiError(apierrors.ApiResourceApplicationCustomDomain, serviceID, &http.Response{StatusCode: http.StatusForbidden}, errors.New("forbidden"))
+
+	testCases := []struct {
+		TestName            string
+		ApplicationDomains  customdomain.CustomDomains
+		ApplicationError    error
+		ContainerDomains    customdomain.CustomDomains
+		ContainerError      error
+		ExpectedServiceType customdomain.ServiceType
+		ExpectedError       string
+	}{
+		{
+			TestName:            "success_application",
+			ApplicationDomains:  customdomain.CustomDomains{domain},
+			ExpectedServiceType: customdomain.ServiceTypeApplication,
+		},
+		{
+			TestName:            "success_container_when_application_not_found",
+			ApplicationError:    notFound,
+			ContainerDomains:    customdomain.CustomDomains{domain},
+			ExpectedServiceType: customdomain.ServiceTypeContainer,
+		},
+		{
+			TestName:            "success_container_when_application_has_no_such_domain",
+			ApplicationDomains:  customdomain.CustomDomains{},
+			ContainerDomains:    customdomain.CustomDomains{domain},
+			ExpectedServiceType: customdomain.ServiceTypeContainer,
+		},
+		{
+			TestName:         "fail_on_application_error",
+			ApplicationError: forbidden,
+			ExpectedError:    forbidden.Error(),
+		},
+		{
+			TestName:         "fail_service_not_found",
+			ApplicationError: notFound,
+			ContainerError:   notFound,
+			ExpectedError:    "service " + serviceID + " not found",
+		},
+		{
+			TestName:           "fail_domain_not_found",
+			ApplicationDomains: customdomain.CustomDomains{},
+			ContainerError:     notFound,
+			ExpectedError:      "custom domain \"" + domain.Domain + "\" not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			applicationRepository := mocks_test.NewCustomDomainRepository(t)
+			applicationRepository.EXPECT().
+				List(mock.Anything, serviceID).
+				Return(tc.ApplicationDomains, tc.ApplicationError)
+			containerRepository := mocks_test.NewCustomDomainRepository(t)
+			if tc.ApplicationError == nil || tc.ApplicationError == notFound {
+				if tc.ExpectedServiceType != customdomain.ServiceTypeApplication {
+					containerRepository.EXPECT().
+						List(mock.Anything, serviceID).
+						Return(tc.ContainerDomains, tc.ContainerError)
+				}
+			}
+
+			applicationService, err := services.NewCustomDomainService(applicationRepository)
+			require.NoError(t, err)
+			containerService, err := services.NewCustomDomainService(containerRepository)
+			require.NoError(t, err)
+
+			r := customDomainResource{
+				applicationCustomDomainService: applicationService,
+				containerCustomDomainService:   containerService,
+			}
+			serviceType, d, err := r.findCustomDomain(context.Background(), serviceID, domain.Domain)
+			if tc.ExpectedError != "" {
+				assert.ErrorContains(t, err, tc.ExpectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.ExpectedServiceType, serviceType)
+			assert.Equal(t, domain.ID, d.ID)
+		})
+	}
+}
@@ -12,7 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
 	"github.com/qovery/terraform-provider-qovery/qovery/modifiers"
 	"github.com/qovery/terraform-provider-qovery/qovery/validators"
@@ -54,7 +54,7 @@ var (
 )
 
 type databaseResource struct {
-	client *client.Client
+	databaseService database.Service
 }
 
 func newDatabaseResource() resource.Resource {
@@ -80,7 +80,7 @@ func (r *databaseResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = provider.client
+	r.databaseService = provider.databaseService
 }
 
 func (r databaseResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
@@ -96,6 +96,9 @@ func (r databaseResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the database.",
@@ -110,6 +113,9 @@ func (r databaseResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				),
 				Type:     types.StringType,
 				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.NewStringEnumValidator(databaseTypes),
 				},
@@ -127,6 +133,9 @@ func (r databaseResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				),
 				Type:     types.StringType,
 				Required: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 				Validators: []tfsdk.AttributeValidator{
 					validators.NewStringEnumValidator(databaseModes),
 				},
@@ -226,6 +235,8 @@ func (r databaseResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagn
 				Optional:    true,
 				Computed:    true,
 			},
+			"advanced_settings": advancedSettingsResourceAttribute("database", databaseAdvancedSettings),
+			"timeouts":          timeoutsResourceAttribute(defaultServiceTimeout),
 		},
 	}, nil
 }
@@ -239,20 +250,23 @@ func (r databaseResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Create new database
-	request, err := plan.toCreateDatabaseRequest()
+	request, err := plan.toUpsertRepositoryRequest()
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database create", err)
 		return
 	}
-	database, apiErr := r.client.CreateDatabase(ctx, plan.EnvironmentId.Value, request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	db, err := r.databaseService.Create(ctx, ToString(plan.EnvironmentId), *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertResponseToDatabase(database)
+	state := convertDomainDatabaseToDatabase(plan, db)
 	tflog.Trace(ctx, "created database", map[string]interface{}{"database_id": state.Id.Value})
 
 	// Set state
@@ -269,14 +283,14 @@ func (r databaseResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get database from the API
-	database, apiErr := r.client.GetDatabase(ctx, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	db, err := r.databaseService.Get(ctx, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertResponseToDatabase(database)
+	state = convertDomainDatabaseToDatabase(state, db)
 	tflog.Trace(ctx, "read database", map[string]interface{}{"database_id": state.Id.Value})
 
 	// Set state
@@ -293,20 +307,23 @@ func (r databaseResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Update database in the backend
-	request, err := plan.toUpdateDatabaseRequest()
+	request, err := plan.toUpsertRepositoryRequest()
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database update", err)
 		return
 	}
-	database, apiErr := r.client.UpdateDatabase(ctx, state.Id.Value, request)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	db, err := r.databaseService.Update(ctx, state.Id.Value, *request)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database update", err)
 		return
 	}
 
 	// Update state values
-	state = convertResponseToDatabase(database)
+	state = convertDomainDatabaseToDatabase(plan, db)
 	tflog.Trace(ctx, "updated database", map[string]interface{}{"database_id": state.Id.Value})
 
 	// Set state
@@ -322,10 +339,13 @@ func (r databaseResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Delete database
-	apiErr := r.client.DeleteDatabase(ctx, state.Id.Value)
-	if apiErr != nil {
-		resp.Diagnostics.AddError(apiErr.Summary(), apiErr.Detail())
+	err := r.databaseService.Delete(ctx, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on database delete", err)
 		return
 	}
 
@@ -1,10 +1,10 @@
 package qovery
 
 import (
+	"github.com/AlekSi/pointer"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/database"
 )
 
 type Database struct {
@@ -24,74 +24,58 @@ type Database struct {
 	Password          types.String `tfsdk:"password"`
 	Storage           types.Int64  `tfsdk:"storage"`
 	DeploymentStageId types.String `tfsdk:"deployment_stage_id"`
+	AdvancedSettings  types.Object `tfsdk:"advanced_settings"`
+	Timeouts          *Timeouts    `tfsdk:"timeouts"`
 }
 
-func (d Database) toCreateDatabaseRequest() (*client.DatabaseCreateParams, error) {
-	dbType, err := qovery.NewDatabaseTypeEnumFromValue(ToString(d.Type))
+func (d Database) toUpsertRepositoryRequest() (*database.UpsertRepositoryRequest, error) {
+	advancedSettings, err := toAdvancedSettings(d.AdvancedSettings)
 	if err != nil {
 		return nil, err
 	}
 
-	mode, err := qovery.NewDatabaseModeEnumFromValue(ToString(d.Mode))
-	if err != nil {
-		return nil, err
-	}
-
-	accessibility, err := qovery.NewDatabaseAccessibilityEnumFromValue(ToString(d.Accessibility))
-	if err != nil {
-		return nil, err
-	}
-
-	return &client.DatabaseCreateParams{
-		DatabaseRequest: qovery.DatabaseRequest{
-			Name:          ToString(d.Name),
-			Type:          *dbType,
-			Version:       ToString(d.Version),
-			Mode:          *mode,
-			Accessibility: accessibility,
-			Cpu:           ToInt32Pointer(d.CPU),
-			Memory:        ToInt32Pointer(d.Memory),
-			Storage:       ToInt32Pointer(d.Storage),
-		},
+	return &database.UpsertRepositoryRequest{
+		Name:              ToString(d.Name),
+		Type:              ToString(d.Type),
+		Version:           ToString(d.Version),
+		Mode:              ToString(d.Mode),
+		Accessibility:     ToStringPointer(d.Accessibility),
+		CPU:               ToInt32Pointer(d.CPU),
+		Memory:            ToInt32Pointer(d.Memory),
+		Storage:           ToInt32Pointer(d.Storage),
+		AdvancedSettings:  advancedSettings,
 		DeploymentStageID: ToString(d.DeploymentStageId),
 	}, nil
 }
 
-func (d Database) toUpdateDatabaseRequest() (*client.DatabaseUpdateParams, error) {
-	accessibility, err := qovery.NewDatabaseAccessibilityEnumFromValue(ToString(d.Accessibility))
-	if err != nil {
-		return nil, err
+func convertDomainDatabaseToDatabase(state Database, db *database.Database) Database {
+	var login, password types.String
+	if db.Credentials != nil {
+		login = FromString(db.Credentials.Login)
+		password = FromString(db.Credentials.Password)
+	} else {
+		login = FromStringPointer(nil)
+		password = FromStringPointer(nil)
 	}
 
-	return &client.DatabaseUpdateParams{
-		DatabaseEditRequest: qovery.DatabaseEditRequest{
-			Name:          ToStringPointer(d.Name),
-			Version:       ToStringPointer(d.Version),
-			Accessibility: accessibility,
-			Cpu:           ToInt32Pointer(d.CPU),
-			Memory:        ToInt32Pointer(d.Memory),
-			Storage:       ToInt32Pointer(d.Storage),
-		},
-	}, nil
-}
-
-func convertResponseToDatabase(res *client.DatabaseResponse) Database {
 	return Database{
-		Id:                FromString(res.DatabaseResponse.Id),
-		EnvironmentId:     FromString(res.DatabaseResponse.Environment.Id),
-		Name:              FromString(res.DatabaseResponse.Name),
-		Type:              fromClientEnum(res.DatabaseResponse.Type),
-		Version:           FromString(res.DatabaseResponse.Version),
-		Mode:              fromClientEnum(res.DatabaseResponse.Mode),
-		Accessibility:     fromClientEnumPointer(res.DatabaseResponse.Accessibility),
-		CPU:               FromInt32Pointer(res.DatabaseResponse.Cpu),
-		Memory:            FromInt32Pointer(res.DatabaseResponse.Memory),
-		ExternalHost:      FromString(res.DatabaseResponse.GetHost()),
-		InternalHost:      FromString(res.DatabaseInternalHost),
-		Port:              FromInt32Pointer(res.DatabaseResponse.Port),
-		Login:             FromString(res.DatabaseCredentials.Login),
-		Password:          FromString(res.DatabaseCredentials.Password),
-		Storage:           FromInt32Pointer(res.DatabaseResponse.Storage),
-		DeploymentStageId: FromString(res.DeploymentStageID),
+		Id:                FromString(db.ID.String()),
+		EnvironmentId:     FromString(db.EnvironmentID.String()),
+		Name:              FromString(db.Name),
+		Type:              FromString(db.Type.String()),
+		Version:           FromString(db.Version),
+		Mode:              FromString(db.Mode.String()),
+		Accessibility:     FromString(db.Accessibility.String()),
+		CPU:               FromInt32(db.CPU),
+		Memory:            FromInt32(db.Memory),
+		ExternalHost:      FromString(pointer.GetString(db.ExternalHost)),
+		InternalHost:      FromString(pointer.GetString(db.InternalHost)),
+		Port:              FromInt32Pointer(db.Port),
+		Login:             login,
+		Password:          password,
+		Storage:           FromInt32(db.Storage),
+		DeploymentStageId: FromString(db.DeploymentStageID),
+		AdvancedSettings:  fromAdvancedSettings(db.AdvancedSettings, databaseAdvancedSettings),
+		Timeouts:          state.Timeouts,
 	}
 }
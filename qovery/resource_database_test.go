@@ -10,8 +10,9 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
 
-	"github.com/qovery/terraform-provider-qovery/client/apierrors"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
 )
 
 type database struct {
@@ -281,6 +282,49 @@ func TestAcc_DatabaseImport(t *testing.T) {
 	})
 }
 
+func TestAcc_DatabaseWithAdvancedSettings(t *testing.T) {
+	t.Parallel()
+	testName := "database-with-advanced-settings"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryDatabaseDestroy("qovery_database.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDatabaseDefaultConfig(
+					testName,
+					redisContainer,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryDatabaseExists("qovery_database.test"),
+					resource.TestCheckResourceAttr("qovery_database.test", "advanced_settings.network.ingress.whitelist_source_range", "0.0.0.0/0"),
+				),
+			},
+			// Update advanced settings
+			{
+				Config: testAccDatabaseDefaultConfigWithAdvancedSettings(
+					testName,
+					redisContainer,
+					`{
+    "network.ingress.whitelist_source_range" = "10.0.0.0/24"
+  }`,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryDatabaseExists("qovery_database.test"),
+					resource.TestCheckResourceAttr("qovery_database.test", "advanced_settings.network.ingress.whitelist_source_range", "10.0.0.0/24"),
+				),
+			},
+			// Check Import
+			{
+				ResourceName:      "qovery_database.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccQoveryDatabaseExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -292,9 +336,9 @@ func testAccQoveryDatabaseExists(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("database.id not found")
 		}
 
-		_, apiErr := apiClient.GetDatabase(context.TODO(), rs.Primary.ID)
-		if apiErr != nil {
-			return apiErr
+		_, err := qoveryServices.Database.Get(context.TODO(), rs.Primary.ID)
+		if err != nil {
+			return err
 		}
 		return nil
 	}
@@ -311,12 +355,12 @@ func testAccQoveryDatabaseDestroy(resourceName string) resource.TestCheckFunc {
 			return fmt.Errorf("database.id not found")
 		}
 
-		_, apiErr := apiClient.GetDatabase(context.TODO(), rs.Primary.ID)
-		if apiErr == nil {
+		_, err := qoveryServices.Database.Get(context.TODO(), rs.Primary.ID)
+		if err == nil {
 			return fmt.Errorf("found database but expected it to be deleted")
 		}
-		if !apierrors.IsNotFound(apiErr) {
-			return fmt.Errorf("unexpected error checking for deleted database: %s", apiErr.Summary())
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted database: %s", err.Error())
 		}
 		return nil
 	}
@@ -385,3 +429,19 @@ resource "qovery_database" "test" {
 `, testAccEnvironmentDefaultConfig(testName), generateTestName(testName), db.dbType, db.version, db.mode, storage,
 	)
 }
+
+func testAccDatabaseDefaultConfigWithAdvancedSettings(testName string, db database, advancedSettings string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "qovery_database" "test" {
+  environment_id = qovery_environment.test.id
+  name = "%s"
+  type = "%s"
+  version = "%s"
+  mode = "%s"
+  advanced_settings = %s
+}
+`, testAccEnvironmentDefaultConfig(testName), generateTestName(testName), db.dbType, db.version, db.mode, advancedSettings,
+	)
+}
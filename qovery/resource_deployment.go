@@ -40,6 +40,7 @@ type NewDeploymentTerraform struct {
 	EnvironmentId types.String `tfsdk:"environment_id"`
 	Version       types.String `tfsdk:"version"`
 	DesiredState  types.String `tfsdk:"desired_state"`
+	Timeouts      *Timeouts    `tfsdk:"timeouts"`
 }
 
 func newDeploymentTerraformFromDomain(domain *newdeployment.Deployment) NewDeploymentTerraform {
@@ -92,6 +93,9 @@ func (r deploymentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"version": {
 				Description: "Version to force trigger a deployment when desired_state doesn't change (e.g redeploy a deployment having the 'RUNNING' state)",
@@ -110,6 +114,7 @@ func (r deploymentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Dia
 					validators.NewStringEnumValidator(deploymentStates),
 				},
 			},
+			"timeouts": timeoutsResourceAttribute(defaultDeploymentTimeout),
 		},
 	}, nil
 }
@@ -123,6 +128,9 @@ func (r deploymentResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultDeploymentTimeout)
+	defer cancel()
+
 	// Create new deployment stage
 	deployment, err := r.deploymentService.Create(ctx, newdeployment.NewDeploymentParams{
 		ID:            ToStringPointer(plan.Id),
@@ -131,11 +139,12 @@ func (r deploymentResource) Create(ctx context.Context, req resource.CreateReque
 		DesiredState:  ToString(plan.DesiredState),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment create", err)
 		return
 	}
 
 	newState := newDeploymentTerraformFromDomain(deployment)
+	newState.Timeouts = plan.Timeouts
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
@@ -157,11 +166,12 @@ func (r deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 		DesiredState:  ToString(state.DesiredState),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment read", err)
 		return
 	}
 
 	newState := newDeploymentTerraformFromDomain(deployment)
+	newState.Timeouts = state.Timeouts
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -176,6 +186,9 @@ func (r deploymentResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultDeploymentTimeout)
+	defer cancel()
+
 	deployment, err := r.deploymentService.Update(ctx, newdeployment.NewDeploymentParams{
 		ID:            ToStringPointer(state.Id),
 		EnvironmentID: ToString(plan.EnvironmentId),
@@ -183,10 +196,11 @@ func (r deploymentResource) Update(ctx context.Context, req resource.UpdateReque
 		DesiredState:  ToString(plan.DesiredState),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment update", err)
 		return
 	}
 	newState := newDeploymentTerraformFromDomain(deployment)
+	newState.Timeouts = plan.Timeouts
 
 	// Set state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -200,13 +214,16 @@ func (r deploymentResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultDeploymentTimeout)
+	defer cancel()
+
 	err := r.deploymentService.Delete(ctx, newdeployment.NewDeploymentParams{
 		EnvironmentID: ToString(state.EnvironmentId),
 		// When terraform destroys, the desired state will be "DELETED"
 		DesiredState: "DELETED",
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment delete", err)
 		return
 	}
 
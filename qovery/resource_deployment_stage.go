@@ -61,6 +61,9 @@ func (r deploymentStageResource) GetSchema(_ context.Context) (tfsdk.Schema, dia
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the deployment stage.",
@@ -98,7 +101,7 @@ func (r deploymentStageResource) Create(ctx context.Context, req resource.Create
 	// Create new deployment stage
 	deploymentStage, err := r.deploymentStageService.Create(ctx, plan.EnvironmentId.Value, plan.toCreateServiceRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment stage create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment stage create", err)
 		return
 	}
 
@@ -121,7 +124,7 @@ func (r deploymentStageResource) Read(ctx context.Context, req resource.ReadRequ
 	// Get deployment stage from the API
 	deploymentStage, err := r.deploymentStageService.Get(ctx, state.EnvironmentId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment stage read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment stage read", err)
 		return
 	}
 
@@ -156,7 +159,7 @@ func (r deploymentStageResource) Update(ctx context.Context, req resource.Update
 	// Update deployment stage in the backend
 	deploymentStage, err := r.deploymentStageService.Update(ctx, state.Id.Value, plan.toUpdateServiceRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment stage update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment stage update", err)
 		return
 	}
 
@@ -180,7 +183,7 @@ func (r deploymentStageResource) Delete(ctx context.Context, req resource.Delete
 	// Delete deployment stage
 	err := r.deploymentStageService.Delete(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on deployment stage delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on deployment stage delete", err)
 		return
 	}
 
@@ -0,0 +1,204 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ resource.ResourceWithConfigure = &digitalOceanCredentialsResource{}
+var _ resource.ResourceWithImportState = digitalOceanCredentialsResource{}
+
+type digitalOceanCredentialsResource struct {
+	digitalOceanCredentialsService credentials.DigitalOceanService
+}
+
+func newDigitalOceanCredentialsResource() resource.Resource {
+	return &digitalOceanCredentialsResource{}
+}
+
+func (r digitalOceanCredentialsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_digitalocean_credentials"
+}
+
+func (r *digitalOceanCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.digitalOceanCredentialsService = provider.digitalOceanCredentialsService
+}
+
+func (r digitalOceanCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Provides a Qovery DigitalOcean credentials resource. This can be used to create and manage Qovery DigitalOcean credentials.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the DigitalOcean credentials.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"organization_id": {
+				Description: "Id of the organization.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"name": {
+				Description: "Name of the DigitalOcean credentials.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"digitalocean_token": {
+				Description: "Your DigitalOcean API token.",
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"digitalocean_spaces_access_id": {
+				Description: "Your DigitalOcean Spaces access id.",
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"digitalocean_spaces_secret_key": {
+				Description: "Your DigitalOcean Spaces secret key.",
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}, nil
+}
+
+// Create qovery digitalocean credentials resource
+func (r digitalOceanCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan DigitalOceanCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new credentials
+	creds, err := r.digitalOceanCredentialsService.Create(ctx, plan.OrganizationId.Value, plan.toUpsertDigitalOceanRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on digitalocean credentials create", err)
+		return
+	}
+
+	// Initialize state values
+	state := convertDomainCredentialsToDigitalOceanCredentials(creds, plan)
+	tflog.Trace(ctx, "created digitalocean credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery digitalocean credentials resource
+func (r digitalOceanCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state DigitalOceanCredentials
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get credentials from API
+	creds, err := r.digitalOceanCredentialsService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on digitalocean credentials read", err)
+		return
+	}
+
+	state = convertDomainCredentialsToDigitalOceanCredentials(creds, state)
+	tflog.Trace(ctx, "read digitalocean credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update qovery digitalocean credentials resource
+func (r digitalOceanCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state DigitalOceanCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update credentials in the backend
+	creds, err := r.digitalOceanCredentialsService.Update(ctx, state.OrganizationId.Value, state.Id.Value, plan.toUpsertDigitalOceanRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on digitalocean credentials update", err)
+		return
+	}
+
+	// Update state values
+	state = convertDomainCredentialsToDigitalOceanCredentials(creds, plan)
+	tflog.Trace(ctx, "updated digitalocean credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete qovery digitalocean credentials resource
+func (r digitalOceanCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state DigitalOceanCredentials
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credentials in the backend
+	err := r.digitalOceanCredentialsService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on digitalocean credentials delete", err)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted digitalocean credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Remove credentials from state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery digitalocean credentials resource using its id
+func (r digitalOceanCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id,digitalocean_credentials_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+}
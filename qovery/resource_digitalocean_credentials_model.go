@@ -0,0 +1,50 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+type DigitalOceanCredentials struct {
+	Id                          types.String `tfsdk:"id"`
+	OrganizationId              types.String `tfsdk:"organization_id"`
+	Name                        types.String `tfsdk:"name"`
+	DigitalOceanToken           types.String `tfsdk:"digitalocean_token"`
+	DigitalOceanSpacesAccessID  types.String `tfsdk:"digitalocean_spaces_access_id"`
+	DigitalOceanSpacesSecretKey types.String `tfsdk:"digitalocean_spaces_secret_key"`
+}
+
+type DigitalOceanCredentialsDataSource struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+}
+
+func (creds DigitalOceanCredentials) toUpsertDigitalOceanRequest() credentials.UpsertDigitalOceanRequest {
+	return credentials.UpsertDigitalOceanRequest{
+		Name:                        ToString(creds.Name),
+		DigitalOceanToken:           ToString(creds.DigitalOceanToken),
+		DigitalOceanSpacesAccessID:  ToString(creds.DigitalOceanSpacesAccessID),
+		DigitalOceanSpacesSecretKey: ToString(creds.DigitalOceanSpacesSecretKey),
+	}
+}
+
+func convertDomainCredentialsToDigitalOceanCredentials(creds *credentials.Credentials, plan DigitalOceanCredentials) DigitalOceanCredentials {
+	return DigitalOceanCredentials{
+		Id:                          FromString(creds.ID.String()),
+		OrganizationId:              FromString(creds.OrganizationID.String()),
+		Name:                        FromString(creds.Name),
+		DigitalOceanToken:           plan.DigitalOceanToken,
+		DigitalOceanSpacesAccessID:  plan.DigitalOceanSpacesAccessID,
+		DigitalOceanSpacesSecretKey: plan.DigitalOceanSpacesSecretKey,
+	}
+}
+
+func convertDomainCredentialsToDigitalOceanCredentialsDataSource(creds *credentials.Credentials) DigitalOceanCredentialsDataSource {
+	return DigitalOceanCredentialsDataSource{
+		Id:             FromString(creds.ID.String()),
+		OrganizationId: FromString(creds.OrganizationID.String()),
+		Name:           FromString(creds.Name),
+	}
+}
@@ -0,0 +1,124 @@
+//go:build integration && !unit
+// +build integration,!unit
+
+package qovery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+func TestAcc_DigitalOceanCredentials(t *testing.T) {
+	t.Parallel()
+	testName := "digitalocean-credentials"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryDigitalOceanCredentialsDestroy("qovery_digitalocean_credentials.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccDigitalOceanCredentialsDefaultConfig(
+					testName,
+					getTestDigitalOceanCredentialsSpacesAccessID(),
+					getTestDigitalOceanCredentialsSpacesSecretKey(),
+					getTestDigitalOceanCredentialsToken(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryDigitalOceanCredentialsExists("qovery_digitalocean_credentials.test"),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "organization_id", getTestOrganizationID()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "name", generateTestName(testName)),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_spaces_access_id", getTestDigitalOceanCredentialsSpacesAccessID()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_spaces_secret_key", getTestDigitalOceanCredentialsSpacesSecretKey()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_token", getTestDigitalOceanCredentialsToken()),
+				),
+			},
+			// Update name
+			{
+				Config: testAccDigitalOceanCredentialsDefaultConfig(
+					fmt.Sprintf("%s-updated", testName),
+					getTestDigitalOceanCredentialsSpacesAccessID(),
+					getTestDigitalOceanCredentialsSpacesSecretKey(),
+					getTestDigitalOceanCredentialsToken(),
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryDigitalOceanCredentialsExists("qovery_digitalocean_credentials.test"),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "organization_id", getTestOrganizationID()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "name", generateTestName(fmt.Sprintf("%s-updated", testName))),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_spaces_access_id", getTestDigitalOceanCredentialsSpacesAccessID()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_spaces_secret_key", getTestDigitalOceanCredentialsSpacesSecretKey()),
+					resource.TestCheckResourceAttr("qovery_digitalocean_credentials.test", "digitalocean_token", getTestDigitalOceanCredentialsToken()),
+				),
+			},
+			// Check Import
+			{
+				ResourceName:            "qovery_digitalocean_credentials.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s,", getTestOrganizationID()),
+				ImportStateVerifyIgnore: []string{"digitalocean_spaces_access_id", "digitalocean_spaces_secret_key", "digitalocean_token"},
+			},
+		},
+	})
+}
+
+func testAccQoveryDigitalOceanCredentialsExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("digitalocean_credentials not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("digitalocean_credentials.id not found")
+		}
+
+		_, err := qoveryServices.CredentialsDigitalOcean.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccQoveryDigitalOceanCredentialsDestroy(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("digitalocean_credentials not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("digitalocean_credentials.id not found")
+		}
+
+		_, err := qoveryServices.CredentialsDigitalOcean.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("found digitalocean_credentials but expected it to be deleted")
+		}
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted digitalocean_credentials: %s", err.Error())
+		}
+		return nil
+	}
+}
+
+func testAccDigitalOceanCredentialsDefaultConfig(testName string, spacesAccessID string, spacesSecretKey string, token string) string {
+	return fmt.Sprintf(`
+resource "qovery_digitalocean_credentials" "test" {
+  organization_id = "%s"
+  name = "%s"
+  digitalocean_spaces_access_id = "%s"
+  digitalocean_spaces_secret_key = "%s"
+  digitalocean_token = "%s"
+}
+`, getTestOrganizationID(), generateTestName(testName), spacesAccessID, spacesSecretKey, token,
+	)
+}
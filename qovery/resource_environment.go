@@ -65,11 +65,17 @@ func (r environmentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 				Description: "Id of the project.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"cluster_id": {
 				Description: "Id of the cluster [NOTE: can't be updated after creation].",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the environment.",
@@ -78,7 +84,7 @@ func (r environmentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 			},
 			"mode": {
 				Description: descriptions.NewStringEnumDescription(
-					"Mode of the environment [NOTE: can't be updated after creation].",
+					"Mode of the environment.",
 					clientEnumToStringArray(environment.AllowedModeValues),
 					pointer.ToString(environment.DefaultMode.String()),
 				),
@@ -111,10 +117,15 @@ func (r environmentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
-				Description: "List of environment variables linked to this environment.",
+				Description: "List of environment variables linked to this environment. The environment variables of the environment not listed here (i.e: managed by a `qovery_environment_variable` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -132,10 +143,15 @@ func (r environmentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"secrets": {
-				Description: "List of secrets linked to this environment.",
+				Description: "List of secrets linked to this environment. The secrets of the environment not listed here (i.e: managed by a `qovery_secret` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -154,8 +170,14 @@ func (r environmentResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Di
 						Required:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
+			"timeouts": timeoutsResourceAttribute(defaultServiceTimeout),
 		},
 	}, nil
 }
@@ -169,21 +191,24 @@ func (r environmentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Create new environment
 	request, err := plan.toCreateEnvironmentRequest()
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment create", err)
 		return
 	}
 
 	env, err := r.environmentService.Create(ctx, plan.ProjectId.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on environment create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertDomainEnvironmentToEnvironment(plan, env)
+	state := convertDomainEnvironmentToEnvironment(plan, env).keepManaged(plan)
 	tflog.Trace(ctx, "created environment", map[string]interface{}{"environment_id": state.Id.Value})
 
 	// Set state
@@ -202,12 +227,12 @@ func (r environmentResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Get environment from the API
 	env, err := r.environmentService.Get(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on environment read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertDomainEnvironmentToEnvironment(state, env)
+	state = convertDomainEnvironmentToEnvironment(state, env).keepManaged(state)
 	tflog.Trace(ctx, "read environment", map[string]interface{}{"environment_id": state.Id.Value})
 
 	// Set state
@@ -224,21 +249,24 @@ func (r environmentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	request, err := plan.toUpdateEnvironmentRequest(state)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment update", err)
 		return
 	}
 
 	// Update environment in the backend
 	env, err := r.environmentService.Update(ctx, state.Id.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on environment update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment update", err)
 		return
 	}
 
 	// Update state values
-	state = convertDomainEnvironmentToEnvironment(plan, env)
+	state = convertDomainEnvironmentToEnvironment(plan, env).keepManaged(plan)
 	tflog.Trace(ctx, "updated environment", map[string]interface{}{"environment_id": state.Id.Value})
 
 	// Set state
@@ -254,10 +282,13 @@ func (r environmentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Delete environment
 	err := r.environmentService.Delete(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on environment delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment delete", err)
 		return
 	}
 
@@ -16,6 +16,7 @@ type Environment struct {
 	BuiltInEnvironmentVariables types.Set    `tfsdk:"built_in_environment_variables"`
 	EnvironmentVariables        types.Set    `tfsdk:"environment_variables"`
 	Secrets                     types.Set    `tfsdk:"secrets"`
+	Timeouts                    *Timeouts    `tfsdk:"timeouts"`
 }
 
 func (e Environment) EnvironmentVariableList() EnvironmentVariableList {
@@ -30,6 +31,19 @@ func (e Environment) SecretList() SecretList {
 	return toSecretList(e.Secrets)
 }
 
+// keepManaged returns the environment with only the environment variables and secrets managed in the given plan or state,
+// so the ones managed by the `qovery_environment_variable` and `qovery_secret` resources are neither shown as a drift nor deleted.
+// Everything is kept on import, as the managed ones aren't known yet.
+func (e Environment) keepManaged(managed Environment) Environment {
+	if managed.ProjectId.Null {
+		return e
+	}
+
+	e.EnvironmentVariables = e.EnvironmentVariableList().managed(managed.EnvironmentVariableList()).toTerraformSet()
+	e.Secrets = e.SecretList().managed(managed.SecretList()).toTerraformSet()
+	return e
+}
+
 func (e Environment) toCreateEnvironmentRequest() (*environment.CreateServiceRequest, error) {
 	mode, err := environment.NewModeFromString(ToString(e.Mode))
 	if err != nil {
@@ -77,5 +91,6 @@ func convertDomainEnvironmentToEnvironment(state Environment, env *environment.E
 		EnvironmentVariables:        convertDomainVariablesToEnvironmentVariableList(env.EnvironmentVariables, variable.ScopeEnvironment).toTerraformSet(),
 		BuiltInEnvironmentVariables: convertDomainVariablesToEnvironmentVariableList(env.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
 		Secrets:                     convertDomainSecretsToSecretList(state.SecretList(), env.Secrets, variable.ScopeEnvironment).toTerraformSet(),
+		Timeouts:                    state.Timeouts,
 	}
 }
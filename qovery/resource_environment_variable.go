@@ -0,0 +1,251 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ resource.ResourceWithConfigure = &environmentVariableResource{}
+var _ resource.ResourceWithImportState = environmentVariableResource{}
+
+type environmentVariableResource struct {
+	environmentVariableService variable.KeyService
+}
+
+func newEnvironmentVariableResource() resource.Resource {
+	return &environmentVariableResource{}
+}
+
+func (r environmentVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable"
+}
+
+func (r *environmentVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.environmentVariableService = provider.environmentVariableService
+}
+
+func (r environmentVariableResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Provides a Qovery environment variable resource. This can be used to create and manage a single environment variable of a Qovery project, environment, application, container or job.\n" +
+			"NOTE: this resource can be used along with the `environment_variables` attribute of the resource owning the scope, as long as each environment variable key is only managed once.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the environment variable.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"scope_id": {
+				Description: "Id of the project, environment, application, container or job owning the environment variable.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"scope": {
+				Description: descriptions.NewStringEnumDescription(
+					"Scope of the environment variable. Resolved once from the `scope_id` if not set.",
+					keyScopeValues(),
+					nil,
+				),
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.NewStringEnumValidator(keyScopeValues()),
+				},
+			},
+			"key": {
+				Description: "Key of the environment variable.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"value": {
+				Description: "Value of the environment variable.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"mount_path": {
+				Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+				Type:        types.StringType,
+				Optional:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+// Create qovery environment variable resource
+func (r environmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ScopedEnvironmentVariable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the scope once if it is not set, it is then kept in the state
+	scope, err := r.resolveScope(ctx, plan)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable create", err)
+		return
+	}
+
+	// Create new environment variable
+	v, err := r.environmentVariableService.Create(ctx, scope, ToString(plan.ScopeId), plan.toUpsertRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable create", err)
+		return
+	}
+
+	// Initialize state values
+	state := convertDomainVariableToScopedEnvironmentVariable(plan, v)
+	tflog.Trace(ctx, "created environment variable", map[string]interface{}{"environment_variable_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery environment variable resource
+func (r environmentVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ScopedEnvironmentVariable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get environment variable from the API
+	v, err := r.environmentVariableService.Get(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Key))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable read", err)
+		return
+	}
+
+	// Refresh state values
+	state = convertDomainVariableToScopedEnvironmentVariable(state, v)
+	tflog.Trace(ctx, "read environment variable", map[string]interface{}{"environment_variable_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update qovery environment variable resource
+func (r environmentVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state ScopedEnvironmentVariable
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update environment variable in the backend
+	v, err := r.environmentVariableService.Update(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Id), plan.toUpsertRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable update", err)
+		return
+	}
+
+	// Update state values
+	state = convertDomainVariableToScopedEnvironmentVariable(plan, v)
+	tflog.Trace(ctx, "updated environment variable", map[string]interface{}{"environment_variable_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete qovery environment variable resource
+func (r environmentVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ScopedEnvironmentVariable
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete environment variable
+	if err := r.environmentVariableService.Delete(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Id)); err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable delete", err)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted environment variable", map[string]interface{}{"environment_variable_id": state.Id.Value})
+
+	// Remove environment variable from state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery environment variable resource using its scope id and key
+func (r environmentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scopeID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || scopeID == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: scope_id/key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	scope, err := r.environmentVariableService.ResolveScope(ctx, scopeID)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on environment variable import", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_id"), scopeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), scope.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// resolveScope returns the scope set in the plan, or resolves it from the scope id if it is not set.
+func (r environmentVariableResource) resolveScope(ctx context.Context, plan ScopedEnvironmentVariable) (variable.Scope, error) {
+	if !plan.Scope.IsUnknown() && !plan.Scope.IsNull() {
+		return variable.Scope(ToString(plan.Scope)), nil
+	}
+
+	return r.environmentVariableService.ResolveScope(ctx, ToString(plan.ScopeId))
+}
+
+// keyScopeValues returns the scopes a single environment variable or secret can be defined in.
+func keyScopeValues() []string {
+	values := make([]string, 0, len(variable.KeyScopes))
+	for _, scope := range variable.KeyScopes {
+		values = append(values, scope.String())
+	}
+
+	return values
+}
@@ -0,0 +1,35 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+type ScopedEnvironmentVariable struct {
+	Id        types.String `tfsdk:"id"`
+	ScopeId   types.String `tfsdk:"scope_id"`
+	Scope     types.String `tfsdk:"scope"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	MountPath types.String `tfsdk:"mount_path"`
+}
+
+func (v ScopedEnvironmentVariable) toUpsertRequest() variable.UpsertRequest {
+	return variable.UpsertRequest{
+		Key:       ToString(v.Key),
+		Value:     ToString(v.Value),
+		MountPath: ToStringPointer(v.MountPath),
+	}
+}
+
+func convertDomainVariableToScopedEnvironmentVariable(state ScopedEnvironmentVariable, v *variable.Variable) ScopedEnvironmentVariable {
+	return ScopedEnvironmentVariable{
+		Id:        FromString(v.ID.String()),
+		ScopeId:   state.ScopeId,
+		Scope:     FromString(v.Scope.String()),
+		Key:       FromString(v.Key),
+		Value:     FromString(v.Value),
+		MountPath: FromStringPointer(v.MountPath),
+	}
+}
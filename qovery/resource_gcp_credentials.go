@@ -0,0 +1,192 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ resource.ResourceWithConfigure = &gcpCredentialsResource{}
+var _ resource.ResourceWithImportState = gcpCredentialsResource{}
+
+type gcpCredentialsResource struct {
+	gcpCredentialsService credentials.GcpService
+}
+
+func newGcpCredentialsResource() resource.Resource {
+	return &gcpCredentialsResource{}
+}
+
+func (r gcpCredentialsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gcp_credentials"
+}
+
+func (r *gcpCredentialsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.gcpCredentialsService = provider.gcpCredentialsService
+}
+
+func (r gcpCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Provides a Qovery GCP credentials resource. This can be used to create and manage Qovery GCP credentials.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the GCP credentials.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"organization_id": {
+				Description: "Id of the organization.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"name": {
+				Description: "Name of the gcp credentials.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"gcp_credentials": {
+				Description: "Your GCP service account json key.",
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+		},
+	}, nil
+}
+
+// Create qovery gcp credentials resource
+func (r gcpCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan GcpCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new credentials
+	creds, err := r.gcpCredentialsService.Create(ctx, plan.OrganizationId.Value, plan.toUpsertGcpRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on gcp credentials create", err)
+		return
+	}
+
+	// Initialize state values
+	state := convertDomainCredentialsToGcpCredentials(creds, plan)
+	tflog.Trace(ctx, "created gcp credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery gcp credentials resource
+func (r gcpCredentialsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state GcpCredentials
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get credentials from API
+	creds, err := r.gcpCredentialsService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on gcp credentials read", err)
+		return
+	}
+
+	state = convertDomainCredentialsToGcpCredentials(creds, state)
+	tflog.Trace(ctx, "read gcp credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update qovery gcp credentials resource
+func (r gcpCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state GcpCredentials
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update credentials in the backend
+	creds, err := r.gcpCredentialsService.Update(ctx, state.OrganizationId.Value, state.Id.Value, plan.toUpsertGcpRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on gcp credentials update", err)
+		return
+	}
+
+	// Update state values
+	state = convertDomainCredentialsToGcpCredentials(creds, plan)
+	tflog.Trace(ctx, "updated gcp credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete qovery gcp credentials resource
+func (r gcpCredentialsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state GcpCredentials
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete credentials in the backend
+	err := r.gcpCredentialsService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on gcp credentials delete", err)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted gcp credentials", map[string]interface{}{"credentials_id": state.Id.Value})
+
+	// Remove credentials from state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery gcp credentials resource using its id
+func (r gcpCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id,gcp_credentials_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), idParts[0])...)
+}
@@ -0,0 +1,44 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/credentials"
+)
+
+type GcpCredentials struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	GcpCredentials types.String `tfsdk:"gcp_credentials"`
+}
+
+type GcpCredentialsDataSource struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+}
+
+func (creds GcpCredentials) toUpsertGcpRequest() credentials.UpsertGcpRequest {
+	return credentials.UpsertGcpRequest{
+		Name:           ToString(creds.Name),
+		GcpCredentials: ToString(creds.GcpCredentials),
+	}
+}
+
+func convertDomainCredentialsToGcpCredentials(creds *credentials.Credentials, plan GcpCredentials) GcpCredentials {
+	return GcpCredentials{
+		Id:             FromString(creds.ID.String()),
+		OrganizationId: FromString(creds.OrganizationID.String()),
+		Name:           FromString(creds.Name),
+		GcpCredentials: plan.GcpCredentials,
+	}
+}
+
+func convertDomainCredentialsToGcpCredentialsDataSource(creds *credentials.Credentials) GcpCredentialsDataSource {
+	return GcpCredentialsDataSource{
+		Id:             FromString(creds.ID.String()),
+		OrganizationId: FromString(creds.OrganizationID.String()),
+		Name:           FromString(creds.Name),
+	}
+}
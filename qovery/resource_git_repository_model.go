@@ -6,9 +6,12 @@ import (
 )
 
 type GitRepository struct {
-	Url      types.String `tfsdk:"url"`
-	Branch   types.String `tfsdk:"branch"`
-	RootPath types.String `tfsdk:"root_path"`
+	Url              types.String `tfsdk:"url"`
+	Branch           types.String `tfsdk:"branch"`
+	RootPath         types.String `tfsdk:"root_path"`
+	GitTokenId       types.String `tfsdk:"git_token_id"`
+	CommitId         types.String `tfsdk:"commit_id"`
+	DeployedCommitId types.String `tfsdk:"deployed_commit_id"`
 }
 
 func (g GitRepository) toUpsertRequest() git_repository.GitRepository {
@@ -25,8 +28,20 @@ func (g GitRepository) toUpsertRequest() git_repository.GitRepository {
 	}
 
 	return git_repository.GitRepository{
-		Url:      g.Url.String(),
-		Branch:   branch,
-		RootPath: rootPath,
+		Url:        g.Url.String(),
+		Branch:     branch,
+		RootPath:   rootPath,
+		GitTokenID: ToStringPointer(g.GitTokenId),
+		CommitID:   ToStringPointer(g.CommitId),
 	}
 }
+
+// convertDomainDeployedCommitIDToPinnedCommitID returns the commit pinned in the state, replaced by the deployed one if any.
+// This way, a commit deployed outside of terraform shows up as a change and the pinned commit is deployed back on apply.
+func convertDomainDeployedCommitIDToPinnedCommitID(pinnedCommitID types.String, deployedCommitID *string) types.String {
+	if pinnedCommitID.Null || pinnedCommitID.Unknown || deployedCommitID == nil {
+		return pinnedCommitID
+	}
+
+	return FromString(*deployedCommitID)
+}
@@ -0,0 +1,40 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
+)
+
+type GitToken struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Name           types.String `tfsdk:"name"`
+	Type           types.String `tfsdk:"type"`
+	Token          types.String `tfsdk:"token"`
+	Description    types.String `tfsdk:"description"`
+	Workspace      types.String `tfsdk:"workspace"`
+}
+
+func (t GitToken) toUpsertRequest() gittoken.UpsertRequest {
+	return gittoken.UpsertRequest{
+		Name:        ToString(t.Name),
+		Type:        ToString(t.Type),
+		Token:       ToString(t.Token),
+		Description: ToStringPointer(t.Description),
+		Workspace:   ToStringPointer(t.Workspace),
+	}
+}
+
+func convertDomainGitTokenToGitToken(state GitToken, res *gittoken.GitToken) GitToken {
+	return GitToken{
+		Id:             FromString(res.ID.String()),
+		OrganizationId: FromString(res.OrganizationID.String()),
+		Name:           FromString(res.Name),
+		Type:           FromString(res.Type.String()),
+		// NOTE: the token is never returned by the api so the value of the state is kept.
+		Token:       state.Token,
+		Description: FromStringPointer(res.Description),
+		Workspace:   FromStringPointer(res.Workspace),
+	}
+}
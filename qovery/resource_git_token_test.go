@@ -0,0 +1,129 @@
+//go:build integration && !unit
+// +build integration,!unit
+
+package qovery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/pkg/errors"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/apierrors"
+)
+
+func TestAcc_GitToken(t *testing.T) {
+	t.Parallel()
+	testName := "git-token"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccQoveryGitTokenDestroy("qovery_git_token.test"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGitTokenDefaultConfig(
+					testName,
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryGitTokenExists("qovery_git_token.test"),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "organization_id", getTestOrganizationID()),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "name", generateTestName(testName)),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "type", "GITHUB"),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "token", getTestGitHubToken()),
+				),
+			},
+			// Add description
+			{
+				Config: testAccGitTokenDefaultConfigWithDescription(
+					testName,
+					"this is a description",
+				),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccQoveryGitTokenExists("qovery_git_token.test"),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "organization_id", getTestOrganizationID()),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "name", generateTestName(testName)),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "type", "GITHUB"),
+					resource.TestCheckResourceAttr("qovery_git_token.test", "description", "this is a description"),
+				),
+			},
+			// Check Import
+			{
+				ResourceName:            "qovery_git_token.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s,", getTestOrganizationID()),
+				ImportStateVerifyIgnore: []string{"token"},
+			},
+		},
+	})
+}
+
+func testAccQoveryGitTokenExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("git token not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("git_token.id not found")
+		}
+
+		_, err := qoveryServices.GitToken.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccQoveryGitTokenDestroy(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("git token not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("git_token.id not found")
+		}
+
+		_, err := qoveryServices.GitToken.Get(context.TODO(), getTestOrganizationID(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("found git token but expected it to be deleted")
+		}
+		if !apierrors.IsErrNotFound(errors.Cause(err)) {
+			return fmt.Errorf("unexpected error checking for deleted git token: %s", err.Error())
+		}
+		return nil
+	}
+}
+
+func testAccGitTokenDefaultConfig(testName string) string {
+	return fmt.Sprintf(`
+resource "qovery_git_token" "test" {
+  organization_id = "%s"
+  name = "%s"
+  type = "GITHUB"
+  token = "%s"
+}
+`, getTestOrganizationID(), generateTestName(testName), getTestGitHubToken(),
+	)
+}
+
+func testAccGitTokenDefaultConfigWithDescription(testName string, description string) string {
+	return fmt.Sprintf(`
+resource "qovery_git_token" "test" {
+  organization_id = "%s"
+  name = "%s"
+  type = "GITHUB"
+  token = "%s"
+  description = "%s"
+}
+`, getTestOrganizationID(), generateTestName(testName), getTestGitHubToken(), description,
+	)
+}
@@ -66,6 +66,9 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 				Description: "Id of the environment.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the job.",
@@ -319,6 +322,21 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 										Optional:    true,
 										Computed:    true,
 									},
+									"git_token_id": {
+										Description: "Job's docker source git token id used to access the git repository (see `qovery_git_token`). If not set, the git provider app of the organization is used.",
+										Type:        types.StringType,
+										Optional:    true,
+									},
+									"commit_id": {
+										Description: "Job's docker source git repository full sha of the commit to deploy. The commit is deployed each time it changes. If not set, the job is deployed with the last commit of the branch.",
+										Type:        types.StringType,
+										Optional:    true,
+									},
+									"deployed_commit_id": {
+										Description: "Job's docker source git repository sha of the commit currently deployed.",
+										Type:        types.StringType,
+										Computed:    true,
+									},
 								}),
 							},
 						}),
@@ -344,10 +362,15 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
-				Description: "List of environment variables linked to this job.",
+				Description: "List of environment variables linked to this job. The environment variables of the job not listed here (i.e: managed by a `qovery_environment_variable` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -365,10 +388,67 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
+				}),
+			},
+			"environment_variable_aliases": {
+				Description: "List of environment variable aliases linked to this job.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Name of the variable to alias.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+				}),
+			},
+			"environment_variable_overrides": {
+				Description: "List of environment variable overrides linked to this job.",
+				Optional:    true,
+				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+					"id": {
+						Description: "Id of the environment variable.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
+					"key": {
+						Description: "Name of the environment variable to override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"value": {
+						Description: "Value of the environment variable override.",
+						Type:        types.StringType,
+						Required:    true,
+					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"secrets": {
-				Description: "List of secrets linked to this job.",
+				Description: "List of secrets linked to this job. The secrets of the job not listed here (i.e: managed by a `qovery_secret` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -387,6 +467,11 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 						Required:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"external_host": {
@@ -405,6 +490,9 @@ func (r jobResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostic
 				Optional:    true,
 				Computed:    true,
 			},
+			"healthchecks":      healthchecksResourceAttribute("job"),
+			"advanced_settings": advancedSettingsResourceAttribute("job", jobAdvancedSettings),
+			"timeouts":          timeoutsResourceAttribute(defaultServiceTimeout),
 		},
 	}, nil
 }
@@ -418,20 +506,23 @@ func (r jobResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withCreateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Create new job
 	request, err := plan.toUpsertServiceRequest(nil)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job create", err)
 		return
 	}
 	cont, err := r.jobService.Create(ctx, plan.EnvironmentID.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertDomainJobToJob(plan, cont)
+	state := convertDomainJobToJob(plan, cont).keepManaged(plan)
 	tflog.Trace(ctx, "created job", map[string]interface{}{"job_id": state.ID.Value})
 
 	// Set state
@@ -450,12 +541,12 @@ func (r jobResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	// Get job from the API
 	cont, err := r.jobService.Get(ctx, state.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertDomainJobToJob(state, cont)
+	state = convertDomainJobToJob(state, cont).keepManaged(state)
 	tflog.Trace(ctx, "read job", map[string]interface{}{"job_id": state.ID.Value})
 
 	// Set state
@@ -472,20 +563,23 @@ func (r jobResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
+	ctx, cancel := plan.Timeouts.withUpdateTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Update job in the backend
 	request, err := plan.toUpsertServiceRequest(&state)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job create", err)
 		return
 	}
 	cont, err := r.jobService.Update(ctx, state.ID.Value, *request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job update", err)
 		return
 	}
 
 	// Update state values
-	state = convertDomainJobToJob(plan, cont)
+	state = convertDomainJobToJob(plan, cont).keepManaged(plan)
 	tflog.Trace(ctx, "updated job", map[string]interface{}{"job_id": state.ID.Value})
 
 	// Set state
@@ -501,10 +595,13 @@ func (r jobResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
+	ctx, cancel := state.Timeouts.withDeleteTimeout(ctx, defaultServiceTimeout)
+	defer cancel()
+
 	// Delete job
 	err := r.jobService.Delete(ctx, state.ID.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on job delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on job delete", err)
 		return
 	}
 
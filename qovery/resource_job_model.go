@@ -31,14 +31,22 @@ func (s JobSource) toUpsertRequest() job.JobSource {
 	}
 }
 
-func JobSourceFromDomainJobSource(j job.JobSource) JobSource {
+func JobSourceFromDomainJobSource(state *JobSource, j job.JobSource) JobSource {
 	var dkr *Docker = nil
 	if j.Docker != nil {
+		commitID := types.String{Null: true}
+		if state != nil && state.Docker != nil {
+			commitID = convertDomainDeployedCommitIDToPinnedCommitID(state.Docker.GitRepository.CommitId, j.Docker.GitRepository.CommitID)
+		}
+
 		dkr = &Docker{
 			GitRepository: GitRepository{
-				Url:      FromString(j.Docker.GitRepository.Url),
-				Branch:   FromStringPointer(j.Docker.GitRepository.Branch),
-				RootPath: FromStringPointer(j.Docker.GitRepository.RootPath),
+				Url:              FromString(j.Docker.GitRepository.Url),
+				Branch:           FromStringPointer(j.Docker.GitRepository.Branch),
+				RootPath:         FromStringPointer(j.Docker.GitRepository.RootPath),
+				GitTokenId:       FromStringPointer(j.Docker.GitRepository.GitTokenID),
+				CommitId:         commitID,
+				DeployedCommitId: FromStringPointer(j.Docker.GitRepository.CommitID),
 			},
 			DockerFilePath: FromStringPointer(j.Docker.DockerFilePath),
 		}
@@ -216,19 +224,32 @@ type Job struct {
 	Source   *JobSource   `tfsdk:"source"`
 	Schedule *JobSchedule `tfsdk:"schedule"`
 
-	BuiltInEnvironmentVariables types.Set    `tfsdk:"built_in_environment_variables"`
-	EnvironmentVariables        types.Set    `tfsdk:"environment_variables"`
-	Secrets                     types.Set    `tfsdk:"secrets"`
-	Port                        types.Int64  `tfsdk:"port"`
-	ExternalHost                types.String `tfsdk:"external_host"`
-	InternalHost                types.String `tfsdk:"internal_host"`
-	DeploymentStageId           types.String `tfsdk:"deployment_stage_id"`
+	BuiltInEnvironmentVariables  types.Set     `tfsdk:"built_in_environment_variables"`
+	EnvironmentVariables         types.Set     `tfsdk:"environment_variables"`
+	EnvironmentVariableAliases   types.Set     `tfsdk:"environment_variable_aliases"`
+	EnvironmentVariableOverrides types.Set     `tfsdk:"environment_variable_overrides"`
+	Secrets                      types.Set     `tfsdk:"secrets"`
+	Port                         types.Int64   `tfsdk:"port"`
+	Healthchecks                 *Healthchecks `tfsdk:"healthchecks"`
+	AdvancedSettings             types.Object  `tfsdk:"advanced_settings"`
+	ExternalHost                 types.String  `tfsdk:"external_host"`
+	InternalHost                 types.String  `tfsdk:"internal_host"`
+	DeploymentStageId            types.String  `tfsdk:"deployment_stage_id"`
+	Timeouts                     *Timeouts     `tfsdk:"timeouts"`
 }
 
 func (j Job) EnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(j.EnvironmentVariables)
 }
 
+func (j Job) EnvironmentVariableAliasList() EnvironmentVariableList {
+	return toEnvironmentVariableList(j.EnvironmentVariableAliases)
+}
+
+func (j Job) EnvironmentVariableOverrideList() EnvironmentVariableList {
+	return toEnvironmentVariableList(j.EnvironmentVariableOverrides)
+}
+
 func (j Job) BuiltInEnvironmentVariableList() EnvironmentVariableList {
 	return toEnvironmentVariableList(j.BuiltInEnvironmentVariables)
 }
@@ -237,20 +258,49 @@ func (j Job) SecretList() SecretList {
 	return toSecretList(j.Secrets)
 }
 
+// keepManaged returns the job with only the environment variables and secrets managed in the given plan or state,
+// so the ones managed by the `qovery_environment_variable` and `qovery_secret` resources are neither shown as a drift nor deleted.
+// Everything is kept on import, as the managed ones aren't known yet.
+func (j Job) keepManaged(managed Job) Job {
+	if managed.EnvironmentID.Null {
+		return j
+	}
+
+	j.EnvironmentVariables = j.EnvironmentVariableList().managed(managed.EnvironmentVariableList()).toTerraformSet()
+	j.EnvironmentVariableAliases = j.EnvironmentVariableAliasList().managed(managed.EnvironmentVariableAliasList()).toTerraformSet()
+	j.EnvironmentVariableOverrides = j.EnvironmentVariableOverrideList().managed(managed.EnvironmentVariableOverrideList()).toTerraformSet()
+	j.Secrets = j.SecretList().managed(managed.SecretList()).toTerraformSet()
+	return j
+}
+
 func (j Job) toUpsertServiceRequest(state *Job) (*job.UpsertServiceRequest, error) {
-	var stateEnvironmentVariables EnvironmentVariableList
+	var stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides EnvironmentVariableList
 	if state != nil {
 		stateEnvironmentVariables = state.EnvironmentVariableList()
+		stateEnvironmentVariableAliases = state.EnvironmentVariableAliasList()
+		stateEnvironmentVariableOverrides = state.EnvironmentVariableOverrideList()
 	}
 
+	environmentVariables := newEnvironmentVariablesDiffRequest(
+		j.EnvironmentVariableList(), j.EnvironmentVariableAliasList(), j.EnvironmentVariableOverrideList(),
+		stateEnvironmentVariables, stateEnvironmentVariableAliases, stateEnvironmentVariableOverrides,
+	)
+
 	var stateSecrets SecretList
 	if state != nil {
 		stateSecrets = state.SecretList()
 	}
 
+	advancedSettings, err := toAdvancedSettings(j.AdvancedSettings)
+	if err != nil {
+		return nil, err
+	}
+	repositoryRequest := j.toUpsertRepositoryRequest()
+	repositoryRequest.AdvancedSettings = advancedSettings
+
 	return &job.UpsertServiceRequest{
-		JobUpsertRequest:     j.toUpsertRepositoryRequest(),
-		EnvironmentVariables: j.EnvironmentVariableList().diffRequest(stateEnvironmentVariables),
+		JobUpsertRequest:     repositoryRequest,
+		EnvironmentVariables: environmentVariables,
 		Secrets:              j.SecretList().diffRequest(stateSecrets),
 	}, nil
 }
@@ -265,6 +315,7 @@ func (j Job) toUpsertRepositoryRequest() job.UpsertRepositoryRequest {
 		MaxDurationSeconds: ToInt32Pointer(j.MaxDurationSeconds),
 		DeploymentStageID:  ToString(j.DeploymentStageId),
 		Port:               ToInt64Pointer(j.Port),
+		Healthchecks:       j.Healthchecks.toUpsertRequest(),
 
 		Source:   j.Source.toUpsertRequest(),
 		Schedule: j.Schedule.toUpsertRequest(),
@@ -277,26 +328,31 @@ func convertDomainJobToJob(state Job, job *job.Job) Job {
 		prt = &job.Port.InternalPort
 	}
 
-	source := JobSourceFromDomainJobSource(job.Source)
+	source := JobSourceFromDomainJobSource(state.Source, job.Source)
 	schedule := JobScheduleFromDomainJobSchedule(job.Schedule)
 
 	return Job{
-		ID:                          FromString(job.ID.String()),
-		EnvironmentID:               FromString(job.EnvironmentID.String()),
-		Name:                        FromString(job.Name),
-		CPU:                         FromInt32(job.CPU),
-		Memory:                      FromInt32(job.Memory),
-		MaxNbRestart:                FromUInt32(job.MaxNbRestart),
-		MaxDurationSeconds:          FromUInt32(job.MaxDurationSeconds),
-		AutoPreview:                 FromBool(job.AutoPreview),
-		Port:                        FromInt32Pointer(prt),
-		Source:                      &source,
-		Schedule:                    &schedule,
-		EnvironmentVariables:        convertDomainVariablesToEnvironmentVariableList(job.EnvironmentVariables, variable.ScopeJob).toTerraformSet(),
-		BuiltInEnvironmentVariables: convertDomainVariablesToEnvironmentVariableList(job.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
-		InternalHost:                FromStringPointer(job.InternalHost),
-		ExternalHost:                FromStringPointer(job.ExternalHost),
-		Secrets:                     convertDomainSecretsToSecretList(state.SecretList(), job.Secrets, variable.ScopeJob).toTerraformSet(),
-		DeploymentStageId:           FromString(job.DeploymentStageID),
+		ID:                           FromString(job.ID.String()),
+		EnvironmentID:                FromString(job.EnvironmentID.String()),
+		Name:                         FromString(job.Name),
+		CPU:                          FromInt32(job.CPU),
+		Memory:                       FromInt32(job.Memory),
+		MaxNbRestart:                 FromUInt32(job.MaxNbRestart),
+		MaxDurationSeconds:           FromUInt32(job.MaxDurationSeconds),
+		AutoPreview:                  FromBool(job.AutoPreview),
+		Port:                         FromInt32Pointer(prt),
+		Healthchecks:                 convertDomainHealthchecksToHealthchecks(job.Healthchecks),
+		AdvancedSettings:             fromAdvancedSettings(job.AdvancedSettings, jobAdvancedSettings),
+		Source:                       &source,
+		Schedule:                     &schedule,
+		EnvironmentVariables:         convertDomainVariablesToEnvironmentVariableList(job.EnvironmentVariables, variable.ScopeJob).toTerraformSet(),
+		EnvironmentVariableAliases:   convertDomainVariablesToEnvironmentVariableAliasList(job.EnvironmentVariables, variable.ScopeJob).toTerraformSet(),
+		EnvironmentVariableOverrides: convertDomainVariablesToEnvironmentVariableOverrideList(job.EnvironmentVariables, variable.ScopeJob).toTerraformSet(),
+		BuiltInEnvironmentVariables:  convertDomainVariablesToEnvironmentVariableList(job.BuiltInEnvironmentVariables, variable.ScopeBuiltIn).toTerraformSet(),
+		InternalHost:                 FromStringPointer(job.InternalHost),
+		ExternalHost:                 FromStringPointer(job.ExternalHost),
+		Secrets:                      convertDomainSecretsToSecretList(state.SecretList(), job.Secrets, variable.ScopeJob).toTerraformSet(),
+		DeploymentStageId:            FromString(job.DeploymentStageID),
+		Timeouts:                     state.Timeouts,
 	}
 }
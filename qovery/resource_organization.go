@@ -105,7 +105,7 @@ func (r organizationResource) Read(ctx context.Context, req resource.ReadRequest
 	// Get organization from API
 	orga, err := r.organizationService.Get(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on organization read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on organization read", err)
 		return
 	}
 
@@ -130,7 +130,7 @@ func (r organizationResource) Update(ctx context.Context, req resource.UpdateReq
 	// Update organization in backend
 	orga, err := r.organizationService.Update(ctx, state.Id.Value, plan.toOrganizationUpdateRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on organization update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on organization update", err)
 		return
 	}
 
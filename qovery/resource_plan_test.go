@@ -0,0 +1,428 @@
+package qovery_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/qovery"
+)
+
+// planValues holds the values of the top level string attributes of a resource, the other attributes are null.
+type planValues map[string]string
+
+// TestResourcePlan_RequiresReplace asserts the shape of the plan when an attribute of an existing resource is changed:
+// immutable attributes must plan a destroy / create while the other ones must plan an in-place update.
+func TestResourcePlan_RequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	environmentID := gofakeit.UUID()
+	organizationID := gofakeit.UUID()
+
+	testCases := []struct {
+		TestName        string
+		ResourceType    string
+		State           planValues
+		Changes         planValues
+		ExpectedReplace []string
+	}{
+		{
+			TestName:        "application_environment_id",
+			ResourceType:    "qovery_application",
+			State:           planValues{"environment_id": environmentID, "name": "app"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:     "application_name",
+			ResourceType: "qovery_application",
+			State:        planValues{"environment_id": environmentID, "name": "app"},
+			Changes:      planValues{"name": "new-app"},
+		},
+		{
+			TestName:        "container_environment_id",
+			ResourceType:    "qovery_container",
+			State:           planValues{"environment_id": environmentID, "name": "container", "registry_id": gofakeit.UUID(), "image_name": "nginx", "tag": "latest"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:     "container_tag",
+			ResourceType: "qovery_container",
+			State:        planValues{"environment_id": environmentID, "name": "container", "registry_id": gofakeit.UUID(), "image_name": "nginx", "tag": "latest"},
+			Changes:      planValues{"tag": "stable"},
+		},
+		{
+			TestName:        "job_environment_id",
+			ResourceType:    "qovery_job",
+			State:           planValues{"environment_id": environmentID, "name": "job"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:        "database_environment_id",
+			ResourceType:    "qovery_database",
+			State:           planValues{"environment_id": environmentID, "name": "db", "type": "POSTGRESQL", "version": "13", "mode": "CONTAINER"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:        "database_type_and_mode",
+			ResourceType:    "qovery_database",
+			State:           planValues{"environment_id": environmentID, "name": "db", "type": "POSTGRESQL", "version": "13", "mode": "CONTAINER"},
+			Changes:         planValues{"type": "MYSQL", "mode": "MANAGED"},
+			ExpectedReplace: []string{"mode", "type"},
+		},
+		{
+			TestName:     "database_version",
+			ResourceType: "qovery_database",
+			State:        planValues{"environment_id": environmentID, "name": "db", "type": "POSTGRESQL", "version": "13", "mode": "CONTAINER"},
+			Changes:      planValues{"version": "14"},
+		},
+		{
+			TestName:        "environment_project_id_and_cluster_id",
+			ResourceType:    "qovery_environment",
+			State:           planValues{"project_id": gofakeit.UUID(), "cluster_id": gofakeit.UUID(), "name": "env", "mode": "DEVELOPMENT"},
+			Changes:         planValues{"project_id": gofakeit.UUID(), "cluster_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"cluster_id", "project_id"},
+		},
+		{
+			TestName:     "environment_mode",
+			ResourceType: "qovery_environment",
+			State:        planValues{"project_id": gofakeit.UUID(), "cluster_id": gofakeit.UUID(), "name": "env", "mode": "DEVELOPMENT"},
+			Changes:      planValues{"mode": "PRODUCTION"},
+		},
+		{
+			TestName:     "environment_name",
+			ResourceType: "qovery_environment",
+			State:        planValues{"project_id": gofakeit.UUID(), "cluster_id": gofakeit.UUID(), "name": "env", "mode": "DEVELOPMENT"},
+			Changes:      planValues{"name": "new-env"},
+		},
+		{
+			TestName:        "cluster_cloud_provider_and_region",
+			ResourceType:    "qovery_cluster",
+			State:           planValues{"organization_id": organizationID, "credentials_id": gofakeit.UUID(), "name": "cluster", "cloud_provider": "AWS", "region": "eu-west-3", "kubernetes_mode": "MANAGED", "state": "DEPLOYED"},
+			Changes:         planValues{"cloud_provider": "SCW", "region": "fr-par-1"},
+			ExpectedReplace: []string{"cloud_provider", "region"},
+		},
+		{
+			TestName:        "cluster_organization_id_and_kubernetes_mode",
+			ResourceType:    "qovery_cluster",
+			State:           planValues{"organization_id": organizationID, "credentials_id": gofakeit.UUID(), "name": "cluster", "cloud_provider": "AWS", "region": "eu-west-3", "kubernetes_mode": "MANAGED", "state": "DEPLOYED"},
+			Changes:         planValues{"organization_id": gofakeit.UUID(), "kubernetes_mode": "K3S"},
+			ExpectedReplace: []string{"kubernetes_mode", "organization_id"},
+		},
+		{
+			TestName:     "cluster_credentials_id",
+			ResourceType: "qovery_cluster",
+			State:        planValues{"organization_id": organizationID, "credentials_id": gofakeit.UUID(), "name": "cluster", "cloud_provider": "AWS", "region": "eu-west-3", "kubernetes_mode": "MANAGED", "state": "DEPLOYED"},
+			Changes:      planValues{"credentials_id": gofakeit.UUID()},
+		},
+		{
+			TestName:        "project_organization_id",
+			ResourceType:    "qovery_project",
+			State:           planValues{"organization_id": organizationID, "name": "project"},
+			Changes:         planValues{"organization_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"organization_id"},
+		},
+		{
+			TestName:        "aws_credentials_organization_id",
+			ResourceType:    "qovery_aws_credentials",
+			State:           planValues{"organization_id": organizationID, "name": "credentials", "access_key_id": "key", "secret_access_key": "secret"},
+			Changes:         planValues{"organization_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"organization_id"},
+		},
+		{
+			TestName:        "scaleway_credentials_organization_id",
+			ResourceType:    "qovery_scaleway_credentials",
+			State:           planValues{"organization_id": organizationID, "name": "credentials", "scaleway_access_key": "key", "scaleway_secret_key": "secret", "scaleway_project_id": gofakeit.UUID()},
+			Changes:         planValues{"organization_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"organization_id"},
+		},
+		{
+			TestName:        "container_registry_organization_id",
+			ResourceType:    "qovery_container_registry",
+			State:           planValues{"organization_id": organizationID, "name": "registry", "kind": "DOCKER_HUB", "url": "https://docker.io"},
+			Changes:         planValues{"organization_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"organization_id"},
+		},
+		{
+			TestName:        "deployment_stage_environment_id",
+			ResourceType:    "qovery_deployment_stage",
+			State:           planValues{"environment_id": environmentID, "name": "stage"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:        "deployment_environment_id",
+			ResourceType:    "qovery_deployment",
+			State:           planValues{"environment_id": environmentID, "desired_state": "RUNNING"},
+			Changes:         planValues{"environment_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"environment_id"},
+		},
+		{
+			TestName:        "custom_domain_domain",
+			ResourceType:    "qovery_custom_domain",
+			State:           planValues{"service_id": gofakeit.UUID(), "service_type": "APPLICATION", "domain": "app.example.com"},
+			Changes:         planValues{"domain": "new-app.example.com"},
+			ExpectedReplace: []string{"domain"},
+		},
+		{
+			TestName:        "custom_domain_service_id_and_service_type",
+			ResourceType:    "qovery_custom_domain",
+			State:           planValues{"service_id": gofakeit.UUID(), "service_type": "APPLICATION", "domain": "app.example.com"},
+			Changes:         planValues{"service_id": gofakeit.UUID(), "service_type": "CONTAINER"},
+			ExpectedReplace: []string{"service_id", "service_type"},
+		},
+		{
+			TestName:        "environment_variable_scope_id",
+			ResourceType:    "qovery_environment_variable",
+			State:           planValues{"scope_id": environmentID, "key": "MY_KEY", "value": "value"},
+			Changes:         planValues{"scope_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"scope_id"},
+		},
+		{
+			TestName:     "environment_variable_key_and_value",
+			ResourceType: "qovery_environment_variable",
+			State:        planValues{"scope_id": environmentID, "key": "MY_KEY", "value": "value"},
+			Changes:      planValues{"key": "MY_NEW_KEY", "value": "new-value"},
+		},
+		{
+			TestName:        "secret_scope_id",
+			ResourceType:    "qovery_secret",
+			State:           planValues{"scope_id": environmentID, "key": "MY_SECRET", "value": "value"},
+			Changes:         planValues{"scope_id": gofakeit.UUID()},
+			ExpectedReplace: []string{"scope_id"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			config := planValues{}
+			for k, v := range tc.State {
+				config[k] = v
+			}
+			for k, v := range tc.Changes {
+				config[k] = v
+			}
+
+			assert.ElementsMatch(t, tc.ExpectedReplace, planRequiresReplace(t, tc.ResourceType, tc.State, config))
+		})
+	}
+}
+
+// TestResourceValidateConfig_AWSCredentials asserts that exactly one of the static keys or role modes must be configured on the aws credentials.
+func TestResourceValidateConfig_AWSCredentials(t *testing.T) {
+	t.Parallel()
+
+	organizationID := gofakeit.UUID()
+	roleArn := "arn:aws:iam::123456789012:role/qovery"
+
+	testCases := []struct {
+		TestName      string
+		Config        planValues
+		ExpectedError bool
+	}{
+		{
+			TestName: "success_with_static_keys",
+			Config:   planValues{"organization_id": organizationID, "name": "creds", "access_key_id": gofakeit.Word(), "secret_access_key": gofakeit.Word()},
+		},
+		{
+			TestName: "success_with_role",
+			Config:   planValues{"organization_id": organizationID, "name": "creds", "role_arn": roleArn, "external_id": gofakeit.UUID()},
+		},
+		{
+			TestName:      "fail_without_static_keys_nor_role",
+			Config:        planValues{"organization_id": organizationID, "name": "creds"},
+			ExpectedError: true,
+		},
+		{
+			TestName:      "fail_with_static_keys_and_role",
+			Config:        planValues{"organization_id": organizationID, "name": "creds", "access_key_id": gofakeit.Word(), "secret_access_key": gofakeit.Word(), "role_arn": roleArn, "external_id": gofakeit.UUID()},
+			ExpectedError: true,
+		},
+		{
+			TestName:      "fail_with_partial_static_keys",
+			Config:        planValues{"organization_id": organizationID, "name": "creds", "access_key_id": gofakeit.Word()},
+			ExpectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := validateResourceConfig(t, "qovery_aws_credentials", tc.Config)
+			assert.Equal(t, tc.ExpectedError, hasErrorDiagnostic(diagnostics), "%v", diagnostics)
+		})
+	}
+}
+
+// TestResourceValidateConfig_ClusterCloudProvider asserts the cloud providers accepted by the cluster.
+func TestResourceValidateConfig_ClusterCloudProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		CloudProvider string
+		Region        string
+		ExpectedError bool
+	}{
+		{TestName: "success_with_aws", CloudProvider: "AWS", Region: "eu-west-3"},
+		{TestName: "success_with_scaleway", CloudProvider: "SCW", Region: "fr-par"},
+		{TestName: "success_with_gcp", CloudProvider: "GCP", Region: "europe-west9"},
+		{TestName: "fail_with_unknown_cloud_provider", CloudProvider: "AZURE", Region: "westeurope", ExpectedError: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			diagnostics := validateResourceConfig(t, "qovery_cluster", planValues{
+				"organization_id": gofakeit.UUID(),
+				"credentials_id":  gofakeit.UUID(),
+				"name":            "cluster",
+				"cloud_provider":  tc.CloudProvider,
+				"region":          tc.Region,
+				"instance_type":   "instance",
+			})
+			assert.Equal(t, tc.ExpectedError, hasErrorDiagnostic(diagnostics), "%v", diagnostics)
+		})
+	}
+}
+
+// validateResourceConfig validates the given config of the given resource type and returns the resulting diagnostics.
+func validateResourceConfig(t *testing.T, resourceType string, config planValues) []*tfprotov6.Diagnostic {
+	ctx := context.Background()
+
+	schema := getResourceSchema(t, resourceType)
+	objectType, ok := schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	server := providerserver.NewProtocol6(qovery.New("test")())()
+	_, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	resp, err := server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: resourceType,
+		Config:   newDynamicValue(t, objectType, config),
+	})
+	require.NoError(t, err)
+
+	return resp.Diagnostics
+}
+
+// hasErrorDiagnostic returns true if one of the given diagnostics is an error.
+func hasErrorDiagnostic(diagnostics []*tfprotov6.Diagnostic) bool {
+	for _, d := range diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// planRequiresReplace plans the update of an existing resource from the given state to the given config
+// and returns the attributes that require the resource to be replaced.
+func planRequiresReplace(t *testing.T, resourceType string, state planValues, config planValues) []string {
+	ctx := context.Background()
+
+	schema := getResourceSchema(t, resourceType)
+	objectType, ok := schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+
+	// The resource exists so its computed id is known.
+	stateWithID := planValues{"id": gofakeit.UUID()}
+	for k, v := range state {
+		stateWithID[k] = v
+	}
+
+	// The proposed new state keeps the state values of the computed attributes that are not configured, as terraform does.
+	proposed := planValues{}
+	for k, v := range config {
+		proposed[k] = v
+	}
+	for k, v := range stateWithID {
+		if _, ok := config[k]; !ok && schema.Attributes[k].Computed {
+			proposed[k] = v
+		}
+	}
+
+	// The provider schema is retrieved first, as terraform does, so that the server knows the provider type name.
+	server := providerserver.NewProtocol6(qovery.New("test")())()
+	_, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+
+	resp, err := server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         resourceType,
+		PriorState:       newDynamicValue(t, objectType, stateWithID),
+		ProposedNewState: newDynamicValue(t, objectType, proposed),
+		Config:           newDynamicValue(t, objectType, config),
+	})
+	require.NoError(t, err)
+	for _, d := range resp.Diagnostics {
+		require.NotEqual(t, tfprotov6.DiagnosticSeverityError, d.Severity, "%s: %s", d.Summary, d.Detail)
+	}
+
+	attributes := make([]string, 0, len(resp.RequiresReplace))
+	for _, p := range resp.RequiresReplace {
+		name, ok := p.LastStep().(tftypes.AttributeName)
+		require.True(t, ok)
+		attributes = append(attributes, string(name))
+	}
+
+	return attributes
+}
+
+// getResourceSchema returns the schema of the given resource type.
+func getResourceSchema(t *testing.T, resourceType string) tfsdk.Schema {
+	ctx := context.Background()
+
+	p := qovery.New("test")()
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+
+		metadata := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "qovery"}, metadata)
+		if metadata.TypeName != resourceType {
+			continue
+		}
+
+		schema, diags := r.GetSchema(ctx)
+		require.False(t, diags.HasError())
+		return schema
+	}
+
+	require.FailNow(t, "unknown resource type", resourceType)
+	return tfsdk.Schema{}
+}
+
+// newDynamicValue returns the given values as a DynamicValue of the given object type, the missing attributes are null.
+func newDynamicValue(t *testing.T, objectType tftypes.Object, values planValues) *tfprotov6.DynamicValue {
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		if v, ok := values[name]; ok {
+			attributes[name] = tftypes.NewValue(tftypes.String, v)
+			continue
+		}
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	require.NoError(t, err)
+
+	return &value
+}
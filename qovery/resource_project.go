@@ -61,6 +61,9 @@ func (r projectResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 				Description: "Id of the organization.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the project.",
@@ -92,10 +95,15 @@ func (r projectResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 						Type:        types.StringType,
 						Computed:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file, if it is a file.",
+						Type:        types.StringType,
+						Computed:    true,
+					},
 				}),
 			},
 			"environment_variables": {
-				Description: "List of environment variables linked to this project.",
+				Description: "List of environment variables linked to this project. The environment variables of the project not listed here (i.e: managed by a `qovery_environment_variable` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -113,10 +121,15 @@ func (r projectResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 						Type:        types.StringType,
 						Required:    true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the environment variable is mounted as a file. The environment variable is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 			"secrets": {
-				Description: "List of secrets linked to this project.",
+				Description: "List of secrets linked to this project. The secrets of the project not listed here (i.e: managed by a `qovery_secret` resource) are left untouched.",
 				Optional:    true,
 				Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
 					"id": {
@@ -135,6 +148,11 @@ func (r projectResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagno
 						Required:    true,
 						Sensitive:   true,
 					},
+					"mount_path": {
+						Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set.",
+						Type:        types.StringType,
+						Optional:    true,
+					},
 				}),
 			},
 		},
@@ -154,12 +172,12 @@ func (r projectResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	proj, err := r.projectService.Create(ctx, plan.OrganizationId.Value, plan.toCreateServiceRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on project create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on project create", err)
 		return
 	}
 
 	// Initialize state values
-	state := convertDomainProjectToProject(plan, proj)
+	state := convertDomainProjectToProject(plan, proj).keepManaged(plan)
 	tflog.Trace(ctx, "created project", map[string]interface{}{"project_id": state.Id.Value})
 
 	// Set state
@@ -178,12 +196,12 @@ func (r projectResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// Get project from the API
 	proj, err := r.projectService.Get(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on project read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on project read", err)
 		return
 	}
 
 	// Refresh state values
-	state = convertDomainProjectToProject(state, proj)
+	state = convertDomainProjectToProject(state, proj).keepManaged(state)
 	tflog.Trace(ctx, "read project", map[string]interface{}{"project_id": state.Id.Value})
 
 	// Set state
@@ -203,12 +221,12 @@ func (r projectResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// Update project in the backend
 	proj, err := r.projectService.Update(ctx, state.Id.Value, plan.toUpdateServiceRequest(state))
 	if err != nil {
-		resp.Diagnostics.AddError("Error on project update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on project update", err)
 		return
 	}
 
 	// Update state values
-	state = convertDomainProjectToProject(plan, proj)
+	state = convertDomainProjectToProject(plan, proj).keepManaged(plan)
 	tflog.Trace(ctx, "updated project", map[string]interface{}{"project_id": state.Id.Value})
 
 	// Set state
@@ -227,7 +245,7 @@ func (r projectResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	// Delete project
 	err := r.projectService.Delete(ctx, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on project delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on project delete", err)
 		return
 	}
 
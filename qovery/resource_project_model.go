@@ -29,6 +29,19 @@ func (p Project) SecretList() SecretList {
 	return toSecretList(p.Secrets)
 }
 
+// keepManaged returns the project with only the environment variables and secrets managed in the given plan or state,
+// so the ones managed by the `qovery_environment_variable` and `qovery_secret` resources are neither shown as a drift nor deleted.
+// Everything is kept on import, as the managed ones aren't known yet.
+func (p Project) keepManaged(managed Project) Project {
+	if managed.OrganizationId.Null {
+		return p
+	}
+
+	p.EnvironmentVariables = p.EnvironmentVariableList().managed(managed.EnvironmentVariableList()).toTerraformSet()
+	p.Secrets = p.SecretList().managed(managed.SecretList()).toTerraformSet()
+	return p
+}
+
 func (p Project) toCreateServiceRequest() project.UpsertServiceRequest {
 	return project.UpsertServiceRequest{
 		ProjectUpsertRequest: project.UpsertRepositoryRequest{
@@ -0,0 +1,75 @@
+package qovery
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/project"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+)
+
+// TestProject_KeepManaged ensures the environment variables and secrets managed by the `qovery_environment_variable` and `qovery_secret` resources
+// survive an update of the project owning them.
+func TestProject_KeepManaged(t *testing.T) {
+	t.Parallel()
+
+	ownedVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeProject, Key: "OWNED", Value: "owned", Type: variable.TypeValue}
+	standaloneVariable := variable.Variable{ID: uuid.New(), Scope: variable.ScopeProject, Key: "STANDALONE", Value: "standalone", Type: variable.TypeValue}
+	ownedSecret := secret.Secret{ID: uuid.New(), Scope: variable.ScopeProject, Key: "OWNED_SECRET", Type: variable.TypeValue}
+	standaloneSecret := secret.Secret{ID: uuid.New(), Scope: variable.ScopeProject, Key: "STANDALONE_SECRET", Type: variable.TypeValue}
+
+	proj := &project.Project{
+		ID:                   uuid.New(),
+		OrganizationID:       uuid.New(),
+		Name:                 "project",
+		EnvironmentVariables: variable.Variables{ownedVariable, standaloneVariable},
+		Secrets:              secret.Secrets{ownedSecret, standaloneSecret},
+	}
+
+	plan := Project{
+		OrganizationId: FromString(proj.OrganizationID.String()),
+		Name:           FromString(proj.Name),
+		EnvironmentVariables: EnvironmentVariableList{
+			{Key: FromString(ownedVariable.Key), Value: FromString(ownedVariable.Value), MountPath: FromStringPointer(nil)},
+		}.toTerraformSet(),
+		Secrets: SecretList{
+			{Key: FromString(ownedSecret.Key), Value: FromString("owned"), MountPath: FromStringPointer(nil)},
+		}.toTerraformSet(),
+	}
+
+	// Refreshing the state only keeps the keys owned by the project.
+	state := convertDomainProjectToProject(plan, proj).keepManaged(plan)
+	require.Len(t, state.EnvironmentVariableList(), 1)
+	assert.NotNil(t, state.EnvironmentVariableList().find(ownedVariable.Key))
+	require.Len(t, state.SecretList(), 1)
+	assert.NotNil(t, state.SecretList().find(ownedSecret.Key))
+
+	// Updating the project doesn't touch the keys it doesn't own.
+	updatedPlan := plan
+	updatedPlan.EnvironmentVariables = EnvironmentVariableList{
+		{Key: FromString(ownedVariable.Key), Value: FromString("updated"), MountPath: FromStringPointer(nil)},
+	}.toTerraformSet()
+	updatedPlan.Secrets = SecretList{
+		{Key: FromString(ownedSecret.Key), Value: FromString("updated"), MountPath: FromStringPointer(nil)},
+	}.toTerraformSet()
+
+	request := updatedPlan.toUpdateServiceRequest(state)
+	assert.Empty(t, request.EnvironmentVariables.Create)
+	assert.Empty(t, request.EnvironmentVariables.Delete)
+	require.Len(t, request.EnvironmentVariables.Update, 1)
+	assert.Equal(t, ownedVariable.ID.String(), request.EnvironmentVariables.Update[0].VariableID)
+	assert.Empty(t, request.Secrets.Create)
+	assert.Empty(t, request.Secrets.Delete)
+	require.Len(t, request.Secrets.Update, 1)
+	assert.Equal(t, ownedSecret.ID.String(), request.Secrets.Update[0].SecretID)
+
+	// Both keys are kept on import, when nothing is managed yet.
+	imported := convertDomainProjectToProject(Project{}, proj).keepManaged(Project{OrganizationId: types.String{Null: true}})
+	assert.Len(t, imported.EnvironmentVariableList(), 2)
+	assert.Len(t, imported.SecretList(), 2)
+}
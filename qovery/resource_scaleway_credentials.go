@@ -62,6 +62,9 @@ func (r scalewayCredentialsResource) GetSchema(_ context.Context) (tfsdk.Schema,
 				Description: "Id of the organization.",
 				Type:        types.StringType,
 				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
 			},
 			"name": {
 				Description: "Name of the scaleway credentials.",
@@ -102,7 +105,7 @@ func (r scalewayCredentialsResource) Create(ctx context.Context, req resource.Cr
 	// Create new credentials
 	creds, err := r.scalewayCredentialsService.Create(ctx, plan.OrganizationId.Value, plan.toUpsertScalewayRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on scaleway credentials create", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on scaleway credentials create", err)
 		return
 	}
 
@@ -126,7 +129,7 @@ func (r scalewayCredentialsResource) Read(ctx context.Context, req resource.Read
 	// Get credentials from API
 	creds, err := r.scalewayCredentialsService.Get(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on scaleway credentials read", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on scaleway credentials read", err)
 		return
 	}
 
@@ -150,7 +153,7 @@ func (r scalewayCredentialsResource) Update(ctx context.Context, req resource.Up
 	// Update credentials in the backend
 	creds, err := r.scalewayCredentialsService.Update(ctx, state.OrganizationId.Value, state.Id.Value, plan.toUpsertScalewayRequest())
 	if err != nil {
-		resp.Diagnostics.AddError("Error on scaleway credentials update", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on scaleway credentials update", err)
 		return
 	}
 
@@ -174,7 +177,7 @@ func (r scalewayCredentialsResource) Delete(ctx context.Context, req resource.De
 	// Delete credentials in the backend
 	err := r.scalewayCredentialsService.Delete(ctx, state.OrganizationId.Value, state.Id.Value)
 	if err != nil {
-		resp.Diagnostics.AddError("Error on scaleway credentials delete", err.Error())
+		addErrorDiagnostic(&resp.Diagnostics, "Error on scaleway credentials delete", err)
 		return
 	}
 
@@ -0,0 +1,244 @@
+package qovery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+// Ensure provider defined types fully satisfy terraform framework interfaces.
+var _ resource.ResourceWithConfigure = &secretResource{}
+var _ resource.ResourceWithImportState = secretResource{}
+
+type secretResource struct {
+	secretService secret.KeyService
+}
+
+func newSecretResource() resource.Resource {
+	return &secretResource{}
+}
+
+func (r secretResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *secretResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	provider, ok := req.ProviderData.(*qProvider)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *qProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.secretService = provider.secretService
+}
+
+func (r secretResource) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Description: "Provides a Qovery secret resource. This can be used to create and manage a single secret of a Qovery project, environment, application, container or job.\n" +
+			"NOTE: this resource can be used along with the `secrets` attribute of the resource owning the scope, as long as each secret key is only managed once.",
+		Attributes: map[string]tfsdk.Attribute{
+			"id": {
+				Description: "Id of the secret.",
+				Type:        types.StringType,
+				Computed:    true,
+			},
+			"scope_id": {
+				Description: "Id of the project, environment, application, container or job owning the secret.",
+				Type:        types.StringType,
+				Required:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+			"scope": {
+				Description: descriptions.NewStringEnumDescription(
+					"Scope of the secret. Resolved once from the `scope_id` if not set.",
+					keyScopeValues(),
+					nil,
+				),
+				Type:     types.StringType,
+				Optional: true,
+				Computed: true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.UseStateForUnknown(),
+					resource.RequiresReplace(),
+				},
+				Validators: []tfsdk.AttributeValidator{
+					validators.NewStringEnumValidator(keyScopeValues()),
+				},
+			},
+			"key": {
+				Description: "Key of the secret.",
+				Type:        types.StringType,
+				Required:    true,
+			},
+			"value": {
+				Description: "Value of the secret.",
+				Type:        types.StringType,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"mount_path": {
+				Description: "Absolute path where the secret is mounted as a file. The secret is not a file if not set. The mount path of a secret is not returned by the API, so it is not imported.",
+				Type:        types.StringType,
+				Optional:    true,
+				PlanModifiers: tfsdk.AttributePlanModifiers{
+					resource.RequiresReplace(),
+				},
+			},
+		},
+	}, nil
+}
+
+// Create qovery secret resource
+func (r secretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan ScopedSecret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the scope once if it is not set, it is then kept in the state
+	scope, err := r.resolveScope(ctx, plan)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret create", err)
+		return
+	}
+
+	// Create new secret
+	s, err := r.secretService.Create(ctx, scope, ToString(plan.ScopeId), plan.toUpsertRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret create", err)
+		return
+	}
+
+	// Initialize state values
+	state := convertDomainSecretToScopedSecret(plan, s)
+	tflog.Trace(ctx, "created secret", map[string]interface{}{"secret_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read qovery secret resource
+func (r secretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state ScopedSecret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get secret from the API
+	s, err := r.secretService.Get(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Key))
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret read", err)
+		return
+	}
+
+	// Refresh state values
+	state = convertDomainSecretToScopedSecret(state, s)
+	tflog.Trace(ctx, "read secret", map[string]interface{}{"secret_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update qovery secret resource
+func (r secretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and current state
+	var plan, state ScopedSecret
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update secret in the backend
+	s, err := r.secretService.Update(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Id), plan.toUpsertRequest())
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret update", err)
+		return
+	}
+
+	// Update state values
+	state = convertDomainSecretToScopedSecret(plan, s)
+	tflog.Trace(ctx, "updated secret", map[string]interface{}{"secret_id": state.Id.Value})
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete qovery secret resource
+func (r secretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state ScopedSecret
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete secret
+	if err := r.secretService.Delete(ctx, variable.Scope(ToString(state.Scope)), ToString(state.ScopeId), ToString(state.Id)); err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret delete", err)
+		return
+	}
+
+	tflog.Trace(ctx, "deleted secret", map[string]interface{}{"secret_id": state.Id.Value})
+
+	// Remove secret from state
+	resp.State.RemoveResource(ctx)
+}
+
+// ImportState imports a qovery secret resource using its scope id and key
+// The value of a secret is never returned by the api, so it is set by the next apply.
+func (r secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scopeID, key, ok := strings.Cut(req.ID, "/")
+	if !ok || scopeID == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: scope_id/key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	scope, err := r.secretService.ResolveScope(ctx, scopeID)
+	if err != nil {
+		addErrorDiagnostic(&resp.Diagnostics, "Error on secret import", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope_id"), scopeID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scope"), scope.String())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+// resolveScope returns the scope set in the plan, or resolves it from the scope id if it is not set.
+func (r secretResource) resolveScope(ctx context.Context, plan ScopedSecret) (variable.Scope, error) {
+	if !plan.Scope.IsUnknown() && !plan.Scope.IsNull() {
+		return variable.Scope(ToString(plan.Scope)), nil
+	}
+
+	return r.secretService.ResolveScope(ctx, ToString(plan.ScopeId))
+}
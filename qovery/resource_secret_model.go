@@ -0,0 +1,36 @@
+package qovery
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
+)
+
+type ScopedSecret struct {
+	Id        types.String `tfsdk:"id"`
+	ScopeId   types.String `tfsdk:"scope_id"`
+	Scope     types.String `tfsdk:"scope"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	MountPath types.String `tfsdk:"mount_path"`
+}
+
+func (s ScopedSecret) toUpsertRequest() secret.UpsertRequest {
+	return secret.UpsertRequest{
+		Key:       ToString(s.Key),
+		Value:     ToString(s.Value),
+		MountPath: ToStringPointer(s.MountPath),
+	}
+}
+
+// convertDomainSecretToScopedSecret keeps the value and the mount path of the given state since the api never returns them.
+func convertDomainSecretToScopedSecret(state ScopedSecret, s *secret.Secret) ScopedSecret {
+	return ScopedSecret{
+		Id:        FromString(s.ID.String()),
+		ScopeId:   state.ScopeId,
+		Scope:     FromString(s.Scope.String()),
+		Key:       FromString(s.Key),
+		Value:     state.Value,
+		MountPath: state.MountPath,
+	}
+}
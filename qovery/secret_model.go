@@ -3,17 +3,16 @@ package qovery
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/qovery/qovery-client-go"
 
-	"github.com/qovery/terraform-provider-qovery/client"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/secret"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/variable"
 )
 
 var secretAttrTypes = map[string]attr.Type{
-	"id":    types.StringType,
-	"key":   types.StringType,
-	"value": types.StringType,
+	"id":         types.StringType,
+	"key":        types.StringType,
+	"value":      types.StringType,
+	"mount_path": types.StringType,
 }
 
 type SecretList []Secret
@@ -37,15 +36,6 @@ func (ss SecretList) toTerraformSet() types.Set {
 	return set
 }
 
-func (ss SecretList) contains(e Secret) bool {
-	for _, v := range ss {
-		if e.Key == v.Key {
-			return true
-		}
-	}
-	return false
-}
-
 func (ss SecretList) find(key string) *Secret {
 	for _, v := range ss {
 		if ToString(v.Key) == key {
@@ -55,30 +45,31 @@ func (ss SecretList) find(key string) *Secret {
 	return nil
 }
 
-func (ss SecretList) diff(old SecretList) client.SecretsDiff {
-	diff := client.SecretsDiff{
-		Create: []client.SecretCreateRequest{},
-		Update: []client.SecretUpdateRequest{},
-		Delete: []client.SecretDeleteRequest{},
+// managed returns the secrets whose key is also in the given managed secrets.
+// The managed secrets are nil when the resource doesn't manage any, so nil is returned as well.
+func (ss SecretList) managed(managedSecrets SecretList) SecretList {
+	if managedSecrets == nil {
+		return nil
 	}
 
-	for _, s := range old {
-		if updatedVar := ss.find(ToString(s.Key)); updatedVar != nil {
-			if updatedVar.Value != s.Value {
-				diff.Update = append(diff.Update, s.toUpdateRequest(*updatedVar))
-			}
-		} else {
-			diff.Delete = append(diff.Delete, s.toDeleteRequest())
+	list := make([]Secret, 0, len(ss))
+	for _, s := range ss {
+		if managedSecrets.find(ToString(s.Key)) != nil {
+			list = append(list, s)
 		}
 	}
+	return list
+}
 
+// mountPaths returns the mount paths of the file secrets.
+func (ss SecretList) mountPaths() []string {
+	mountPaths := make([]string, 0, len(ss))
 	for _, s := range ss {
-		if !old.contains(s) {
-			diff.Create = append(diff.Create, s.toCreateRequest())
+		if p := ToStringPointer(s.MountPath); p != nil {
+			mountPaths = append(mountPaths, *p)
 		}
 	}
-
-	return diff
+	return mountPaths
 }
 
 func (ss SecretList) diffRequest(old SecretList) secret.DiffRequest {
@@ -89,17 +80,18 @@ func (ss SecretList) diffRequest(old SecretList) secret.DiffRequest {
 	}
 
 	for _, s := range old {
-		if updatedVar := ss.find(ToString(s.Key)); updatedVar != nil {
+		if updatedVar := ss.find(ToString(s.Key)); updatedVar != nil && updatedVar.MountPath == s.MountPath {
 			if updatedVar.Value != s.Value {
 				diff.Update = append(diff.Update, s.toDiffUpdateRequest(*updatedVar))
 			}
 		} else {
+			// The mount path of a secret can't be edited: it is deleted and created again when the mount path changes.
 			diff.Delete = append(diff.Delete, s.toDiffDeleteRequest())
 		}
 	}
 
 	for _, s := range ss {
-		if !old.contains(s) {
+		if oldVar := old.find(ToString(s.Key)); oldVar == nil || oldVar.MountPath != s.MountPath {
 			diff.Create = append(diff.Create, s.toDiffCreateRequest())
 		}
 	}
@@ -108,27 +100,20 @@ func (ss SecretList) diffRequest(old SecretList) secret.DiffRequest {
 }
 
 type Secret struct {
-	Id    types.String `tfsdk:"id"`
-	Key   types.String `tfsdk:"key"`
-	Value types.String `tfsdk:"value"`
+	Id        types.String `tfsdk:"id"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	MountPath types.String `tfsdk:"mount_path"`
 }
 
 func (s Secret) toTerraformObject() types.Object {
 	return types.Object{
 		AttrTypes: secretAttrTypes,
 		Attrs: map[string]attr.Value{
-			"id":    s.Id,
-			"key":   s.Key,
-			"value": s.Value,
-		},
-	}
-}
-
-func (s Secret) toCreateRequest() client.SecretCreateRequest {
-	return client.SecretCreateRequest{
-		SecretRequest: qovery.SecretRequest{
-			Key:   ToString(s.Key),
-			Value: ToString(s.Value),
+			"id":         s.Id,
+			"key":        s.Key,
+			"value":      s.Value,
+			"mount_path": s.MountPath,
 		},
 	}
 }
@@ -136,18 +121,9 @@ func (s Secret) toCreateRequest() client.SecretCreateRequest {
 func (s Secret) toDiffCreateRequest() secret.DiffCreateRequest {
 	return secret.DiffCreateRequest{
 		UpsertRequest: secret.UpsertRequest{
-			Key:   ToString(s.Key),
-			Value: ToString(s.Value),
-		},
-	}
-}
-
-func (s Secret) toUpdateRequest(new Secret) client.SecretUpdateRequest {
-	return client.SecretUpdateRequest{
-		Id: ToString(s.Id),
-		SecretEditRequest: qovery.SecretEditRequest{
-			Key:   ToString(s.Key),
-			Value: ToString(new.Value),
+			Key:       ToString(s.Key),
+			Value:     ToString(s.Value),
+			MountPath: ToStringPointer(s.MountPath),
 		},
 	}
 }
@@ -162,49 +138,12 @@ func (s Secret) toDiffUpdateRequest(new Secret) secret.DiffUpdateRequest {
 	}
 }
 
-func (s Secret) toDeleteRequest() client.SecretDeleteRequest {
-	return client.SecretDeleteRequest{
-		Id: ToString(s.Id),
-	}
-}
-
 func (s Secret) toDiffDeleteRequest() secret.DiffDeleteRequest {
 	return secret.DiffDeleteRequest{
 		SecretID: ToString(s.Id),
 	}
 }
 
-func fromSecret(v *qovery.Secret, state *Secret) Secret {
-	sec := Secret{
-		Id:  FromString(v.Id),
-		Key: FromString(v.Key),
-	}
-	if state != nil {
-		sec.Value = state.Value
-	}
-	return sec
-}
-
-func fromSecretList(state SecretList, secrets []*qovery.Secret, scope qovery.APIVariableScopeEnum) SecretList {
-	stateByKey := make(map[string]Secret)
-	for _, s := range state {
-		stateByKey[ToString(s.Key)] = s
-	}
-
-	list := make([]Secret, 0, len(secrets))
-	for _, s := range secrets {
-		if s.Scope != scope {
-			continue
-		}
-		list = append(list, fromSecret(s, state.find(s.GetKey())))
-	}
-
-	if len(list) == 0 {
-		return nil
-	}
-	return list
-}
-
 func convertDomainSecretsToSecretList(state SecretList, secrets secret.Secrets, scope variable.Scope) SecretList {
 	stateByKey := make(map[string]Secret)
 	for _, s := range state {
@@ -213,7 +152,7 @@ func convertDomainSecretsToSecretList(state SecretList, secrets secret.Secrets,
 
 	list := make([]Secret, 0, len(secrets))
 	for _, s := range secrets {
-		if s.Scope != scope {
+		if s.Scope != scope || s.Type.IsReference() {
 			continue
 		}
 		list = append(list, convertDomainSecretToSecret(s, state.find(s.Key)))
@@ -227,20 +166,26 @@ func convertDomainSecretsToSecretList(state SecretList, secrets secret.Secrets,
 
 func convertDomainSecretToSecret(s secret.Secret, state *Secret) Secret {
 	sec := Secret{
-		Id:  FromString(s.ID.String()),
-		Key: FromString(s.Key),
+		Id:        FromString(s.ID.String()),
+		Key:       FromString(s.Key),
+		MountPath: FromStringPointer(s.MountPath),
 	}
 	if state != nil {
 		sec.Value = state.Value
+		// The mount path of a secret isn't returned by the API, it is kept from the state.
+		if s.MountPath == nil {
+			sec.MountPath = state.MountPath
+		}
 	}
 	return sec
 }
 
 func toSecret(v types.Object) Secret {
 	return Secret{
-		Id:    v.Attrs["id"].(types.String),
-		Key:   v.Attrs["key"].(types.String),
-		Value: v.Attrs["value"].(types.String),
+		Id:        v.Attrs["id"].(types.String),
+		Key:       v.Attrs["key"].(types.String),
+		Value:     v.Attrs["value"].(types.String),
+		MountPath: v.Attrs["mount_path"].(types.String),
 	}
 }
 
@@ -0,0 +1,124 @@
+package qovery
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/qovery/terraform-provider-qovery/qovery/descriptions"
+	"github.com/qovery/terraform-provider-qovery/qovery/validators"
+)
+
+const (
+	// defaultServiceTimeout is the default time spent waiting for a service (application, container, database...) to reach its expected state.
+	defaultServiceTimeout = 1 * time.Hour
+	// defaultClusterTimeout is the default time spent waiting for a cluster to reach its expected state.
+	defaultClusterTimeout = 4 * time.Hour
+	// defaultDeploymentTimeout is the default time spent waiting for a whole environment deployment to reach its expected state.
+	defaultDeploymentTimeout = 4 * time.Hour
+	// defaultCustomDomainTimeout is the default time spent waiting for the certificate of a custom domain to be issued.
+	defaultCustomDomainTimeout = 1 * time.Hour
+)
+
+// Timeouts represents the `timeouts` block of the resources waiting for a deployment to complete.
+type Timeouts struct {
+	Create types.String `tfsdk:"create"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+// timeoutsResourceAttribute returns the schema of the `timeouts` block using the given default timeout for every operation.
+func timeoutsResourceAttribute(defaultTimeout time.Duration) tfsdk.Attribute {
+	operationAttribute := func(operation string) tfsdk.Attribute {
+		return tfsdk.Attribute{
+			Description: descriptions.NewStringDefaultDescription(
+				"Maximum time to wait for the "+operation+" to complete (e.g `30m`, `2h`).",
+				formatDuration(defaultTimeout),
+			),
+			Type:     types.StringType,
+			Optional: true,
+			Validators: []tfsdk.AttributeValidator{
+				validators.DurationValidator{},
+			},
+		}
+	}
+
+	return tfsdk.Attribute{
+		Description: "Timeouts applied while waiting for the resource to reach its expected state.",
+		Optional:    true,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": operationAttribute("creation"),
+			"update": operationAttribute("update"),
+			"delete": operationAttribute("deletion"),
+		}),
+	}
+}
+
+// timeoutsDataSourceAttribute returns the schema of the `timeouts` block for data sources sharing their model with a resource.
+// It is always null since data sources do not wait for any deployment.
+func timeoutsDataSourceAttribute() tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Description: "Timeouts of the resource [NOTE: always empty for data sources].",
+		Computed:    true,
+		Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+			"create": {Type: types.StringType, Computed: true},
+			"update": {Type: types.StringType, Computed: true},
+			"delete": {Type: types.StringType, Computed: true},
+		}),
+	}
+}
+
+// withCreateTimeout returns a copy of ctx bounded by the `create` timeout or the given default.
+func (t *Timeouts) withCreateTimeout(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	var v types.String
+	if t != nil {
+		v = t.Create
+	}
+	return context.WithTimeout(ctx, toDuration(v, defaultTimeout))
+}
+
+// withUpdateTimeout returns a copy of ctx bounded by the `update` timeout or the given default.
+func (t *Timeouts) withUpdateTimeout(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	var v types.String
+	if t != nil {
+		v = t.Update
+	}
+	return context.WithTimeout(ctx, toDuration(v, defaultTimeout))
+}
+
+// withDeleteTimeout returns a copy of ctx bounded by the `delete` timeout or the given default.
+func (t *Timeouts) withDeleteTimeout(ctx context.Context, defaultTimeout time.Duration) (context.Context, context.CancelFunc) {
+	var v types.String
+	if t != nil {
+		v = t.Delete
+	}
+	return context.WithTimeout(ctx, toDuration(v, defaultTimeout))
+}
+
+// formatDuration returns a short representation of the given duration (e.g `1h` instead of `1h0m0s`).
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
+
+func toDuration(v types.String, defaultDuration time.Duration) time.Duration {
+	if v.Null || v.Unknown || v.Value == "" {
+		return defaultDuration
+	}
+
+	d, err := time.ParseDuration(v.Value)
+	if err != nil || d <= 0 {
+		return defaultDuration
+	}
+
+	return d
+}
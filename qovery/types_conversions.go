@@ -7,13 +7,14 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/qovery/qovery-client-go"
 
+	"github.com/qovery/terraform-provider-qovery/internal/domain/cluster"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/customdomain"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/environment"
+	"github.com/qovery/terraform-provider-qovery/internal/domain/gittoken"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/organization"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/port"
 	"github.com/qovery/terraform-provider-qovery/internal/domain/registry"
@@ -43,7 +44,10 @@ type ClientEnum interface {
 		registry.Kind |
 		status.State |
 		storage.Type |
-		qovery.BuildModeEnum
+		qovery.BuildModeEnum |
+		customdomain.ServiceType |
+		gittoken.Type |
+		cluster.CloudProvider
 }
 
 func clientEnumToStringArray[T ClientEnum](enum []T) []string {
@@ -78,25 +82,6 @@ func StringAsPointer(v string) *string {
 // Convert Terraform types to Go types
 //
 
-func ToNullableNullableBuildPackLanguageEnum(v types.String) qovery.NullableBuildPackLanguageEnum {
-	enum, err := qovery.NewBuildPackLanguageEnumFromValue(v.Value)
-	if err != nil || v.Null || v.Unknown {
-		s := qovery.NewNullableBuildPackLanguageEnum(nil)
-		return *s
-	}
-	s := qovery.NewNullableBuildPackLanguageEnum(enum)
-	return *s
-}
-
-func ToNullableString(v types.String) qovery.NullableString {
-	if v.Null || v.Unknown {
-		s := qovery.NewNullableString(nil)
-		return *s
-	}
-	s := qovery.NewNullableString(&v.Value)
-	return *s
-}
-
 func ToString(v types.String) string {
 	return v.Value
 }
@@ -151,18 +136,6 @@ func ToInt64Pointer(v types.Int64) *int32 {
 	return &i
 }
 
-func ToMapStringString(obj types.Object) (map[string]interface{}, error) {
-	ret := make(map[string]interface{}, len(obj.Attrs))
-	for k, v := range obj.Attrs {
-		value, err := FromTfValueToGoValue(v)
-		if err != nil {
-			return nil, err
-		}
-		ret[k] = value
-	}
-	return ret, nil
-}
-
 func ToStringArray(set types.List) []string {
 	if set.Null || set.Unknown {
 		return []string{}
@@ -180,13 +153,6 @@ func ToStringArray(set types.List) []string {
 // Convert Go types to Terraform types
 //
 
-func FromNullableNullableBuildPackLanguageEnum(v qovery.NullableBuildPackLanguageEnum) types.String {
-	if v.Get() == nil {
-		return FromStringPointer(nil)
-	}
-	return FromString(string(*v.Get()))
-}
-
 func FromString(v string) types.String {
 	return types.String{Value: v}
 }
@@ -198,13 +164,6 @@ func FromStringPointer(v *string) types.String {
 	return FromString(*v)
 }
 
-func FromNullableString(v qovery.NullableString) types.String {
-	if v.Get() == nil {
-		return types.String{Null: true}
-	}
-	return FromString(*v.Get())
-}
-
 func FromInt64(v int64) types.Int64 {
 	return types.Int64{Value: v}
 }
@@ -304,31 +263,3 @@ func FromTfValueToGoValue(v attr.Value) (interface{}, error) {
 
 	return nil, fmt.Errorf("unable to parse %s as Go value", v.String())
 }
-
-func FromStringMap(value *map[string]interface{}) types.Object {
-	if value == nil || len(*value) == 0 {
-		return types.Object{Null: true}
-	}
-
-	attrs := make(map[string]attr.Value)
-	attrTypes := make(map[string]attr.Type)
-	for k, f := range advancedSettingsDefault {
-		attrTypes[k] = f._type
-	}
-
-	for k, f := range *value {
-		attribute, err := FromGoValueToTfValue(f, attrTypes[k])
-
-		if err != nil {
-			tflog.Warn(context.Background(), "Unable to parse attribute, using default value.", map[string]interface{}{"error": err.Error()})
-			attribute = advancedSettingsDefault[k].defaultValue
-		}
-
-		attrs[k] = attribute
-	}
-
-	return types.Object{
-		Attrs:     attrs,
-		AttrTypes: attrTypes,
-	}
-}
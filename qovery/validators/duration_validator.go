@@ -0,0 +1,48 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.AttributeValidator = DurationValidator{}
+
+// DurationValidator validates that the value is a valid positive duration (e.g `30m`, `1h30m`).
+type DurationValidator struct{}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v DurationValidator) Description(_ context.Context) string {
+	return "string value must be a positive duration (e.g 30s, 10m, 2h)"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (v DurationValidator) MarkdownDescription(_ context.Context) string {
+	return "string value must be a positive duration (e.g `30s`, `10m`, `2h`)"
+}
+
+// Validate runs the main validation logic of the validator, reading configuration data out of `req` and updating `resp` with diagnostics.
+func (v DurationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	var str types.String
+	diags := tfsdk.ValueAs(ctx, req.AttributeConfig, &str)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	if str.Unknown || str.Null {
+		return
+	}
+
+	d, err := time.ParseDuration(str.Value)
+	if err != nil || d <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Duration Value",
+			fmt.Sprintf("string value must be a positive duration (e.g 30s, 10m, 2h), got: %s.", str.Value),
+		)
+	}
+}
@@ -0,0 +1,388 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/qovery/qovery-client-go"
+)
+
+const (
+	clientModule = "github.com/qovery/qovery-client-go"
+	localModule  = "github.com/qovery/terraform-provider-qovery"
+)
+
+// model is an advanced settings model of the api client to generate the attributes of.
+type model struct {
+	// Variable is the name of the generated variable holding the attributes.
+	Variable string
+	// Settings is the advanced settings model as returned by its constructor, so the values it sets are used as defaults.
+	Settings interface{}
+	// Defaults overrides the defaults of the settings with the given keys.
+	Defaults map[string]interface{}
+}
+
+var models = []model{
+	{
+		Variable: "clusterAdvancedSettings",
+		Settings: qovery.NewClusterAdvancedSettings(),
+		// NOTE: the defaults of the cluster settings the provider exposed before they were generated are kept, so existing clusters don't get a diff.
+		Defaults: map[string]interface{}{
+			"aws.eks.ec2.metadata_imds":              "Optional",
+			"cloud_provider.container_registry.tags": map[string]string{},
+			"database.mongodb.allowed_cidrs":         []string{"0.0.0.0/0"},
+			"database.mysql.allowed_cidrs":           []string{"0.0.0.0/0"},
+			"database.postgresql.allowed_cidrs":      []string{"0.0.0.0/0"},
+			"database.redis.allowed_cidrs":           []string{"0.0.0.0/0"},
+		},
+	},
+	{Variable: "applicationAdvancedSettings", Settings: qovery.NewApplicationAdvancedSettings()},
+	{Variable: "containerAdvancedSettings", Settings: qovery.NewContainerAdvancedSettings()},
+	{Variable: "jobAdvancedSettings", Settings: qovery.NewJobAdvancedSettings()},
+	{Variable: "databaseAdvancedSettings", Settings: qovery.NewDatabaseAdvancedSettings()},
+}
+
+// attribute holds the Go expressions of the fields of an advanced setting attribute.
+type attribute struct {
+	Key           string
+	Description   string
+	Type          string
+	PlanModifiers string
+	DefaultValue  string
+}
+
+type variable struct {
+	Name       string
+	Model      string
+	Attributes []attribute
+}
+
+var fileTemplate = template.Must(template.New("advanced_settings").Parse(`// Code generated by scripts/advancedsettings from {{ .Module }} {{ .Version }}; DO NOT EDIT.
+
+package qovery
+
+import (
+{{- range $i, $group := .Imports }}
+{{- if $i }}
+{{ end }}
+{{- range $group }}
+	{{ printf "%q" . }}
+{{- end }}
+{{- end }}
+)
+{{ range .Variables }}
+// {{ .Name }} holds the advanced settings attributes generated from qovery.{{ .Model }}.
+var {{ .Name }} = map[string]advSettingAttr{
+{{- range .Attributes }}
+	{{ printf "%q" .Key }}: {
+		description: {{ .Description }},
+		_type: {{ .Type }},
+		{{- if .PlanModifiers }}
+		planModifiers: {{ .PlanModifiers }},
+		{{- end }}
+		defaultValue: {{ .DefaultValue }},
+	},
+{{- end }}
+}
+{{ end }}`))
+
+// imports are the packages the generated attributes may use, indexed by their name.
+var imports = map[string]string{
+	"attr":         "github.com/hashicorp/terraform-plugin-framework/attr",
+	"tfsdk":        "github.com/hashicorp/terraform-plugin-framework/tfsdk",
+	"types":        "github.com/hashicorp/terraform-plugin-framework/types",
+	"descriptions": "github.com/qovery/terraform-provider-qovery/qovery/descriptions",
+	"modifiers":    "github.com/qovery/terraform-provider-qovery/qovery/modifiers",
+}
+
+func main() {
+	output := flag.String("output", "qovery/advanced_settings_gen.go", "path of the generated file")
+	flag.Parse()
+
+	dir, version, err := clientModuleDir()
+	if err != nil {
+		log.Fatalf("unable to locate %s: %s", clientModule, err)
+	}
+
+	docs, err := fieldDocs(dir)
+	if err != nil {
+		log.Fatalf("unable to parse %s: %s", clientModule, err)
+	}
+
+	variables := make([]variable, 0, len(models))
+	for _, m := range models {
+		v, err := newVariable(m, docs)
+		if err != nil {
+			log.Fatal(err)
+		}
+		variables = append(variables, v)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, map[string]interface{}{
+		"Module":    clientModule,
+		"Version":   version,
+		"Imports":   usedImports(variables),
+		"Variables": variables,
+	}); err != nil {
+		log.Fatalf("unable to generate %s: %s", *output, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("unable to format %s: %s", *output, err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalf("unable to write %s: %s", *output, err)
+	}
+}
+
+// clientModuleDir returns the directory and the version of the api client module used by the provider.
+func clientModuleDir() (string, string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", clientModule).Output()
+	if err != nil {
+		return "", "", err
+	}
+
+	fields := strings.Fields(string(out))
+	if len(fields) != 2 {
+		return "", "", fmt.Errorf("unexpected go list output: %s", out)
+	}
+
+	return fields[0], fields[1], nil
+}
+
+// fieldDocs returns the doc comments of the fields of the structs of the api client indexed by struct and field name.
+func fieldDocs(dir string) (map[string]map[string]string, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make(map[string]map[string]string)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+
+				fields := make(map[string]string)
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						fields[name.Name] = field.Doc.Text()
+					}
+				}
+				docs[spec.Name.Name] = fields
+				return false
+			})
+		}
+	}
+
+	return docs, nil
+}
+
+// newVariable returns the variable holding the attributes of every field of the given advanced settings model.
+// An error is returned if a field has a type with no terraform equivalent, so the schema never silently misses a setting.
+func newVariable(m model, docs map[string]map[string]string) (variable, error) {
+	value := reflect.Indirect(reflect.ValueOf(m.Settings))
+	name := value.Type().Name()
+
+	v := variable{Name: m.Variable, Model: name}
+	overridden := make(map[string]bool, len(m.Defaults))
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		key := strings.Split(field.Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+
+		fieldValue := value.Field(i)
+		if defaultValue, ok := m.Defaults[key]; ok {
+			var err error
+			if fieldValue, err = newFieldValue(fieldValue.Type(), defaultValue); err != nil {
+				return variable{}, fmt.Errorf("unable to override the default of %s.%s: %w", name, field.Name, err)
+			}
+			overridden[key] = true
+		}
+
+		a, err := newAttribute(key, description(key, docs[name][field.Name]), fieldValue)
+		if err != nil {
+			return variable{}, fmt.Errorf("unable to generate the attribute of %s.%s: %w", name, field.Name, err)
+		}
+		v.Attributes = append(v.Attributes, a)
+	}
+
+	// The overridden defaults must match a field, so they don't silently become stale when the model changes.
+	for key := range m.Defaults {
+		if !overridden[key] {
+			return variable{}, fmt.Errorf("unable to override the default of %s: %s is not a field of %s", key, key, name)
+		}
+	}
+
+	return v, nil
+}
+
+// newFieldValue returns the given default value as a value of the given field type, taking its address if the field is a pointer.
+func newFieldValue(fieldType reflect.Type, defaultValue interface{}) (reflect.Value, error) {
+	value := reflect.ValueOf(defaultValue)
+	if fieldType.Kind() == reflect.Ptr && value.Type() == fieldType.Elem() {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		return ptr, nil
+	}
+
+	if value.Type() != fieldType {
+		return reflect.Value{}, fmt.Errorf("expected a %s, got a %s", fieldType, value.Type())
+	}
+
+	return value, nil
+}
+
+// newAttribute returns the attribute of the advanced setting with the given key, description and field value, defaulting to the value of the field if it is set.
+func newAttribute(key string, description string, field reflect.Value) (attribute, error) {
+	a := attribute{Key: key, Description: fmt.Sprintf("%q", description)}
+
+	switch v := field.Interface().(type) {
+	case *bool:
+		a.Type = "types.BoolType"
+		a.DefaultValue = "types.Bool{Null: true}"
+		if v != nil {
+			a.Description = fmt.Sprintf("descriptions.NewBoolDefaultDescription(%q, %t)", description, *v)
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewBoolDefaultModifier(%t)}", *v)
+			a.DefaultValue = fmt.Sprintf("types.Bool{Value: %t}", *v)
+		}
+	case *int32:
+		a.Type = "types.Int64Type"
+		a.DefaultValue = "types.Int64{Null: true}"
+		if v != nil {
+			a.Description = fmt.Sprintf("descriptions.NewInt64DefaultDescription(%q, %d)", description, *v)
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(%d)}", *v)
+			a.DefaultValue = fmt.Sprintf("types.Int64{Value: %d}", *v)
+		}
+	case qovery.NullableInt32:
+		a.Type = "types.Int64Type"
+		a.DefaultValue = "types.Int64{Null: true}"
+		if v.IsSet() && v.Get() != nil {
+			a.Description = fmt.Sprintf("descriptions.NewInt64DefaultDescription(%q, %d)", description, *v.Get())
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewInt64DefaultModifier(%d)}", *v.Get())
+			a.DefaultValue = fmt.Sprintf("types.Int64{Value: %d}", *v.Get())
+		}
+	case *string:
+		a.Type = "types.StringType"
+		a.DefaultValue = "types.String{Null: true}"
+		if v != nil {
+			a.Description = fmt.Sprintf("descriptions.NewStringDefaultDescription(%q, %q)", description, *v)
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewStringDefaultModifier(%q)}", *v)
+			a.DefaultValue = fmt.Sprintf("types.String{Value: %q}", *v)
+		}
+	case []string:
+		a.Type = "types.SetType{ElemType: types.StringType}"
+		a.DefaultValue = "types.Set{ElemType: types.StringType, Null: true}"
+		if v != nil {
+			elems := make([]string, 0, len(v))
+			for _, e := range v {
+				elems = append(elems, fmt.Sprintf("types.String{Value: %q}", e))
+			}
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewStringSetDefaultModifier(%#v)}", v)
+			a.DefaultValue = fmt.Sprintf("types.Set{ElemType: types.StringType, Elems: []attr.Value{%s}}", strings.Join(elems, ", "))
+		}
+	case *map[string]string:
+		a.Type = "types.MapType{ElemType: types.StringType}"
+		a.DefaultValue = "types.Map{ElemType: types.StringType, Null: true}"
+		if v != nil {
+			keys := make([]string, 0, len(*v))
+			for k := range *v {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			elems := make([]string, 0, len(keys))
+			for _, k := range keys {
+				elems = append(elems, fmt.Sprintf("%q: types.String{Value: %q}", k, (*v)[k]))
+			}
+			a.PlanModifiers = fmt.Sprintf("tfsdk.AttributePlanModifiers{modifiers.NewStringMapDefaultModifier(%#v)}", *v)
+			a.DefaultValue = fmt.Sprintf("types.Map{ElemType: types.StringType, Elems: map[string]attr.Value{%s}}", strings.Join(elems, ", "))
+		}
+	default:
+		return attribute{}, fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return a, nil
+}
+
+// usedImports returns the sorted paths of the packages used by the given variables, grouped as goimports does.
+func usedImports(variables []variable) [][]string {
+	used := make(map[string]bool)
+	for _, v := range variables {
+		for _, a := range v.Attributes {
+			for _, expr := range []string{a.Description, a.Type, a.PlanModifiers, a.DefaultValue} {
+				for name, path := range imports {
+					if strings.Contains(expr, name+".") {
+						used[path] = true
+					}
+				}
+			}
+		}
+	}
+
+	var external, local []string
+	for path := range used {
+		if strings.HasPrefix(path, localModule) {
+			local = append(local, path)
+		} else {
+			external = append(external, path)
+		}
+	}
+	sort.Strings(external)
+	sort.Strings(local)
+
+	return [][]string{external, local}
+}
+
+// description returns the description of the advanced setting with the given key from the doc comment of its field.
+// The key is humanized if the field has no doc comment (i.e: `network.ingress.enable_cors` is described as `Network ingress enable cors.`).
+func description(key string, doc string) string {
+	// Each line of the doc comment is a sentence (i.e: `Please use ... instead` followed by `Deprecated`).
+	var sentences []string
+	for _, line := range strings.Split(strings.ReplaceAll(doc, `\"`, `"`), "\n") {
+		line = strings.Join(strings.Fields(line), " ")
+		if line == "" {
+			continue
+		}
+		if !strings.HasSuffix(line, ".") {
+			line += "."
+		}
+		sentences = append(sentences, line)
+	}
+
+	description := strings.TrimSuffix(strings.Join(sentences, " "), ".")
+	if description == "" {
+		description = strings.NewReplacer(".", " ", "_", " ").Replace(key)
+	}
+
+	description = strings.ToUpper(description[:1]) + description[1:]
+	if !strings.HasSuffix(description, ".") {
+		description += "."
+	}
+
+	return description
+}
@@ -55,6 +55,10 @@ func main() {
 		log.Fatalf(err.Error())
 	}
 
+	if err := cleanDigitalOceanCredentials(ctx, apiClient, env.TestOrganizationID); err != nil {
+		log.Fatalf(err.Error())
+	}
+
 	if err := cleanProjects(ctx, apiClient, env.TestOrganizationID); err != nil {
 		log.Fatalf(err.Error())
 	}
@@ -152,6 +156,36 @@ func cleanScalewayCredentials(ctx context.Context, apiClient *qovery.APIClient,
 	return nil
 }
 
+func cleanDigitalOceanCredentials(ctx context.Context, apiClient *qovery.APIClient, organizationID string) error {
+	digitaloceanCreds, err := getDigitalOceanCredentialsToDelete(ctx, apiClient, organizationID)
+	if err != nil {
+		return err
+	}
+
+	bar := progressbar.Default(int64(len(digitaloceanCreds)))
+	fmt.Printf("Deleting %d digitalocean credentials...\n", len(digitaloceanCreds))
+	for _, creds := range digitaloceanCreds {
+		if strings.Contains(creds.Name, testPrefix) {
+			maxSize := len(creds.Name)
+			if maxSize > 50 {
+				maxSize = 50
+			}
+			bar.Describe(fmt.Sprintf("%s...", creds.Name[0:maxSize]))
+
+			_, err := apiClient.CloudProviderCredentialsApi.
+				DeleteDOCredentials(ctx, creds.ID, organizationID).
+				Execute()
+			if err != nil {
+				return err
+			}
+
+			bar.Add(1)
+		}
+	}
+
+	return nil
+}
+
 func cleanContainerRegistry(ctx context.Context, apiClient *qovery.APIClient, organizationID string) error {
 	registries, err := getContainerRegitriesToDelete(ctx, apiClient, organizationID)
 	if err != nil {
@@ -247,6 +281,28 @@ func getScalewayCredentialsToDelete(ctx context.Context, apiClient *qovery.APICl
 	return scalewayCredsToDelete, nil
 }
 
+func getDigitalOceanCredentialsToDelete(ctx context.Context, apiClient *qovery.APIClient, organizationID string) ([]credentials, error) {
+	digitaloceanCreds, _, err := apiClient.CloudProviderCredentialsApi.
+		ListDOCredentials(ctx, organizationID).
+		Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	digitaloceanCredsToDelete := make([]credentials, 0, len(digitaloceanCreds.GetResults()))
+	for _, c := range digitaloceanCreds.GetResults() {
+		credsName := strings.ToLower(c.GetName())
+		if strings.Contains(credsName, testPrefix) {
+			digitaloceanCredsToDelete = append(digitaloceanCredsToDelete, credentials{
+				ID:   c.GetId(),
+				Name: c.GetName(),
+			})
+		}
+	}
+
+	return digitaloceanCredsToDelete, nil
+}
+
 func getContainerRegitriesToDelete(ctx context.Context, apiClient *qovery.APIClient, organizationID string) ([]registry, error) {
 	registries, _, err := apiClient.ContainerRegistriesApi.
 		ListContainerRegistry(ctx, organizationID).
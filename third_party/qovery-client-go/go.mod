@@ -0,0 +1,5 @@
+module github.com/qovery/qovery-client-go
+
+go 1.13
+
+require golang.org/x/oauth2 v0.0.0-20210323180902-22b0adad7558